@@ -9,20 +9,26 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/keepcalmist/chat-service/internal/config"
 	"github.com/keepcalmist/chat-service/internal/logger"
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
 	chatsrepo "github.com/keepcalmist/chat-service/internal/repositories/chats"
 	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	skillsrepo "github.com/keepcalmist/chat-service/internal/repositories/skills"
 	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
 	clientv1 "github.com/keepcalmist/chat-service/internal/server/server-client/v1"
 	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	afcverdictsprocessor "github.com/keepcalmist/chat-service/internal/services/afc-verdicts-processor"
+	inmemeventstream "github.com/keepcalmist/chat-service/internal/services/event-stream/in-mem"
 	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
-	inmemmanagerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool/in-mem"
+	managerscheduler "github.com/keepcalmist/chat-service/internal/services/manager-scheduler"
 	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
 	"github.com/keepcalmist/chat-service/internal/store"
 )
@@ -88,17 +94,6 @@ func run() (errReturned error) {
 		return fmt.Errorf("get manager swagger: %v", err)
 	}
 
-	srvDebug, err := serverdebug.New(
-		serverdebug.NewOptions(
-			cfg.Servers.Debug.Addr,
-			clientSwagger,
-			managerSwagger,
-			serverdebug.WithLvlSetter(setLevel)),
-	)
-	if err != nil {
-		return fmt.Errorf("init debug server: %v", err)
-	}
-
 	if cfg.Clients.Keycloak.DebugMode && cfg.Global.IsProduction() {
 		zap.L().Warn("keycloak debug mode enabled in production")
 	}
@@ -130,6 +125,20 @@ func run() (errReturned error) {
 		return fmt.Errorf("init problems repo: %v", err)
 	}
 
+	repoSkills, err := skillsrepo.New(skillsrepo.NewOptions(
+		database,
+	))
+	if err != nil {
+		return fmt.Errorf("init skills repo: %v", err)
+	}
+
+	repoCapacities, err := capacitiesrepo.New(capacitiesrepo.NewOptions(
+		database,
+	))
+	if err != nil {
+		return fmt.Errorf("init capacities repo: %v", err)
+	}
+
 	repoJobs, err := jobsrepo.New(jobsrepo.NewOptions(
 		database,
 	))
@@ -153,18 +162,109 @@ func run() (errReturned error) {
 		return fmt.Errorf("init msg producer: %v", err)
 	}
 
-	managerLoadService, err := managerload.New(managerload.NewOptions(cfg.Services.ManagerLoad.MaxProblemsAtSameTime, repoProblems))
+	managerLoadService, err := managerload.New(managerload.NewOptions(
+		cfg.Services.ManagerLoad.MaxProblemsAtSameTime,
+		repoProblems,
+		repoCapacities,
+	))
 	if err != nil {
 		return fmt.Errorf("init manager load service: %v", err)
 	}
 
-	poolService := inmemmanagerpool.New()
+	poolService, err := initManagerPool(cfg.Services.ManagerPool, database)
+	if err != nil {
+		return fmt.Errorf("init manager pool: %v", err)
+	}
+
+	eventStream := inmemeventstream.New()
+	defer func() {
+		if err := eventStream.Close(); err != nil {
+			zap.L().Error("close event stream", zap.Error(err))
+		}
+	}()
 
-	outbox, err := initOutbox(cfg.Services, database, repoJobs, repoMsg, producer)
+	jobsListener, err := store.NewPSQLListener(psqlClient, jobsrepo.JobsChannel)
+	if err != nil {
+		return fmt.Errorf("init jobs listener: %v", err)
+	}
+
+	metrics := prometheus.NewRegistry()
+	metrics.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+
+	outbox, err := initOutbox(
+		cfg.Services,
+		database,
+		jobsListener,
+		metrics,
+		repoJobs,
+		repoChat,
+		repoMsg,
+		repoProblems,
+		producer,
+		managerLoadService,
+		eventStream,
+	)
 	if err != nil {
 		return fmt.Errorf("init outbox: %v", err)
 	}
 
+	srvDebug, err := serverdebug.New(
+		serverdebug.NewOptions(
+			cfg.Servers.Debug.Addr,
+			clientSwagger,
+			managerSwagger,
+			repoJobs,
+			repoJobs,
+			repoCapacities,
+			repoSkills,
+			outbox,
+			metrics,
+			serverdebug.WithLvlSetter(setLevel)),
+	)
+	if err != nil {
+		return fmt.Errorf("init debug server: %v", err)
+	}
+
+	assignmentStrategy, err := initAssignmentStrategy(cfg.Services.ManagerScheduler, repoProblems)
+	if err != nil {
+		return fmt.Errorf("init assignment strategy: %v", err)
+	}
+
+	managerScheduler, err := managerscheduler.New(managerscheduler.NewOptions(
+		cfg.Services.ManagerScheduler.Period,
+		cfg.Services.ManagerScheduler.SkillFallbackAfter,
+		poolService,
+		assignmentStrategy,
+		repoSkills,
+		repoMsg,
+		outbox,
+		repoProblems,
+		database,
+	))
+	if err != nil {
+		return fmt.Errorf("init manager scheduler: %v", err)
+	}
+
+	afcVerdictsProcessor, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		cfg.Services.AFCVerdictsProcessor.Brokers,
+		cfg.Services.AFCVerdictsProcessor.Consumers,
+		cfg.Services.AFCVerdictsProcessor.ConsumerGroup,
+		cfg.Services.AFCVerdictsProcessor.VerdictsTopic,
+		cfg.Services.AFCVerdictsProcessor.VerdictsSignKey,
+		afcverdictsprocessor.NewKafkaReader,
+		afcverdictsprocessor.NewKafkaDLQWriter(
+			cfg.Services.AFCVerdictsProcessor.Brokers,
+			cfg.Services.AFCVerdictsProcessor.VerdictsDLQTopic,
+		),
+		database,
+		repoMsg,
+		outbox,
+		afcverdictsprocessor.WithRegisterer(metrics),
+	))
+	if err != nil {
+		return fmt.Errorf("init afc verdicts processor: %v", err)
+	}
+
 	srvManager, err := initServerManager(
 		cfg.Servers.Manager.Addr,
 		cfg.Servers.Manager.AllowOrigins,
@@ -175,6 +275,14 @@ func run() (errReturned error) {
 		managerSwagger,
 		managerLoadService,
 		poolService,
+		database,
+		outbox,
+		repoMsg,
+		repoProblems,
+		repoSkills,
+		cfg.Services.ManagerSkills.Source == "keycloak",
+		eventStream,
+		ctx.Done(),
 	)
 	if err != nil {
 		return fmt.Errorf("init manager server: %v", err)
@@ -192,7 +300,10 @@ func run() (errReturned error) {
 		repoChat,
 		repoMsg,
 		repoProblems,
+		initTopicDetector(cfg.Services.ProblemTopics),
 		outbox,
+		eventStream,
+		ctx.Done(),
 	)
 	if err != nil {
 		return fmt.Errorf("init client server: %v", err)
@@ -203,10 +314,10 @@ func run() (errReturned error) {
 	eg.Go(func() error { return srvDebug.Run(ctx) })
 	eg.Go(func() error { return srvClient.Run(ctx) })
 	eg.Go(func() error { return srvManager.Run(ctx) })
-	eg.Go(func() error { return outbox.Run(ctx) })
 	// Run services.
-	// Ждут своего часа.
-	// ...
+	eg.Go(func() error { return outbox.Run(ctx) })
+	eg.Go(func() error { return managerScheduler.Run(ctx) })
+	eg.Go(func() error { return afcVerdictsProcessor.Run(ctx) })
 
 	if err = eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
 		return fmt.Errorf("wait app stop: %v", err)
@@ -12,11 +12,15 @@ import (
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
 	"github.com/keepcalmist/chat-service/internal/server"
+	clientevents "github.com/keepcalmist/chat-service/internal/server/server-client/events"
 	clientv12 "github.com/keepcalmist/chat-service/internal/server/server-client/v1"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
 	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	topicdetector "github.com/keepcalmist/chat-service/internal/services/topic-detector"
 	"github.com/keepcalmist/chat-service/internal/store"
 	gethistory "github.com/keepcalmist/chat-service/internal/usecases/client/get-history"
 	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/client/send-message"
+	websocketstream "github.com/keepcalmist/chat-service/internal/websocket-stream"
 )
 
 const nameServerClient = "server-client"
@@ -33,7 +37,10 @@ func initServerClient(
 	chatRepository *chatsrepo.Repo,
 	msgRepository *messagesrepo.Repo,
 	problemRepository *problemsrepo.Repo,
+	topicDetector *topicdetector.Service,
 	outboxService *outbox.Service,
+	eventStream eventstream.EventStream,
+	shutdownCh <-chan struct{},
 ) (*server.Server, error) {
 	getHistoryUsecase, err := gethistory.New(
 		gethistory.NewOptions(msgRepository),
@@ -48,6 +55,7 @@ func initServerClient(
 			msgRepository,
 			outboxService,
 			problemRepository,
+			topicDetector,
 			database,
 		),
 	)
@@ -62,6 +70,18 @@ func initServerClient(
 		return nil, fmt.Errorf("create v1 handlers: %v", err)
 	}
 
+	wsHandler, err := websocketstream.NewHTTPHandler(websocketstream.NewOptions(
+		zap.L().Named(nameServerClient+"-ws"),
+		eventStream,
+		clientevents.Adapter{},
+		websocketstream.JSONEventWriter{},
+		websocketstream.NewUpgrader(allowOrigins, websocketstream.SecWsProtocol),
+		shutdownCh,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create ws handler: %v", err)
+	}
+
 	keyCloakClient, err := keycloakclient.New(
 		keycloakclient.NewOptions(
 			keycloakConfig.BasePath,
@@ -84,6 +104,7 @@ func initServerClient(
 		role,
 		resource,
 		isProduction,
+		wsHandler,
 	), v1Handlers)
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
@@ -8,12 +8,25 @@ import (
 
 	keycloakclient "github.com/keepcalmist/chat-service/internal/clients/keycloak"
 	"github.com/keepcalmist/chat-service/internal/config"
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	skillsrepo "github.com/keepcalmist/chat-service/internal/repositories/skills"
 	"github.com/keepcalmist/chat-service/internal/server"
+	managerevents "github.com/keepcalmist/chat-service/internal/server/server-manager/events"
 	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
 	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
 	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/store"
 	canreceiveproblems "github.com/keepcalmist/chat-service/internal/usecases/manager/can-receive-problems"
 	freehands "github.com/keepcalmist/chat-service/internal/usecases/manager/free-hands"
+	getchathistory "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history"
+	getchats "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats"
+	leavehands "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands"
+	resolveproblem "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem"
+	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message"
+	websocketstream "github.com/keepcalmist/chat-service/internal/websocket-stream"
 )
 
 const nameServerManager = "server-manager"
@@ -28,6 +41,14 @@ func initServerManager(
 	swag *openapi3.T,
 	managerLoadService *managerload.Service,
 	managerPoolService managerpool.Pool,
+	database *store.Database,
+	outboxService *outbox.Service,
+	msgRepository *messagesrepo.Repo,
+	problemRepository *problemsrepo.Repo,
+	skillsRepository *skillsrepo.Repo,
+	skillsFromToken bool,
+	eventStream eventstream.EventStream,
+	shutdownCh <-chan struct{},
 ) (*server.Server, error) {
 	keyCloakClient, err := keycloakclient.New(
 		keycloakclient.NewOptions(
@@ -49,18 +70,72 @@ func initServerManager(
 		return nil, fmt.Errorf("init usecase can reciev problem: %v", err)
 	}
 
-	useCaseFreeHands, err := freehands.New(freehands.NewOptions(managerLoadService, managerPoolService))
+	var freeHandsOpts []freehands.OptOptionsSetter
+	if skillsFromToken {
+		freeHandsOpts = append(freeHandsOpts, freehands.WithSkillsRepo(skillsRepository))
+	}
+
+	useCaseFreeHands, err := freehands.New(freehands.NewOptions(managerLoadService, managerPoolService, freeHandsOpts...))
 	if err != nil {
 		return nil, fmt.Errorf("init usecase free hands: %v", err)
 	}
 
+	useCaseLeaveHands, err := leavehands.New(leavehands.NewOptions(managerPoolService))
+	if err != nil {
+		return nil, fmt.Errorf("init usecase leave hands: %v", err)
+	}
+
+	useCaseGetChats, err := getchats.New(getchats.NewOptions(problemRepository))
+	if err != nil {
+		return nil, fmt.Errorf("init usecase get chats: %v", err)
+	}
+
+	useCaseGetChatHistory, err := getchathistory.New(getchathistory.NewOptions(msgRepository, problemRepository))
+	if err != nil {
+		return nil, fmt.Errorf("init usecase get chat history: %v", err)
+	}
+
+	useCaseSendMessage, err := sendmessage.New(
+		sendmessage.NewOptions(msgRepository, outboxService, problemRepository, database),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("init usecase send message: %v", err)
+	}
+
+	useCaseResolveProblem, err := resolveproblem.New(
+		resolveproblem.NewOptions(msgRepository, outboxService, problemRepository, database),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("init usecase resolve problem: %v", err)
+	}
+
 	handlers, err := managerv1.NewHandlers(
-		managerv1.NewOptions(useCaseCanReceiveProblem, useCaseFreeHands),
+		managerv1.NewOptions(
+			useCaseCanReceiveProblem,
+			useCaseFreeHands,
+			useCaseLeaveHands,
+			useCaseGetChats,
+			useCaseGetChatHistory,
+			useCaseSendMessage,
+			useCaseResolveProblem,
+		),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("init handlers: %v", err)
 	}
 
+	wsHandler, err := websocketstream.NewHTTPHandler(websocketstream.NewOptions(
+		zap.L().Named(nameServerManager+"-ws"),
+		eventStream,
+		managerevents.Adapter{},
+		websocketstream.JSONEventWriter{},
+		websocketstream.NewUpgrader(allowOrigins, websocketstream.SecWsProtocol),
+		shutdownCh,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create ws handler: %v", err)
+	}
+
 	srv, err := server.New(server.NewOptions(
 		zap.L().Named(nameServerManager),
 		addr,
@@ -70,6 +145,7 @@ func initServerManager(
 		role,
 		resource,
 		isProduction,
+		wsHandler,
 	), handlers)
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
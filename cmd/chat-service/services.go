@@ -2,35 +2,98 @@ package main
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/keepcalmist/chat-service/internal/config"
+	chatsrepo "github.com/keepcalmist/chat-service/internal/repositories/chats"
 	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	inmemmanagerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool/in-mem"
+	psqlmanagerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool/psql"
+	managerscheduler "github.com/keepcalmist/chat-service/internal/services/manager-scheduler"
+	schedulerstrategy "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy"
 	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
 	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	clientmessageblockedjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-sent"
+	closechatjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat"
+	managerassignedtoproblemjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	prunejobattemptsjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/prune-job-attempts"
 	sendclientmessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-client-message"
+	sendmanagermessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-manager-message"
+	topicdetector "github.com/keepcalmist/chat-service/internal/services/topic-detector"
 	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/types"
 )
 
+func initManagerPool(cfg config.ManagerPool, database *store.Database) (managerpool.Pool, error) {
+	switch cfg.Storage {
+	case "inmem":
+		return inmemmanagerpool.New(), nil
+	case "psql":
+		return psqlmanagerpool.New(psqlmanagerpool.NewOptions(database))
+	}
+	return nil, fmt.Errorf("unknown manager pool storage: %q", cfg.Storage)
+}
+
+func initAssignmentStrategy(cfg config.ManagerScheduler, repoProblems *problemsrepo.Repo) (managerscheduler.Strategy, error) {
+	switch cfg.Strategy {
+	case "fifo":
+		return schedulerstrategy.NewFIFO(), nil
+	case "least-loaded":
+		return schedulerstrategy.NewLeastLoaded(repoProblems), nil
+	case "round-robin":
+		return schedulerstrategy.NewRoundRobin(repoProblems), nil
+	case "sticky":
+		return schedulerstrategy.NewSticky(repoProblems, schedulerstrategy.NewFIFO()), nil
+	}
+	return nil, fmt.Errorf("unknown assignment strategy: %q", cfg.Strategy)
+}
+
+func initTopicDetector(cfg config.ProblemTopics) *topicdetector.Service {
+	keywords := make(map[types.Topic][]string, len(cfg.Keywords))
+	for topic, words := range cfg.Keywords {
+		keywords[types.Topic(topic)] = words
+	}
+	return topicdetector.New(keywords)
+}
+
 func initOutbox(
 	cfg config.Services,
 	database *store.Database,
+	jobsListener *store.PSQLListener,
+	metrics prometheus.Registerer,
 	repoJobs *jobsrepo.Repo,
+	repoChat *chatsrepo.Repo,
 	repoMsg *messagesrepo.Repo,
+	repoProblems *problemsrepo.Repo,
 	producer *msgproducer.Service,
+	managerLoadService *managerload.Service,
+	eventStream eventstream.EventStream,
 ) (*outbox.Service, error) {
 	outboxService, err := outbox.New(outbox.NewOptions(
 		cfg.Outbox.Workers,
+		cfg.Outbox.BatchSize,
 		cfg.Outbox.IdleTime,
 		cfg.Outbox.ReserveFor,
 		repoJobs,
 		database,
+		outbox.WithListener(jobsListener),
+		outbox.WithMaxJobAge(cfg.Outbox.MaxJobAge),
+		outbox.WithDrainTimeout(cfg.Outbox.DrainTimeout),
+		outbox.WithRegisterer(metrics),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("init outbox service: %v", err)
 	}
 
-	sendClientMsgJob, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(repoMsg, producer))
+	sendClientMsgJob, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(repoMsg, producer, eventStream))
 	if err != nil {
 		return nil, fmt.Errorf("init send client message job: %v", err)
 	}
@@ -40,5 +103,70 @@ func initOutbox(
 		return nil, fmt.Errorf("register send client message job: %v", err)
 	}
 
+	sendManagerMsgJob, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(repoMsg, repoChat, producer, eventStream))
+	if err != nil {
+		return nil, fmt.Errorf("init send manager message job: %v", err)
+	}
+
+	err = outboxService.RegisterJob(sendManagerMsgJob)
+	if err != nil {
+		return nil, fmt.Errorf("register send manager message job: %v", err)
+	}
+
+	managerAssignedJob, err := managerassignedtoproblemjob.New(
+		managerassignedtoproblemjob.NewOptions(repoMsg, repoChat, managerLoadService, eventStream),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("init manager assigned to problem job: %v", err)
+	}
+
+	err = outboxService.RegisterJob(managerAssignedJob)
+	if err != nil {
+		return nil, fmt.Errorf("register manager assigned to problem job: %v", err)
+	}
+
+	closeChatJob, err := closechatjob.New(closechatjob.NewOptions(repoMsg, repoChat, managerLoadService, eventStream))
+	if err != nil {
+		return nil, fmt.Errorf("init close chat job: %v", err)
+	}
+
+	err = outboxService.RegisterJob(closeChatJob)
+	if err != nil {
+		return nil, fmt.Errorf("register close chat job: %v", err)
+	}
+
+	clientMsgBlockedJob, err := clientmessageblockedjob.New(clientmessageblockedjob.NewOptions(repoMsg, eventStream))
+	if err != nil {
+		return nil, fmt.Errorf("init client message blocked job: %v", err)
+	}
+
+	err = outboxService.RegisterJob(clientMsgBlockedJob)
+	if err != nil {
+		return nil, fmt.Errorf("register client message blocked job: %v", err)
+	}
+
+	clientMsgSentJob, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(repoMsg, repoProblems, eventStream))
+	if err != nil {
+		return nil, fmt.Errorf("init client message sent job: %v", err)
+	}
+
+	err = outboxService.RegisterJob(clientMsgSentJob)
+	if err != nil {
+		return nil, fmt.Errorf("register client message sent job: %v", err)
+	}
+
+	pruneJobAttemptsJob, err := prunejobattemptsjob.New(
+		prunejobattemptsjob.NewOptions(repoJobs, cfg.Outbox.AttemptsRetention),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("init prune job attempts job: %v", err)
+	}
+
+	// Every run is handled by one replica only.
+	err = outboxService.RegisterPeriodicJob(pruneJobAttemptsJob, outbox.Every(time.Hour), outbox.CatchUpOnce)
+	if err != nil {
+		return nil, fmt.Errorf("register prune job attempts job: %v", err)
+	}
+
 	return outboxService, nil
 }
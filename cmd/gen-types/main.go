@@ -18,7 +18,7 @@ import (
 	"database/sql/driver"
 	"github.com/google/uuid"
 )
-	
+
 type IDs interface {
 	{{.TYPES | join " | "}}
 }
@@ -39,8 +39,7 @@ func MustParse[t IDs](value string) t {
 		panic(err)
 	}
 	return t(id)
-}	
-
+}
 `
 
 func main() {
@@ -29,7 +29,14 @@ class APIClient {
         return await this.extractData(response);
     }
 
-    async sendMessage(msgBody) {
+    async sendMessage(msgBody, topic) {
+        const request = {
+            messageBody: msgBody,
+        };
+        if (topic) {
+            request.topic = topic;
+        }
+
         const response = await fetch(apiEndpoint + sendMessagePath, {
             method: 'POST',
             headers: {
@@ -37,9 +44,7 @@ class APIClient {
                 'Authorization': 'Bearer ' + this.token,
                 'X-Request-ID': uuidV4(),
             },
-            body: JSON.stringify({
-                messageBody: msgBody,
-            }),
+            body: JSON.stringify(request),
         });
         return await this.extractData(response);
     }
@@ -13,6 +13,7 @@ class App {
     static msgSelector = '.media.media-chat';
     static msgInput = $('#msgInput');
     static sendButton = $('#sendBtn');
+    static topicSelect = $('#topicSelect');
 
     static Run() {
         const keycloak = new Keycloak({
@@ -109,7 +110,8 @@ class App {
                 return;
             }
 
-            app.apiClient.sendMessage(msgBody)
+            // The topic matters for the new problem only, the server ignores it for the open one.
+            app.apiClient.sendMessage(msgBody, app.topicSelect.val())
                 .then((msg) => {
                     msg.body = msgBody;
 
@@ -2,6 +2,7 @@ const getChatsPath = '/getChats';
 const getChatHistoryPath = '/getChatHistory';
 const getFreeHandsBtnAvPath = '/getFreeHandsBtnAvailability';
 const freeHandsPath = '/freeHands';
+const leaveHandsPath = '/leaveHands';
 const sendMessagePath = '/sendMessage';
 const resolveProblemPath = '/resolveProblem';
 
@@ -35,6 +36,17 @@ class APIClient {
         return await this.extractData(response);
     }
 
+    async leaveHands() {
+        const response = await fetch(apiEndpoint + leaveHandsPath, {
+            method: 'POST',
+            headers: {
+                'Authorization': 'Bearer ' + this.token,
+                'X-Request-ID': uuidV4(),
+            },
+        });
+        return await this.extractData(response);
+    }
+
     async getChats() {
         const response = await fetch(apiEndpoint + getChatsPath, {
             method: 'POST',
@@ -13,6 +13,8 @@ class App {
     static openChats = $('#open-chats');
     static problemSelector = '.problem';
     static readyToProblemsBtn = $('#ready-to-problems-btn');
+    static takeABreakBtn = $('#take-a-break-btn');
+    static managerLoad = $('#manager-load');
     static chatArea = $('#chat-content');
     static msgInput = $('#msgInput');
     static sendButton = $('#sendBtn');
@@ -75,9 +77,13 @@ class App {
     static GetReadyToProblemsAv() {
         this.apiClient.getFreeHandsBtnAvailability()
             .then((result) => {
+                this.managerLoad.text(`${result.load} / ${result.capacity}`);
                 if (result.available) {
                     this.readyToProblemsBtn.removeClass('disabled');
                 }
+                if (result.inPool) {
+                    this.SetWaitingForProblems();
+                }
             })
             .catch((err) => {
                 alert('Get "ready to problems" button availability: ' + err);
@@ -87,6 +93,7 @@ class App {
     static InitListeners() {
         App.OpenChatOnClick();
         App.ReadyToProblemsOnBtnClick();
+        App.TakeABreakOnBtnClick();
         App.GetChatHistoryOnScroll();
         App.SendMessageOnBtnClick();
         App.ResolveProblemOnBtnClick();
@@ -97,8 +104,7 @@ class App {
         this.readyToProblemsBtn.click(function () {
             app.apiClient.freeHands()
                 .then(() => {
-                    app.readyToProblemsBtn.addClass('disabled waiting');
-                    app.readyToProblemsBtn.text('Waiting for problems...');
+                    app.SetWaitingForProblems();
                 })
                 .catch((err) => {
                     alert('Send free hands signal error: ' + err);
@@ -106,6 +112,28 @@ class App {
         });
     }
 
+    static TakeABreakOnBtnClick() {
+        const app = this;
+        this.takeABreakBtn.click(function () {
+            app.apiClient.leaveHands()
+                .then(() => {
+                    app.takeABreakBtn.addClass('disabled');
+                    app.readyToProblemsBtn.removeClass('waiting');
+                    app.readyToProblemsBtn.text('Ready to Problems 🙋‍');
+                    App.GetReadyToProblemsAv();
+                })
+                .catch((err) => {
+                    alert('Send leave hands signal error: ' + err);
+                });
+        });
+    }
+
+    static SetWaitingForProblems() {
+        this.readyToProblemsBtn.addClass('disabled waiting');
+        this.readyToProblemsBtn.text('Waiting for problems...');
+        this.takeABreakBtn.removeClass('disabled');
+    }
+
     static OpenChatOnClick() {
         const app = this;
         $(document.body).on('click', App.problemSelector, function () {
@@ -13,13 +13,45 @@ type Config struct {
 }
 
 type Services struct {
-	MsgProducer MsgProducer `toml:"msg_producer"`
-	Outbox      Outbox      `toml:"outbox"`
-	ManagerLoad ManagerLoad `toml:"manager_load"`
+	MsgProducer          MsgProducer          `toml:"msg_producer"`
+	AFCVerdictsProcessor AFCVerdictsProcessor `toml:"afc_verdicts_processor"`
+	Outbox               Outbox               `toml:"outbox"`
+	ManagerLoad          ManagerLoad          `toml:"manager_load"`
+	ManagerScheduler     ManagerScheduler     `toml:"manager_scheduler"`
+	ManagerPool          ManagerPool          `toml:"manager_pool"`
+	ManagerSkills        ManagerSkills        `toml:"manager_skills"`
+	ProblemTopics        ProblemTopics        `toml:"problem_topics"`
+}
+
+type ManagerSkills struct {
+	// Source is "keycloak" to take the skills from the "skill:<topic>" roles of the manager token
+	// every time the manager frees the hands, or "db" to use the skills stored in the database as is.
+	// The stored skills are edited on the debug server.
+	Source string `toml:"source" validate:"required,oneof=keycloak db"`
+}
+
+type ProblemTopics struct {
+	// Keywords infer the topic of the problem from the client message if the client hasn't chosen it.
+	Keywords map[string][]string `toml:"keywords" validate:"dive,keys,topic,endkeys,dive,required"`
+}
+
+type ManagerPool struct {
+	// Storage is "inmem" for the single instance or "psql" to share the pool between the replicas.
+	Storage string `toml:"storage" validate:"required,oneof=inmem psql"`
 }
 
 type ManagerLoad struct {
-	MaxProblemsAtSameTime int `toml:"max_problems_at_same_time" validate:"required,min=1,max=30"`
+	// MaxProblemsAtSameTime is the default capacity, it can be overridden per manager on the debug server.
+	// The upper bound is checked by the manager-load service.
+	MaxProblemsAtSameTime int `toml:"max_problems_at_same_time" validate:"required,min=1"`
+}
+
+type ManagerScheduler struct {
+	Period time.Duration `toml:"period" validate:"required,min=100ms,max=1m"`
+	// Strategy chooses the manager for the problem among the pool.
+	Strategy string `toml:"strategy" validate:"required,oneof=fifo least-loaded round-robin sticky"`
+	// SkillFallbackAfter is the time the problem waits for the manager skilled in its topic.
+	SkillFallbackAfter time.Duration `toml:"skill_fallback_after" validate:"required,min=1s,max=1h"`
 }
 
 type MsgProducer struct {
@@ -29,10 +61,23 @@ type MsgProducer struct {
 	EncryptKey string   `toml:"encrypt_key"`
 }
 
+type AFCVerdictsProcessor struct {
+	Brokers          []string `toml:"brokers" validate:"required,dive,hostname_port"`
+	Consumers        int      `toml:"consumers" validate:"required,min=1,max=16"`
+	ConsumerGroup    string   `toml:"consumer_group" validate:"required"`
+	VerdictsTopic    string   `toml:"verdicts_topic" validate:"required"`
+	VerdictsDLQTopic string   `toml:"verdicts_dlq_topic" validate:"required"`
+	VerdictsSignKey  string   `toml:"verdicts_signing_public_key" validate:"required"`
+}
+
 type Outbox struct {
-	Workers    int           `toml:"workers" validate:"required,min=1,max=100"`
-	IdleTime   time.Duration `toml:"idle_time" validate:"required,min=1s,max=10s"`
-	ReserveFor time.Duration `toml:"reserve_for" validate:"required,min=1s,max=10m"`
+	Workers           int           `toml:"workers" validate:"required,min=1,max=100"`
+	BatchSize         int           `toml:"batch_size" validate:"required,min=1,max=100"`
+	IdleTime          time.Duration `toml:"idle_time" validate:"required,min=1s,max=10s"`
+	ReserveFor        time.Duration `toml:"reserve_for" validate:"required,min=1s,max=10m"`
+	MaxJobAge         time.Duration `toml:"max_job_age" validate:"required,min=1s"`
+	DrainTimeout      time.Duration `toml:"drain_timeout" validate:"required,max=10m"`
+	AttemptsRetention time.Duration `toml:"attempts_retention" validate:"required,min=1h"`
 }
 
 type GlobalConfig struct {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/keepcalmist/chat-service/internal/config"
+	"github.com/keepcalmist/chat-service/internal/validator"
 )
 
 var configExamplePath string
@@ -23,4 +24,73 @@ func TestParseAndValidate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, cfg.Log.Level)
 	assert.False(t, cfg.Global.IsProduction())
+	assert.NotEmpty(t, cfg.Services.AFCVerdictsProcessor.VerdictsSignKey)
+	assert.NotEmpty(t, cfg.Services.ProblemTopics.Keywords["cards"])
+}
+
+func TestAFCVerdictsProcessor_Validate(t *testing.T) {
+	valid := func() config.AFCVerdictsProcessor {
+		return config.AFCVerdictsProcessor{
+			Brokers:          []string{"localhost:9092"},
+			Consumers:        4,
+			ConsumerGroup:    "chat-service",
+			VerdictsTopic:    "afc.msg-verdicts",
+			VerdictsDLQTopic: "afc.msg-verdicts.dlq",
+			VerdictsSignKey:  "-----BEGIN PUBLIC KEY-----",
+		}
+	}
+
+	cases := []struct {
+		name    string
+		modify  func(cfg *config.AFCVerdictsProcessor)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*config.AFCVerdictsProcessor) {}},
+		{name: "no brokers", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.Brokers = nil }, wantErr: true},
+		{name: "invalid broker", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.Brokers = []string{"kafka"} }, wantErr: true},
+		{name: "no consumers", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.Consumers = 0 }, wantErr: true},
+		{name: "too many consumers", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.Consumers = 17 }, wantErr: true},
+		{name: "no consumer group", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.ConsumerGroup = "" }, wantErr: true},
+		{name: "no topic", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.VerdictsTopic = "" }, wantErr: true},
+		{name: "no dlq topic", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.VerdictsDLQTopic = "" }, wantErr: true},
+		{name: "no key", modify: func(cfg *config.AFCVerdictsProcessor) { cfg.VerdictsSignKey = "" }, wantErr: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(&cfg)
+
+			err := validator.Validator.Struct(cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProblemTopics_Validate(t *testing.T) {
+	cases := []struct {
+		name     string
+		keywords map[string][]string
+		wantErr  bool
+	}{
+		{name: "valid", keywords: map[string][]string{"cards": {"card"}, "loans": {"loan", "credit"}}},
+		{name: "no keywords", keywords: nil},
+		{name: "unknown topic", keywords: map[string][]string{"mortgage": {"house"}}, wantErr: true},
+		{name: "empty keyword", keywords: map[string][]string{"cards": {""}}, wantErr: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validator.Struct(config.ProblemTopics{Keywords: tt.keywords})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
@@ -14,7 +15,10 @@ import (
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/introspector_mock.gen.go -package=middlewaresmocks Introspector
 
-const tokenCtxKey = "user-token"
+const (
+	tokenCtxKey = "user-token"
+	rolesCtxKey = "user-resource-roles"
+)
 
 var ErrNoRequiredResourceRole = errors.New("no required resource role")
 
@@ -23,12 +27,29 @@ type Introspector interface {
 }
 
 // NewKeycloakTokenAuth returns a middleware that implements "active" authentication:
-// each request is verified by the Keycloak server.
+// each request is verified by the Keycloak server. The token is taken from the Authorization header only.
 func NewKeycloakTokenAuth(introspector Introspector, resource, role string) echo.MiddlewareFunc {
+	return newKeycloakTokenAuth(introspector, resource, role, "header:Authorization", "")
+}
+
+// NewKeycloakWsTokenAuth is NewKeycloakTokenAuth for the WebSocket handshake.
+// Browsers can't set headers for it, so the token is also looked up in the Sec-WebSocket-Protocol header
+// (next to secWsProtocol) and in the "token" query param. Don't use it for the other routes,
+// the query params end up in the access logs.
+func NewKeycloakWsTokenAuth(introspector Introspector, resource, role, secWsProtocol string) echo.MiddlewareFunc {
+	return newKeycloakTokenAuth(introspector, resource, role,
+		"header:Authorization,header:Sec-WebSocket-Protocol,query:token", secWsProtocol)
+}
+
+func newKeycloakTokenAuth(introspector Introspector, resource, role, keyLookup, secWsProtocol string) echo.MiddlewareFunc {
 	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
-		KeyLookup:  "header:Authorization",
+		KeyLookup:  keyLookup,
 		AuthScheme: "Bearer",
 		Validator: func(tokenStr string, eCtx echo.Context) (bool, error) {
+			if secWsProtocol != "" {
+				tokenStr = strings.TrimPrefix(tokenStr, secWsProtocol+", ")
+			}
+
 			token, err := introspector.IntrospectToken(eCtx.Request().Context(), tokenStr)
 			if err != nil {
 				return false, err
@@ -56,6 +77,7 @@ func NewKeycloakTokenAuth(introspector Introspector, resource, role string) echo
 			}
 
 			eCtx.Set(tokenCtxKey, jwtToken)
+			eCtx.Set(rolesCtxKey, tokenClaims.ResourcesAccess[resource].Roles)
 
 			return true, nil
 		},
@@ -74,6 +96,12 @@ func GetUserID(eCtx echo.Context) (types.UserID, bool) {
 	return userID(eCtx)
 }
 
+// GetResourceRoles returns the roles of the user in the resource the middleware checks the access to.
+func GetResourceRoles(eCtx echo.Context) []string {
+	roles, _ := eCtx.Get(rolesCtxKey).([]string)
+	return roles
+}
+
 func userID(eCtx echo.Context) (types.UserID, bool) {
 	t := eCtx.Get(tokenCtxKey)
 	if t == nil {
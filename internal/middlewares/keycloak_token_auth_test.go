@@ -23,6 +23,7 @@ import (
 const (
 	requiredResource = "chat-ui-client"
 	requiredRole     = "support-chat-client"
+	secWsProtocol    = "chat-service-protocol"
 )
 
 func TestNewKeycloakTokenAuth(t *testing.T) {
@@ -34,6 +35,7 @@ type KeycloakTokenAuthSuite struct {
 	ctrl         *gomock.Controller
 	introspector *middlewaresmocks.MockIntrospector
 	authMdlwr    echo.MiddlewareFunc
+	wsAuthMdlwr  echo.MiddlewareFunc
 	req          *http.Request
 	resp         *httptest.ResponseRecorder
 	ctx          echo.Context
@@ -44,6 +46,7 @@ func (s *KeycloakTokenAuthSuite) SetupTest() {
 
 	s.introspector = middlewaresmocks.NewMockIntrospector(s.ctrl)
 	s.authMdlwr = middlewares.NewKeycloakTokenAuth(s.introspector, requiredResource, requiredRole)
+	s.wsAuthMdlwr = middlewares.NewKeycloakWsTokenAuth(s.introspector, requiredResource, requiredRole, secWsProtocol)
 
 	s.req = httptest.NewRequest(http.MethodPost, "/getHistory",
 		bytes.NewBufferString(`{"pageSize": 100, "cursor": ""}`))
@@ -64,14 +67,19 @@ func (s *KeycloakTokenAuthSuite) TestValidToken_AudString() {
 
 	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
 
-	var uid types.UserID
+	var (
+		uid   types.UserID
+		roles []string
+	)
 
 	err := s.authMdlwr(func(c echo.Context) error {
 		uid = middlewares.MustUserID(c)
+		roles = middlewares.GetResourceRoles(c)
 		return nil
 	})(s.ctx)
 	s.Require().NoError(err)
 	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
+	s.Equal([]string{requiredRole}, roles)
 }
 
 func (s *KeycloakTokenAuthSuite) TestValidToken_AudList() {
@@ -92,6 +100,43 @@ func (s *KeycloakTokenAuthSuite) TestValidToken_AudList() {
 	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
 }
 
+func (s *KeycloakTokenAuthSuite) TestValidToken_SecWebSocketProtocol() {
+	//nolint:lll,gosec
+	const token = "eyJhbGciOiJSUzI1NiIsInR5cCIgOiAiSldUIiwia2lkIiA6ICJIR1lJcHN1UXlsZFNJZTB1T0JaeEpuQjBkZlFuTWI5LUlFcmx6NHk5ek9BIn0.eyJleHAiOjI2NjcxOTk1ODAsImlhdCI6MTY2NzE5OTI4MCwiYXV0aF90aW1lIjoxNjY3MTk4OTI4LCJqdGkiOiI5NGQ3ZDBkNS0zZTZmLTQ5NGItYTkzYy1hYjliMDkxMzQ3YmEiLCJpc3MiOiJodHRwOi8vbG9jYWxob3N0OjMwMTAvcmVhbG1zL0JhbmsiLCJhdWQiOiJhY2NvdW50Iiwic3ViIjoiNWNiNDBkYzAtYTI0OS00NzgzLWEzMDEtOWUxZjNjZjNlYTQxIiwidHlwIjoiQmVhcmVyIiwiYXpwIjoiY2hhdC11aS1jbGllbnQiLCJub25jZSI6ImJhMzdmZDVhLThjMzktNDgxNC1hZmNiLTk1MmExOGI3MjY3ZCIsInNlc3Npb25fc3RhdGUiOiJkODZkMTk4ZS1jMWM1LTRlZGQtODM1MC0zNjFlZTU4MTcxZjIiLCJhY3IiOiIwIiwiYWxsb3dlZC1vcmlnaW5zIjpbIiIsIioiXSwicmVhbG1fYWNjZXNzIjp7InJvbGVzIjpbIm9mZmxpbmVfYWNjZXNzIiwiZGVmYXVsdC1yb2xlcy1iYW5rIiwidW1hX2F1dGhvcml6YXRpb24iXX0sInJlc291cmNlX2FjY2VzcyI6eyJjaGF0LXVpLWNsaWVudCI6eyJyb2xlcyI6WyJzdXBwb3J0LWNoYXQtY2xpZW50Il19LCJhY2NvdW50Ijp7InJvbGVzIjpbIm1hbmFnZS1hY2NvdW50IiwibWFuYWdlLWFjY291bnQtbGlua3MiLCJ2aWV3LXByb2ZpbGUiXX19LCJzY29wZSI6Im9wZW5pZCBwcm9maWxlIGVtYWlsIiwic2lkIjoiZDg2ZDE5OGUtYzFjNS00ZWRkLTgzNTAtMzYxZWU1ODE3MWYyIiwiZW1haWxfdmVyaWZpZWQiOnRydWUsInByZWZlcnJlZF91c2VybmFtZSI6ImJvbmQwMDciLCJnaXZlbl9uYW1lIjoiIiwiZmFtaWx5X25hbWUiOiIiLCJlbWFpbCI6ImJvbmQwMDdAdWsuY29tIn0.we-dont-check-signature"
+	s.req = httptest.NewRequest(http.MethodGet, "/ws", nil)
+	s.req.Header.Add("Sec-WebSocket-Protocol", secWsProtocol+", "+token)
+	s.ctx = echo.New().NewContext(s.req, s.resp)
+
+	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
+
+	var uid types.UserID
+
+	err := s.wsAuthMdlwr(func(c echo.Context) error {
+		uid = middlewares.MustUserID(c)
+		return nil
+	})(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
+}
+
+func (s *KeycloakTokenAuthSuite) TestValidToken_QueryParam() {
+	//nolint:lll,gosec
+	const token = "eyJhbGciOiJSUzI1NiIsInR5cCIgOiAiSldUIiwia2lkIiA6ICJIR1lJcHN1UXlsZFNJZTB1T0JaeEpuQjBkZlFuTWI5LUlFcmx6NHk5ek9BIn0.eyJleHAiOjI2NjcxOTk1ODAsImlhdCI6MTY2NzE5OTI4MCwiYXV0aF90aW1lIjoxNjY3MTk4OTI4LCJqdGkiOiI5NGQ3ZDBkNS0zZTZmLTQ5NGItYTkzYy1hYjliMDkxMzQ3YmEiLCJpc3MiOiJodHRwOi8vbG9jYWxob3N0OjMwMTAvcmVhbG1zL0JhbmsiLCJhdWQiOiJhY2NvdW50Iiwic3ViIjoiNWNiNDBkYzAtYTI0OS00NzgzLWEzMDEtOWUxZjNjZjNlYTQxIiwidHlwIjoiQmVhcmVyIiwiYXpwIjoiY2hhdC11aS1jbGllbnQiLCJub25jZSI6ImJhMzdmZDVhLThjMzktNDgxNC1hZmNiLTk1MmExOGI3MjY3ZCIsInNlc3Npb25fc3RhdGUiOiJkODZkMTk4ZS1jMWM1LTRlZGQtODM1MC0zNjFlZTU4MTcxZjIiLCJhY3IiOiIwIiwiYWxsb3dlZC1vcmlnaW5zIjpbIiIsIioiXSwicmVhbG1fYWNjZXNzIjp7InJvbGVzIjpbIm9mZmxpbmVfYWNjZXNzIiwiZGVmYXVsdC1yb2xlcy1iYW5rIiwidW1hX2F1dGhvcml6YXRpb24iXX0sInJlc291cmNlX2FjY2VzcyI6eyJjaGF0LXVpLWNsaWVudCI6eyJyb2xlcyI6WyJzdXBwb3J0LWNoYXQtY2xpZW50Il19LCJhY2NvdW50Ijp7InJvbGVzIjpbIm1hbmFnZS1hY2NvdW50IiwibWFuYWdlLWFjY291bnQtbGlua3MiLCJ2aWV3LXByb2ZpbGUiXX19LCJzY29wZSI6Im9wZW5pZCBwcm9maWxlIGVtYWlsIiwic2lkIjoiZDg2ZDE5OGUtYzFjNS00ZWRkLTgzNTAtMzYxZWU1ODE3MWYyIiwiZW1haWxfdmVyaWZpZWQiOnRydWUsInByZWZlcnJlZF91c2VybmFtZSI6ImJvbmQwMDciLCJnaXZlbl9uYW1lIjoiIiwiZmFtaWx5X25hbWUiOiIiLCJlbWFpbCI6ImJvbmQwMDdAdWsuY29tIn0.we-dont-check-signature"
+	s.req = httptest.NewRequest(http.MethodGet, "/ws?token="+token, nil)
+	s.ctx = echo.New().NewContext(s.req, s.resp)
+
+	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
+
+	var uid types.UserID
+
+	err := s.wsAuthMdlwr(func(c echo.Context) error {
+		uid = middlewares.MustUserID(c)
+		return nil
+	})(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
+}
+
 // Negative.
 
 func (s *KeycloakTokenAuthSuite) TestNoAuthorizationHeader() {
@@ -106,6 +151,31 @@ func (s *KeycloakTokenAuthSuite) TestNoAuthorizationHeader() {
 	s.assertHTTPCode(err, http.StatusBadRequest)
 }
 
+func (s *KeycloakTokenAuthSuite) TestTokenOutsideAuthorizationHeader() {
+	const token = "token"
+
+	s.Run("query param", func() {
+		req := httptest.NewRequest(http.MethodPost, "/getHistory?token="+token, nil)
+
+		err := s.authMdlwr(func(c echo.Context) error {
+			s.Fail("unreachable")
+			return nil
+		})(echo.New().NewContext(req, httptest.NewRecorder()))
+		s.assertHTTPCode(err, http.StatusBadRequest)
+	})
+
+	s.Run("Sec-WebSocket-Protocol header", func() {
+		req := httptest.NewRequest(http.MethodPost, "/getHistory", nil)
+		req.Header.Add("Sec-WebSocket-Protocol", secWsProtocol+", "+token)
+
+		err := s.authMdlwr(func(c echo.Context) error {
+			s.Fail("unreachable")
+			return nil
+		})(echo.New().NewContext(req, httptest.NewRecorder()))
+		s.assertHTTPCode(err, http.StatusBadRequest)
+	})
+}
+
 func (s *KeycloakTokenAuthSuite) TestNotBearerAuth() {
 	//nolint:lll,gosec
 	const token = "eyJhbGciOiJSUzI1NiIsInR5cCIgOiAiSldUIiwia2lkIiA6ICJIR1lJcHN1UXlsZFNJZTB1T0JaeEpuQjBkZlFuTWI5LUlFcmx6NHk5ek9BIn0.eyJleHAiOjI2NjcxOTk1ODAsImlhdCI6MTY2NzE5OTI4MCwiYXV0aF90aW1lIjoxNjY3MTk4OTI4LCJqdGkiOiI5NGQ3ZDBkNS0zZTZmLTQ5NGItYTkzYy1hYjliMDkxMzQ3YmEiLCJpc3MiOiJodHRwOi8vbG9jYWxob3N0OjMwMTAvcmVhbG1zL0JhbmsiLCJhdWQiOlsiY2hhdC11aS1jbGllbnQiLCJhY2NvdW50Il0sInN1YiI6IjVjYjQwZGMwLWEyNDktNDc4My1hMzAxLTllMWYzY2YzZWE0MSIsInR5cCI6IkJlYXJlciIsImF6cCI6ImNoYXQtdWktY2xpZW50Iiwibm9uY2UiOiJiYTM3ZmQ1YS04YzM5LTQ4MTQtYWZjYi05NTJhMThiNzI2N2QiLCJzZXNzaW9uX3N0YXRlIjoiZDg2ZDE5OGUtYzFjNS00ZWRkLTgzNTAtMzYxZWU1ODE3MWYyIiwiYWNyIjoiMCIsImFsbG93ZWQtb3JpZ2lucyI6WyIiLCIqIl0sInJlYWxtX2FjY2VzcyI6eyJyb2xlcyI6WyJvZmZsaW5lX2FjY2VzcyIsImRlZmF1bHQtcm9sZXMtYmFuayIsInVtYV9hdXRob3JpemF0aW9uIl19LCJyZXNvdXJjZV9hY2Nlc3MiOnsiY2hhdC11aS1jbGllbnQiOnsicm9sZXMiOlsic3VwcG9ydC1jaGF0LWNsaWVudCJdfSwiYWNjb3VudCI6eyJyb2xlcyI6WyJtYW5hZ2UtYWNjb3VudCIsIm1hbmFnZS1hY2NvdW50LWxpbmtzIiwidmlldy1wcm9maWxlIl19fSwic2NvcGUiOiJvcGVuaWQgcHJvZmlsZSBlbWFpbCIsInNpZCI6ImQ4NmQxOThlLWMxYzUtNGVkZC04MzUwLTM2MWVlNTgxNzFmMiIsImVtYWlsX3ZlcmlmaWVkIjp0cnVlLCJwcmVmZXJyZWRfdXNlcm5hbWUiOiJib25kMDA3IiwiZ2l2ZW5fbmFtZSI6IiIsImZhbWlseV9uYW1lIjoiIiwiZW1haWwiOiJib25kMDA3QHVrLmNvbSJ9.we-dont-check-signature"
@@ -288,6 +358,13 @@ func (s *KeycloakTokenAuthSuite) assertHTTPCode(err error, code int) {
 	s.Equal(httpErr.Code, code)
 }
 
+func TestGetResourceRoles_NoToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, middlewares.GetResourceRoles(echo.New().NewContext(req, httptest.NewRecorder())))
+}
+
 func TestMustUserID_NoUID(t *testing.T) {
 	req, err := http.NewRequest(http.MethodGet, "/", nil)
 	require.NoError(t, err)
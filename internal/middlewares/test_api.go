@@ -14,3 +14,7 @@ func SetToken(c echo.Context, uid types.UserID) {
 		},
 	})
 }
+
+func SetResourceRoles(c echo.Context, roles ...string) {
+	c.Set(rolesCtxKey, roles)
+}
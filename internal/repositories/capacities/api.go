@@ -0,0 +1,87 @@
+package capacitiesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+var ErrCapacityNotFound = errors.New("manager capacity not found")
+
+type ManagerCapacity struct {
+	ManagerID types.UserID
+	Capacity  int
+	UpdatedAt time.Time
+}
+
+// GetManagerCapacity returns the capacity override of the manager or ErrCapacityNotFound.
+func (r *Repo) GetManagerCapacity(ctx context.Context, managerID types.UserID) (int, error) {
+	c, err := r.db.ManagerCapacity(ctx).Query().
+		Where(managercapacity.ManagerID(managerID)).
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return 0, ErrCapacityNotFound
+		}
+		return 0, fmt.Errorf("query manager capacity: %w", err)
+	}
+
+	return c.Capacity, nil
+}
+
+// GetManagerCapacities returns all the capacity overrides, the recently updated first.
+func (r *Repo) GetManagerCapacities(ctx context.Context) ([]ManagerCapacity, error) {
+	capacities, err := r.db.ManagerCapacity(ctx).Query().
+		Order(store.Desc(managercapacity.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query manager capacities: %w", err)
+	}
+
+	result := make([]ManagerCapacity, 0, len(capacities))
+	for _, c := range capacities {
+		result = append(result, ManagerCapacity{
+			ManagerID: c.ManagerID,
+			Capacity:  c.Capacity,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// SetManagerCapacity creates or replaces the capacity override of the manager.
+func (r *Repo) SetManagerCapacity(ctx context.Context, managerID types.UserID, capacity int) error {
+	err := r.db.ManagerCapacity(ctx).Create().
+		SetManagerID(managerID).
+		SetCapacity(capacity).
+		OnConflictColumns(managercapacity.FieldManagerID).
+		UpdateCapacity().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("upsert manager capacity: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteManagerCapacity removes the capacity override, so the default capacity is applied to the manager.
+func (r *Repo) DeleteManagerCapacity(ctx context.Context, managerID types.UserID) error {
+	n, err := r.db.ManagerCapacity(ctx).Delete().
+		Where(managercapacity.ManagerID(managerID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete manager capacity: %w", err)
+	}
+	if n == 0 {
+		return ErrCapacityNotFound
+	}
+
+	return nil
+}
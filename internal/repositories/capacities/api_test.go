@@ -0,0 +1,95 @@
+//go:build integration
+
+package capacitiesrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type CapacitiesRepoSuite struct {
+	testingh.DBSuite
+	repo *capacitiesrepo.Repo
+}
+
+func TestCapacitiesRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &CapacitiesRepoSuite{DBSuite: testingh.NewDBSuite("TestCapacitiesRepoSuite")})
+}
+
+func (s *CapacitiesRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = capacitiesrepo.New(capacitiesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *CapacitiesRepoSuite) Test_SetManagerCapacity() {
+	managerID := types.NewUserID()
+
+	s.Run("no capacity", func() {
+		_, err := s.repo.GetManagerCapacity(s.Ctx, managerID)
+		s.Require().ErrorIs(err, capacitiesrepo.ErrCapacityNotFound)
+	})
+
+	s.Run("set capacity", func() {
+		err := s.repo.SetManagerCapacity(s.Ctx, managerID, 3)
+		s.Require().NoError(err)
+
+		capacity, err := s.repo.GetManagerCapacity(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(3, capacity)
+	})
+
+	s.Run("replace capacity", func() {
+		err := s.repo.SetManagerCapacity(s.Ctx, managerID, 8)
+		s.Require().NoError(err)
+
+		capacity, err := s.repo.GetManagerCapacity(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(8, capacity)
+	})
+
+	s.Run("invalid capacity", func() {
+		err := s.repo.SetManagerCapacity(s.Ctx, managerID, 0)
+		s.Require().Error(err)
+	})
+
+	s.Run("delete capacity", func() {
+		err := s.repo.DeleteManagerCapacity(s.Ctx, managerID)
+		s.Require().NoError(err)
+
+		_, err = s.repo.GetManagerCapacity(s.Ctx, managerID)
+		s.Require().ErrorIs(err, capacitiesrepo.ErrCapacityNotFound)
+
+		err = s.repo.DeleteManagerCapacity(s.Ctx, managerID)
+		s.Require().ErrorIs(err, capacitiesrepo.ErrCapacityNotFound)
+	})
+}
+
+func (s *CapacitiesRepoSuite) Test_GetManagerCapacities() {
+	// Arrange.
+	m1, m2 := types.NewUserID(), types.NewUserID()
+	s.Require().NoError(s.repo.SetManagerCapacity(s.Ctx, m1, 2))
+	s.Require().NoError(s.repo.SetManagerCapacity(s.Ctx, m2, 10))
+
+	// Action.
+	capacities, err := s.repo.GetManagerCapacities(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+
+	got := make(map[types.UserID]int, len(capacities))
+	for _, c := range capacities {
+		got[c.ManagerID] = c.Capacity
+	}
+	s.Equal(2, got[m1])
+	s.Equal(10, got[m2])
+}
@@ -33,3 +33,12 @@ func (r *Repo) CreateIfNotExists(ctx context.Context, userID types.UserID) (type
 
 	return createdChat.ID, nil
 }
+
+// GetClientID returns the owner of the chat.
+func (r *Repo) GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	c, err := r.db.Chat(ctx).Get(ctx, chatID)
+	if err != nil {
+		return types.UserIDNil, fmt.Errorf("get chat: %w", err)
+	}
+	return c.ClientID, nil
+}
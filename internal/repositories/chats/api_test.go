@@ -53,3 +53,21 @@ func (s *ChatsRepoSuite) Test_CreateIfNotExists() {
 		s.Equal(chat.ID, chatID)
 	})
 }
+
+func (s *ChatsRepoSuite) Test_GetClientID() {
+	s.Run("chat exists", func() {
+		clientID := types.NewUserID()
+
+		chatID, err := s.repo.CreateIfNotExists(s.Ctx, clientID)
+		s.Require().NoError(err)
+
+		gotClientID, err := s.repo.GetClientID(s.Ctx, chatID)
+		s.Require().NoError(err)
+		s.Equal(clientID, gotClientID)
+	})
+
+	s.Run("chat does not exist", func() {
+		_, err := s.repo.GetClientID(s.Ctx, types.NewChatID())
+		s.Require().Error(err)
+	})
+}
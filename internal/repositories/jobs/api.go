@@ -2,94 +2,337 @@ package jobsrepo
 
 import (
 	"context"
+	stdsql "database/sql"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 
 	"github.com/keepcalmist/chat-service/internal/store"
 	"github.com/keepcalmist/chat-service/internal/store/job"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
-var ErrNoJobs = errors.New("no jobs found")
+// JobsChannel is the channel notified when new jobs become available.
+const JobsChannel = "outbox_jobs"
+
+// createUniqueJobAttempts limits the retries of CreateUniqueJob when the job with the same key keeps changing.
+const createUniqueJobAttempts = 3
+
+var (
+	ErrNoJobs            = errors.New("no jobs found")
+	errDedupKeyContended = errors.New("dedup key is contended")
+)
 
 type Job struct {
-	ID       types.JobID
-	Name     string
-	Payload  string
-	Attempts int
+	ID        types.JobID
+	Name      string
+	Payload   string
+	Attempts  int
+	LastError string
+	Requeues  int
 }
 
 func (r *Repo) FindAndReserveJob(ctx context.Context, until time.Time) (Job, error) {
-	retJob := Job{}
+	jobs, err := r.FindAndReserveJobs(ctx, until, 1)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return jobs[0], nil
+}
+
+// FindAndReserveJobs reserves up to limit available jobs in one transaction.
+// The jobs locked by the concurrent reservations are skipped.
+func (r *Repo) FindAndReserveJobs(ctx context.Context, until time.Time, limit int) ([]Job, error) {
+	var retJobs []Job
 	err := r.db.RunInTx(ctx, func(ctx context.Context) error {
-		j, err := r.db.Job(ctx).Query().
+		jobs, err := r.db.Job(ctx).Query().
 			Unique(false).
 			Where(
 				job.And(
 					job.ReservedUntilLT(time.Now()),
 					job.AvailableAtLTE(time.Now()),
+					isFirstWithOrderingKey(),
 				),
 			).
 			Order(job.ByCreatedAt()).
+			Limit(limit).
 			ForUpdate(sql.WithLockAction(sql.SkipLocked)). // нет смысла ждать анлока записи, т.к. она уже выбрана
-			First(ctx)
+			All(ctx)
 		if err != nil {
-			if store.IsNotFound(err) {
-				return ErrNoJobs
-			}
-			return fmt.Errorf("find job err: %w", err)
+			return fmt.Errorf("find jobs err: %w", err)
+		}
+		if len(jobs) == 0 {
+			return ErrNoJobs
 		}
 
-		j, err = j.Update().
-			SetAttempts(j.Attempts + 1).
+		ids := make([]types.JobID, 0, len(jobs))
+		for _, j := range jobs {
+			ids = append(ids, j.ID)
+		}
+
+		if err := r.db.Job(ctx).Update().
+			Where(job.IDIn(ids...)).
+			AddAttempts(1).
 			SetReservedUntil(until).
-			Save(ctx)
-		if err != nil {
-			return fmt.Errorf("update job err: %w", err)
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update jobs err: %w", err)
 		}
-		retJob = Job{
-			ID:       j.ID,
-			Name:     j.Name,
-			Payload:  j.Payload,
-			Attempts: j.Attempts,
+
+		retJobs = make([]Job, 0, len(jobs))
+		for _, j := range jobs {
+			retJobs = append(retJobs, Job{
+				ID:        j.ID,
+				Name:      j.Name,
+				Payload:   j.Payload,
+				Attempts:  j.Attempts + 1,
+				LastError: j.LastError,
+				Requeues:  j.Requeues,
+			})
 		}
 
 		return nil
 	})
 	if err != nil {
 		if errors.Is(err, ErrNoJobs) {
-			return Job{}, fmt.Errorf("find and reserve job err: %w", ErrNoJobs)
+			return nil, fmt.Errorf("find and reserve jobs err: %w", ErrNoJobs)
 		}
 
-		return Job{}, fmt.Errorf("find and reserve job err: %w", err)
+		return nil, fmt.Errorf("find and reserve jobs err: %w", err)
 	}
 
-	return retJob, nil
+	return retJobs, nil
 }
 
 func (r *Repo) CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
-	j, err := r.db.Job(ctx).
+	return r.CreateOrderedJob(ctx, name, payload, "", availableAt)
+}
+
+// CreateOrderedJob creates the job that can't be reserved until all earlier jobs with the same
+// ordering key are gone, i.e. handled or moved to the DLQ. The empty key means no ordering.
+func (r *Repo) CreateOrderedJob(
+	ctx context.Context,
+	name, payload, orderingKey string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	create := r.db.Job(ctx).
 		Create().
 		SetName(name).
 		SetPayload(payload).
-		SetAvailableAt(availableAt).
-		Save(ctx)
+		SetAvailableAt(availableAt)
+	if orderingKey != "" {
+		create.SetOrderingKey(orderingKey)
+	}
+
+	j, err := create.Save(ctx)
 	if err != nil {
 		return types.JobIDNil, fmt.Errorf("create job err: %w", err)
 	}
 
+	if err := r.notifyJobs(ctx); err != nil {
+		return types.JobIDNil, err
+	}
+
 	return j.ID, nil
 }
 
-func (r *Repo) CreateFailedJob(ctx context.Context, name, payload, reason string) error {
+// CreateUniqueJob creates the job unless there is a pending job with the same dedup key.
+// Returns the ID of the existing job and false in this case. The key can be used again
+// once the job is handled or moved to the DLQ.
+func (r *Repo) CreateUniqueJob(
+	ctx context.Context,
+	name, payload, dedupKey string,
+	availableAt time.Time,
+) (types.JobID, bool, error) {
+	// The existing job can be deleted between the insert and the select.
+	for i := 0; i < createUniqueJobAttempts; i++ {
+		jobID, err := r.db.Job(ctx).
+			Create().
+			SetName(name).
+			SetPayload(payload).
+			SetAvailableAt(availableAt).
+			SetDedupKey(dedupKey).
+			OnConflict(sql.ConflictColumns(job.FieldDedupKey), sql.DoNothing()).
+			ID(ctx)
+		if err == nil {
+			if err := r.notifyJobs(ctx); err != nil {
+				return types.JobIDNil, false, err
+			}
+			return jobID, true, nil
+		}
+		if !errors.Is(err, stdsql.ErrNoRows) {
+			return types.JobIDNil, false, fmt.Errorf("create unique job err: %w", err)
+		}
+
+		existing, err := r.db.Job(ctx).Query().Where(job.DedupKey(dedupKey)).Only(ctx)
+		if err == nil {
+			return existing.ID, false, nil
+		}
+		if !store.IsNotFound(err) {
+			return types.JobIDNil, false, fmt.Errorf("get job by dedup key err: %w", err)
+		}
+	}
+
+	return types.JobIDNil, false, fmt.Errorf("create unique job err: %w", errDedupKeyContended)
+}
+
+// CreateJobIfNotScheduled creates the job ordered by its name unless there is another job with the same name
+// besides the except one. The concurrent calls with the same name are serialized with the advisory lock,
+// so the replicas of the service never create two jobs. Returns false if the job is already scheduled.
+func (r *Repo) CreateJobIfNotScheduled(
+	ctx context.Context,
+	name, payload string,
+	availableAt time.Time,
+	except types.JobID,
+) (bool, error) {
+	created := false
+	err := r.db.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := r.db.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", name); err != nil {
+			return fmt.Errorf("lock job name err: %w", err)
+		}
+
+		exists, err := r.db.Job(ctx).Query().
+			Where(job.Name(name), job.IDNEQ(except)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check scheduled job err: %w", err)
+		}
+		if exists {
+			return nil
+		}
+
+		if _, err := r.CreateOrderedJob(ctx, name, payload, name, availableAt); err != nil {
+			return err
+		}
+		created = true
+
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("create job if not scheduled err: %w", err)
+	}
+
+	return created, nil
+}
+
+// NextJobAvailableAt returns the nearest time when one of the jobs can be reserved.
+// The jobs blocked by the earlier ones with the same ordering key are ignored.
+// Returns ErrNoJobs if there are no jobs at all.
+func (r *Repo) NextJobAvailableAt(ctx context.Context) (time.Time, error) {
+	query, args := nextJobAvailableAtQuery()
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query next job available at err: %w", err)
+	}
+	defer rows.Close()
+
+	var availableAt sql.NullTime
+	if rows.Next() {
+		if err := rows.Scan(&availableAt); err != nil {
+			return time.Time{}, fmt.Errorf("scan next job available at err: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, fmt.Errorf("read next job available at err: %w", err)
+	}
+
+	if !availableAt.Valid {
+		return time.Time{}, ErrNoJobs
+	}
+	return availableAt.Time, nil
+}
+
+// nextJobAvailableAtQuery applies the same ordering key filter as FindAndReserveJobs does.
+func nextJobAvailableAtQuery() (string, []any) {
+	s := sql.Dialect(dialect.Postgres).Select().From(sql.Table(job.Table))
+	s.Select(sql.Min(fmt.Sprintf("GREATEST(%s, %s)", s.C(job.FieldAvailableAt), s.C(job.FieldReservedUntil))))
+	isFirstWithOrderingKey()(s)
+	return s.Query()
+}
+
+// isFirstWithOrderingKey filters out the jobs having the earlier created job with the same ordering key.
+// The jobs of the same key created at the same moment are ordered by ID.
+func isFirstWithOrderingKey() predicate.Job {
+	return func(s *sql.Selector) {
+		prev := sql.Table(job.Table).As("prev")
+		s.Where(sql.Or(
+			sql.IsNull(s.C(job.FieldOrderingKey)),
+			sql.NotExists(
+				sql.Select(prev.C(job.FieldID)).
+					From(prev).
+					Where(sql.And(
+						sql.ColumnsEQ(prev.C(job.FieldOrderingKey), s.C(job.FieldOrderingKey)),
+						sql.Or(
+							sql.ColumnsLT(prev.C(job.FieldCreatedAt), s.C(job.FieldCreatedAt)),
+							sql.And(
+								sql.ColumnsEQ(prev.C(job.FieldCreatedAt), s.C(job.FieldCreatedAt)),
+								sql.ColumnsLT(prev.C(job.FieldID), s.C(job.FieldID)),
+							),
+						),
+					)),
+			),
+		))
+	}
+}
+
+// notifyJobs wakes up the listeners of JobsChannel.
+// Inside a transaction the notification is delivered on commit only.
+func (r *Repo) notifyJobs(ctx context.Context) error {
+	if _, err := r.db.Exec(ctx, "NOTIFY "+JobsChannel); err != nil {
+		return fmt.Errorf("notify jobs err: %w", err)
+	}
+
+	return nil
+}
+
+// RescheduleJob releases the reservation of the failed job and postpones it until availableAt.
+// The lastError is kept to become the reason of the failed job when the attempts run out.
+func (r *Repo) RescheduleJob(ctx context.Context, jobID types.JobID, availableAt time.Time, lastError string) error {
+	if err := r.db.Job(ctx).
+		UpdateOneID(jobID).
+		SetAvailableAt(availableAt).
+		SetReservedUntil(time.Now()).
+		SetLastError(lastError).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("reschedule job err: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseJob resets the reservation of the interrupted job, so it can be reserved again at once.
+// The attempts are kept. It is no-op if the job is already deleted.
+func (r *Repo) ReleaseJob(ctx context.Context, jobID types.JobID) error {
+	if err := r.db.Job(ctx).
+		Update().
+		Where(job.ID(jobID)).
+		SetReservedUntil(time.Now()).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("release job err: %w", err)
+	}
+
+	return nil
+}
+
+// CreateFailedJob moves the job to the DLQ. The requeues is the number of times
+// the job has already been requeued from the DLQ. The jobID links the failed job with the attempts history.
+func (r *Repo) CreateFailedJob(
+	ctx context.Context,
+	jobID types.JobID,
+	name, payload, reason string,
+	requeues int,
+) error {
 	if err := r.db.FailedJob(ctx).
 		Create().
+		SetJobID(jobID).
 		SetName(name).
 		SetPayload(payload).
 		SetReason(reason).
+		SetRequeues(requeues).
 		Exec(ctx); err != nil {
 		return fmt.Errorf("create failed job err: %w", err)
 	}
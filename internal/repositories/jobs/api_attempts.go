@@ -0,0 +1,92 @@
+package jobsrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type JobAttemptOutcome string
+
+const (
+	JobAttemptSucceeded   JobAttemptOutcome = JobAttemptOutcome(jobattempt.OutcomeSucceeded)
+	JobAttemptFailed      JobAttemptOutcome = JobAttemptOutcome(jobattempt.OutcomeFailed)
+	JobAttemptTimedOut    JobAttemptOutcome = JobAttemptOutcome(jobattempt.OutcomeTimedOut)
+	JobAttemptInterrupted JobAttemptOutcome = JobAttemptOutcome(jobattempt.OutcomeInterrupted)
+)
+
+type JobAttempt struct {
+	ID         types.JobAttemptID
+	JobID      types.JobID
+	JobName    string
+	Attempt    int
+	Worker     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Outcome    JobAttemptOutcome
+	Error      string
+}
+
+// CreateJobAttempt records the attempt to handle the job. The ID of the attempt is ignored.
+func (r *Repo) CreateJobAttempt(ctx context.Context, a JobAttempt) error {
+	if err := r.db.JobAttempt(ctx).
+		Create().
+		SetJobID(a.JobID).
+		SetJobName(a.JobName).
+		SetAttempt(a.Attempt).
+		SetWorker(a.Worker).
+		SetStartedAt(a.StartedAt).
+		SetFinishedAt(a.FinishedAt).
+		SetOutcome(jobattempt.Outcome(a.Outcome)).
+		SetError(a.Error).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("create job attempt err: %w", err)
+	}
+
+	return nil
+}
+
+// GetJobAttempts returns the attempts of the job in order they were made.
+func (r *Repo) GetJobAttempts(ctx context.Context, jobID types.JobID) ([]JobAttempt, error) {
+	attempts, err := r.db.JobAttempt(ctx).Query().
+		Where(jobattempt.JobID(jobID)).
+		Order(store.Asc(jobattempt.FieldAttempt), store.Asc(jobattempt.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query job attempts err: %w", err)
+	}
+
+	result := make([]JobAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		result = append(result, JobAttempt{
+			ID:         a.ID,
+			JobID:      a.JobID,
+			JobName:    a.JobName,
+			Attempt:    a.Attempt,
+			Worker:     a.Worker,
+			StartedAt:  a.StartedAt,
+			FinishedAt: a.FinishedAt,
+			Outcome:    JobAttemptOutcome(a.Outcome),
+			Error:      a.Error,
+		})
+	}
+
+	return result, nil
+}
+
+// DeleteJobAttemptsBefore prunes the attempts started before the cutoff.
+// Returns the number of deleted attempts.
+func (r *Repo) DeleteJobAttemptsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := r.db.JobAttempt(ctx).Delete().
+		Where(jobattempt.StartedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete job attempts err: %w", err)
+	}
+
+	return n, nil
+}
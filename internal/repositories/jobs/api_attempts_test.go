@@ -0,0 +1,79 @@
+//go:build integration
+
+package jobsrepo_test
+
+import (
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func (s *JobsRepoSuite) Test_GetJobAttempts() {
+	// Arrange.
+	jobID := types.NewJobID()
+	now := time.Now()
+
+	second := s.newJobAttempt(jobID, 2, now.Add(-time.Minute))
+	second.Outcome = jobsrepo.JobAttemptSucceeded
+	second.Error = ""
+	first := s.newJobAttempt(jobID, 1, now.Add(-time.Hour))
+	another := s.newJobAttempt(types.NewJobID(), 1, now)
+
+	for _, a := range []jobsrepo.JobAttempt{second, first, another} {
+		s.Require().NoError(s.repo.CreateJobAttempt(s.Ctx, a))
+	}
+
+	// Action.
+	attempts, err := s.repo.GetJobAttempts(s.Ctx, jobID)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(attempts, 2)
+
+	for i, expected := range []jobsrepo.JobAttempt{first, second} {
+		actual := attempts[i]
+		s.NotEmpty(actual.ID)
+		s.Equal(expected.JobID, actual.JobID)
+		s.Equal(expected.JobName, actual.JobName)
+		s.Equal(expected.Attempt, actual.Attempt)
+		s.Equal(expected.Worker, actual.Worker)
+		s.WithinDuration(expected.StartedAt, actual.StartedAt, time.Millisecond)
+		s.WithinDuration(expected.FinishedAt, actual.FinishedAt, time.Millisecond)
+		s.Equal(expected.Outcome, actual.Outcome)
+		s.Equal(expected.Error, actual.Error)
+	}
+}
+
+func (s *JobsRepoSuite) Test_DeleteJobAttemptsBefore() {
+	// Arrange.
+	jobID := types.NewJobID()
+	now := time.Now()
+	s.Require().NoError(s.repo.CreateJobAttempt(s.Ctx, s.newJobAttempt(jobID, 1, now.Add(-48*time.Hour))))
+	s.Require().NoError(s.repo.CreateJobAttempt(s.Ctx, s.newJobAttempt(jobID, 2, now.Add(-time.Hour))))
+
+	// Action.
+	deleted, err := s.repo.DeleteJobAttemptsBefore(s.Ctx, now.Add(-24*time.Hour))
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(1, deleted)
+
+	attempts, err := s.repo.GetJobAttempts(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Require().Len(attempts, 1)
+	s.Equal(2, attempts[0].Attempt)
+}
+
+func (s *JobsRepoSuite) newJobAttempt(jobID types.JobID, attempt int, startedAt time.Time) jobsrepo.JobAttempt {
+	return jobsrepo.JobAttempt{
+		JobID:      jobID,
+		JobName:    name,
+		Attempt:    attempt,
+		Worker:     "host/0",
+		StartedAt:  startedAt,
+		FinishedAt: startedAt.Add(time.Second),
+		Outcome:    jobsrepo.JobAttemptFailed,
+		Error:      "kafka is down",
+	}
+}
@@ -0,0 +1,132 @@
+package jobsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/failedjob"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const defaultFailedJobsLimit = 100
+
+var ErrFailedJobNotFound = errors.New("failed job not found")
+
+type FailedJob struct {
+	ID        types.FailedJobID
+	Name      string
+	Payload   string
+	Reason    string
+	Requeues  int
+	JobID     types.JobID
+	CreatedAt time.Time
+}
+
+// FailedJobsFilter narrows the failed jobs down. Zero fields are ignored.
+type FailedJobsFilter struct {
+	Name  string
+	From  time.Time
+	To    time.Time
+	Limit int
+}
+
+// GetFailedJobs returns the failed jobs matching the filter, the newest first.
+func (r *Repo) GetFailedJobs(ctx context.Context, filter FailedJobsFilter) ([]FailedJob, error) {
+	predicates := make([]predicate.FailedJob, 0, 3)
+	if filter.Name != "" {
+		predicates = append(predicates, failedjob.Name(filter.Name))
+	}
+	if !filter.From.IsZero() {
+		predicates = append(predicates, failedjob.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, failedjob.CreatedAtLT(filter.To))
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultFailedJobsLimit
+	}
+
+	jobs, err := r.db.FailedJob(ctx).Query().
+		Where(predicates...).
+		Order(store.Desc(failedjob.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query failed jobs err: %w", err)
+	}
+
+	result := make([]FailedJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, FailedJob{
+			ID:        j.ID,
+			Name:      j.Name,
+			Payload:   j.Payload,
+			Reason:    j.Reason,
+			Requeues:  j.Requeues,
+			JobID:     j.JobID,
+			CreatedAt: j.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// RequeueFailedJobs moves the failed jobs back to the jobs with the attempts reset.
+// The requeue is counted in the new job, so it is seen how often the payload bounced.
+// Returns ErrFailedJobNotFound if any of the failed jobs doesn't exist, nothing is requeued in this case.
+func (r *Repo) RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error) {
+	jobIDs := make([]types.JobID, 0, len(ids))
+
+	err := r.db.RunInTx(ctx, func(ctx context.Context) error {
+		for _, id := range ids {
+			fJob, err := r.db.FailedJob(ctx).Get(ctx, id)
+			if err != nil {
+				if store.IsNotFound(err) {
+					return fmt.Errorf("%w: %s", ErrFailedJobNotFound, id)
+				}
+				return fmt.Errorf("get failed job err: %w", err)
+			}
+
+			j, err := r.db.Job(ctx).Create().
+				SetName(fJob.Name).
+				SetPayload(fJob.Payload).
+				SetAvailableAt(time.Now()).
+				SetRequeues(fJob.Requeues + 1).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create job err: %w", err)
+			}
+
+			if err := r.db.FailedJob(ctx).DeleteOneID(id).Exec(ctx); err != nil {
+				return fmt.Errorf("delete failed job err: %w", err)
+			}
+
+			jobIDs = append(jobIDs, j.ID)
+		}
+		return r.notifyJobs(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("requeue failed jobs err: %w", err)
+	}
+
+	return jobIDs, nil
+}
+
+// DeleteFailedJobsBefore purges the failed jobs created before the cutoff.
+// Returns the number of deleted jobs.
+func (r *Repo) DeleteFailedJobsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := r.db.FailedJob(ctx).Delete().
+		Where(failedjob.CreatedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete failed jobs err: %w", err)
+	}
+
+	return n, nil
+}
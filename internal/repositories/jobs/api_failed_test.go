@@ -0,0 +1,139 @@
+//go:build integration
+
+package jobsrepo_test
+
+import (
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func (s *JobsRepoSuite) Test_GetFailedJobs() {
+	// Arrange.
+	now := time.Now()
+	old := s.createFailedJob("old", now.Add(-48*time.Hour))
+	recent := s.createFailedJob("recent", now.Add(-time.Hour))
+	anotherName := s.createFailedJob("another", now.Add(-time.Hour))
+
+	s.Run("no filter, the newest first", func() {
+		jobs, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{})
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 3)
+		s.Equal(old, jobs[2].ID)
+	})
+
+	s.Run("by name", func() {
+		jobs, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{Name: "another"})
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 1)
+		s.Equal(anotherName, jobs[0].ID)
+		s.Equal("another", jobs[0].Name)
+		s.Equal(payload, jobs[0].Payload)
+		s.Equal(reason, jobs[0].Reason)
+	})
+
+	s.Run("by time range", func() {
+		jobs, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{
+			From: now.Add(-72 * time.Hour),
+			To:   now.Add(-24 * time.Hour),
+		})
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 1)
+		s.Equal(old, jobs[0].ID)
+	})
+
+	s.Run("by name and time range", func() {
+		jobs, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{
+			Name: "recent",
+			From: now.Add(-2 * time.Hour),
+		})
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 1)
+		s.Equal(recent, jobs[0].ID)
+	})
+
+	s.Run("limit", func() {
+		jobs, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{Limit: 2})
+		s.Require().NoError(err)
+		s.Len(jobs, 2)
+	})
+}
+
+func (s *JobsRepoSuite) Test_RequeueFailedJobs() {
+	// Arrange.
+	first := s.createFailedJob(name, time.Now())
+	second := s.createFailedJob(name, time.Now())
+
+	// Action.
+	jobIDs, err := s.repo.RequeueFailedJobs(s.Ctx, []types.FailedJobID{first, second})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(jobIDs, 2)
+	s.Equal(0, s.Database.FailedJob(s.Ctx).Query().CountX(s.Ctx))
+
+	for _, id := range jobIDs {
+		j := s.Database.Job(s.Ctx).GetX(s.Ctx, id)
+		s.Equal(name, j.Name)
+		s.Equal(payload, j.Payload)
+		s.Equal(0, j.Attempts)
+		s.Equal(1, j.Requeues)
+	}
+
+	s.Run("requeue is counted on the next failure", func() {
+		j, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+		s.Require().NoError(err)
+		s.Equal(1, j.Requeues)
+
+		s.Require().NoError(s.repo.CreateFailedJob(s.Ctx, j.ID, j.Name, j.Payload, reason, j.Requeues))
+		fJob := s.Database.FailedJob(s.Ctx).Query().OnlyX(s.Ctx)
+
+		jobIDs, err := s.repo.RequeueFailedJobs(s.Ctx, []types.FailedJobID{fJob.ID})
+		s.Require().NoError(err)
+		s.Equal(2, s.Database.Job(s.Ctx).GetX(s.Ctx, jobIDs[0]).Requeues)
+	})
+}
+
+func (s *JobsRepoSuite) Test_RequeueFailedJobs_NotFound() {
+	// Arrange.
+	existing := s.createFailedJob(name, time.Now())
+
+	// Action.
+	_, err := s.repo.RequeueFailedJobs(s.Ctx, []types.FailedJobID{existing, types.NewFailedJobID()})
+
+	// Assert.
+	s.Require().ErrorIs(err, jobsrepo.ErrFailedJobNotFound)
+	s.Equal(1, s.Database.FailedJob(s.Ctx).Query().CountX(s.Ctx))
+	s.Equal(0, s.Database.Job(s.Ctx).Query().CountX(s.Ctx))
+}
+
+func (s *JobsRepoSuite) Test_DeleteFailedJobsBefore() {
+	// Arrange.
+	now := time.Now()
+	s.createFailedJob(name, now.Add(-48*time.Hour))
+	s.createFailedJob(name, now.Add(-25*time.Hour))
+	kept := s.createFailedJob(name, now.Add(-time.Hour))
+
+	// Action.
+	n, err := s.repo.DeleteFailedJobsBefore(s.Ctx, now.Add(-24*time.Hour))
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(2, n)
+	s.Equal(kept, s.Database.FailedJob(s.Ctx).Query().OnlyIDX(s.Ctx))
+}
+
+func (s *JobsRepoSuite) createFailedJob(jobName string, createdAt time.Time) types.FailedJobID {
+	s.T().Helper()
+
+	fJob, err := s.Database.FailedJob(s.Ctx).Create().
+		SetName(jobName).
+		SetPayload(payload).
+		SetReason(reason).
+		SetCreatedAt(createdAt).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return fJob.ID
+}
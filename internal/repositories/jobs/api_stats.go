@@ -0,0 +1,55 @@
+package jobsrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/job"
+)
+
+// QueueDepth is the number of jobs with the same name, including the reserved and postponed ones.
+type QueueDepth struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// GetQueueDepths returns the number of jobs per job name.
+func (r *Repo) GetQueueDepths(ctx context.Context) ([]QueueDepth, error) {
+	var depths []QueueDepth
+	if err := r.db.Job(ctx).Query().
+		GroupBy(job.FieldName).
+		Aggregate(store.Count()).
+		Scan(ctx, &depths); err != nil {
+		return nil, fmt.Errorf("get queue depths err: %w", err)
+	}
+
+	return depths, nil
+}
+
+// OldestPendingJobAvailableAt returns the moment since which the oldest pending job waits to be handled.
+// Returns ErrNoJobs if there are no pending jobs.
+func (r *Repo) OldestPendingJobAvailableAt(ctx context.Context) (time.Time, error) {
+	j, err := r.db.Job(ctx).Query().
+		Where(job.AvailableAtLTE(time.Now())).
+		Order(job.ByAvailableAt()).
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return time.Time{}, ErrNoJobs
+		}
+		return time.Time{}, fmt.Errorf("get oldest pending job err: %w", err)
+	}
+
+	return j.AvailableAt, nil
+}
+
+func (r *Repo) CountFailedJobs(ctx context.Context) (int, error) {
+	n, err := r.db.FailedJob(ctx).Query().Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count failed jobs err: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,63 @@
+//go:build integration
+
+package jobsrepo_test
+
+import (
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+)
+
+func (s *JobsRepoSuite) Test_GetQueueDepths() {
+	// Arrange.
+	for i := 0; i < 3; i++ {
+		_, err := s.repo.CreateJob(s.Ctx, "first", payload, availableAt)
+		s.Require().NoError(err)
+	}
+	_, err := s.repo.CreateJob(s.Ctx, "second", payload, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Action.
+	depths, err := s.repo.GetQueueDepths(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.ElementsMatch([]jobsrepo.QueueDepth{
+		{Name: "first", Count: 3},
+		{Name: "second", Count: 1},
+	}, depths)
+}
+
+func (s *JobsRepoSuite) Test_OldestPendingJobAvailableAt() {
+	// Arrange.
+	_, err := s.repo.OldestPendingJobAvailableAt(s.Ctx)
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+
+	oldest := time.Now().Add(-time.Hour)
+	_, err = s.repo.CreateJob(s.Ctx, name, payload, time.Now().Add(-time.Minute))
+	s.Require().NoError(err)
+	_, err = s.repo.CreateJob(s.Ctx, name, payload, oldest)
+	s.Require().NoError(err)
+	_, err = s.repo.CreateJob(s.Ctx, name, payload, time.Now().Add(time.Hour)) // Not pending yet.
+	s.Require().NoError(err)
+
+	// Action.
+	availableAt, err := s.repo.OldestPendingJobAvailableAt(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.WithinDuration(oldest, availableAt, time.Millisecond)
+}
+
+func (s *JobsRepoSuite) Test_CountFailedJobs() {
+	// Arrange.
+	s.createFailedJob(name, time.Now())
+	s.createFailedJob(name, time.Now())
+
+	// Action.
+	n, err := s.repo.CountFailedJobs(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(2, n)
+}
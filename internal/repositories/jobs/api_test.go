@@ -3,6 +3,7 @@
 package jobsrepo_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -47,6 +48,7 @@ func (s *JobsRepoSuite) SetupTest() {
 	s.DBSuite.SetupTest()
 	s.Database.Job(s.Ctx).Delete().ExecX(s.Ctx)
 	s.Database.FailedJob(s.Ctx).Delete().ExecX(s.Ctx)
+	s.Database.JobAttempt(s.Ctx).Delete().ExecX(s.Ctx)
 }
 
 func (s *JobsRepoSuite) Test_FindAndReserveJob_JobFoundAndReserved() {
@@ -117,6 +119,83 @@ func (s *JobsRepoSuite) Test_FindAndReserveJob_SkipReservedJob() {
 	s.ElementsMatch(expected, actual)
 }
 
+func (s *JobsRepoSuite) Test_FindAndReserveJobs_Batch() {
+	// Arrange.
+	const jobs = 5
+	const limit = 3
+
+	created := make([]types.JobID, jobs)
+	for i := 0; i < jobs; i++ {
+		jobID, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+		created[i] = jobID
+	}
+
+	// Action.
+	first, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), limit)
+	s.Require().NoError(err)
+
+	second, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), limit)
+	s.Require().NoError(err)
+
+	_, err = s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), limit)
+
+	// Assert.
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+	s.Len(first, limit)
+	s.Len(second, jobs-limit)
+
+	actual := make([]types.JobID, 0, jobs)
+	for _, j := range append(first, second...) {
+		s.Equal(1, j.Attempts)
+		s.Equal(payload, j.Payload)
+
+		dbJob, err := s.Database.Job(s.Ctx).Get(s.Ctx, j.ID)
+		s.Require().NoError(err)
+		s.Equal(1, dbJob.Attempts)
+		s.True(dbJob.ReservedUntil.After(time.Now()))
+
+		actual = append(actual, j.ID)
+	}
+	s.ElementsMatch(created, actual)
+}
+
+func (s *JobsRepoSuite) Test_FindAndReserveJobs_OrderingKey() {
+	// Arrange.
+	firstA, err := s.repo.CreateOrderedJob(s.Ctx, name, payload, "a", availableAt)
+	s.Require().NoError(err)
+	secondA, err := s.repo.CreateOrderedJob(s.Ctx, name, payload, "a", availableAt)
+	s.Require().NoError(err)
+	firstB, err := s.repo.CreateOrderedJob(s.Ctx, name, payload, "b", availableAt)
+	s.Require().NoError(err)
+	unordered, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+
+	// Action.
+	jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+
+	// Assert.
+	s.Require().NoError(err)
+	actual := make([]types.JobID, 0, len(jobs))
+	for _, j := range jobs {
+		actual = append(actual, j.ID)
+	}
+	s.ElementsMatch([]types.JobID{firstA, firstB, unordered}, actual)
+
+	// The reserved head still blocks the successor.
+	_, err = s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+
+	// Action.
+	s.Require().NoError(s.repo.DeleteJob(s.Ctx, firstA))
+	jobs, err = s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 1)
+	s.Equal(secondA, jobs[0].ID)
+}
+
 func (s *JobsRepoSuite) Test_FindAndReserveJob_SkipDelayedJob() {
 	{
 		// Arrange.
@@ -189,8 +268,237 @@ func (s *JobsRepoSuite) Test_CreateJob_Multiple() {
 	s.Equal(jobs, count)
 }
 
+func (s *JobsRepoSuite) Test_CreateJob_NotifiesListeners() {
+	// Arrange.
+	listener, err := store.NewPSQLListener(s.Store, jobsrepo.JobsChannel)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	notified := make(chan struct{}, 1)
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- listener.Listen(ctx, func() {
+			select {
+			case notified <- struct{}{}:
+			default:
+			}
+		})
+	}()
+	time.Sleep(100 * time.Millisecond) // Let the listener subscribe.
+
+	// Action.
+	err = s.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		_, err := s.repo.CreateJob(ctx, name, payload, availableAt)
+		return err
+	})
+	s.Require().NoError(err)
+
+	// Assert.
+	select {
+	case <-notified:
+	case <-time.After(time.Second):
+		s.Fail("listener was not notified")
+	}
+
+	cancel()
+	s.ErrorIs(<-listenErr, context.Canceled)
+}
+
+func (s *JobsRepoSuite) Test_CreateUniqueJob() {
+	const dedupKey = "message-1"
+
+	// Action.
+	firstID, created, err := s.repo.CreateUniqueJob(s.Ctx, name, payload, dedupKey, availableAt)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.True(created)
+	s.NotEmpty(firstID)
+
+	// Action.
+	var secondID types.JobID
+	err = s.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		secondID, created, err = s.repo.CreateUniqueJob(ctx, name, payload, dedupKey, availableAt)
+		if err != nil {
+			return err
+		}
+
+		// The duplicate doesn't break the transaction.
+		_, err = s.repo.CreateJob(ctx, name, payload, availableAt)
+		return err
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.False(created)
+	s.Equal(firstID, secondID)
+	s.Equal(2, s.Database.Job(s.Ctx).Query().CountX(s.Ctx))
+
+	// Action.
+	s.Require().NoError(s.repo.DeleteJob(s.Ctx, firstID))
+	thirdID, created, err := s.repo.CreateUniqueJob(s.Ctx, name, payload, dedupKey, availableAt)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.True(created)
+	s.NotEqual(firstID, thirdID)
+}
+
+func (s *JobsRepoSuite) Test_CreateJobIfNotScheduled() {
+	// Action.
+	const concurrent = 5
+
+	created := make([]bool, concurrent)
+	wg, ctx := errgroup.WithContext(s.Ctx)
+	for i := 0; i < concurrent; i++ {
+		i := i
+		wg.Go(func() error {
+			var err error
+			created[i], err = s.repo.CreateJobIfNotScheduled(ctx, name, payload, availableAt, types.JobIDNil)
+			return err
+		})
+	}
+	s.Require().NoError(wg.Wait())
+
+	// Assert.
+	s.ElementsMatch([]bool{true, false, false, false, false}, created)
+
+	current, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+
+	// Action.
+	ok, err := s.repo.CreateJobIfNotScheduled(s.Ctx, name, payload, availableAt, current.ID)
+	s.Require().NoError(err)
+	s.True(ok)
+
+	ok, err = s.repo.CreateJobIfNotScheduled(s.Ctx, name, payload, availableAt, current.ID)
+	s.Require().NoError(err)
+	s.False(ok)
+
+	// Assert.
+	s.Equal(2, s.Database.Job(s.Ctx).Query().CountX(s.Ctx))
+}
+
+func (s *JobsRepoSuite) Test_NextJobAvailableAt() {
+	// Arrange.
+	_, err := s.repo.NextJobAvailableAt(s.Ctx)
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+
+	soon := time.Now().Add(time.Minute)
+	reservedUntil := time.Now().Add(30 * time.Second)
+
+	_, err = s.repo.CreateJob(s.Ctx, name, payload, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = s.repo.CreateJob(s.Ctx, name, payload, soon)
+	s.Require().NoError(err)
+	reserved := s.Database.Job(s.Ctx).Create().
+		SetName(name).
+		SetPayload(payload).
+		SetAvailableAt(time.Now()).
+		SetReservedUntil(reservedUntil).
+		SaveX(s.Ctx)
+	s.Require().NotEmpty(reserved.ID)
+
+	// Action.
+	next, err := s.repo.NextJobAvailableAt(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.WithinDuration(reservedUntil, next, time.Millisecond)
+
+	// Action.
+	s.Require().NoError(s.repo.DeleteJob(s.Ctx, reserved.ID))
+	next, err = s.repo.NextJobAvailableAt(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.WithinDuration(soon, next, time.Millisecond)
+}
+
+func (s *JobsRepoSuite) Test_NextJobAvailableAt_OrderingKey() {
+	// Arrange.
+	const orderingKey = "chat-1"
+	backoffUntil := time.Now().Add(time.Minute)
+
+	_, err := s.repo.CreateOrderedJob(s.Ctx, name, payload, orderingKey, backoffUntil)
+	s.Require().NoError(err)
+	_, err = s.repo.CreateOrderedJob(s.Ctx, name, payload, orderingKey, time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	next, err := s.repo.NextJobAvailableAt(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.WithinDuration(backoffUntil, next, time.Millisecond)
+}
+
+func (s *JobsRepoSuite) Test_RescheduleJob() {
+	// Arrange.
+	const lastError = "kafka is down"
+
+	jobID, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+
+	job, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Require().Equal(jobID, job.ID)
+
+	// Action.
+	err = s.repo.RescheduleJob(s.Ctx, jobID, time.Now().Add(time.Second), lastError)
+	s.Require().NoError(err)
+
+	// Assert.
+	_, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs) // Not available yet.
+
+	s.Eventually(func() bool {
+		job, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+		return err == nil
+	}, 3*time.Second, 100*time.Millisecond) // The reservation is released, no need to wait for it.
+	s.Equal(jobID, job.ID)
+	s.Equal(2, job.Attempts)
+	s.Equal(lastError, job.LastError)
+}
+
+func (s *JobsRepoSuite) Test_ReleaseJob() {
+	// Arrange.
+	jobID, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+
+	job, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Require().Equal(jobID, job.ID)
+
+	// Action.
+	err = s.repo.ReleaseJob(s.Ctx, jobID)
+	s.Require().NoError(err)
+
+	// Assert.
+	job, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Equal(jobID, job.ID)
+	s.Equal(2, job.Attempts)
+}
+
+func (s *JobsRepoSuite) Test_ReleaseJob_Deleted() {
+	// Arrange.
+	jobID, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.DeleteJob(s.Ctx, jobID))
+
+	// Action.
+	err = s.repo.ReleaseJob(s.Ctx, jobID)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
 func (s *JobsRepoSuite) Test_CreateFailedJob() {
-	err := s.repo.CreateFailedJob(s.Ctx, name, payload, reason)
+	jobID := types.NewJobID()
+	err := s.repo.CreateFailedJob(s.Ctx, jobID, name, payload, reason, 0)
 
 	// Assert.
 	s.Require().NoError(err)
@@ -203,6 +511,7 @@ func (s *JobsRepoSuite) Test_CreateFailedJob() {
 	s.Equal(name, fJob.Name)
 	s.Equal(payload, fJob.Payload)
 	s.Equal(reason, fJob.Reason)
+	s.Equal(jobID, fJob.JobID)
 }
 
 func (s *JobsRepoSuite) Test_CreateFailedJob_Multiple() {
@@ -211,7 +520,7 @@ func (s *JobsRepoSuite) Test_CreateFailedJob_Multiple() {
 
 	// Action.
 	for i := 0; i < fJobs; i++ {
-		err := s.repo.CreateFailedJob(s.Ctx, name, payload, reason)
+		err := s.repo.CreateFailedJob(s.Ctx, types.NewJobID(), name, payload, reason, 0)
 		s.Require().NoError(err)
 	}
 
@@ -3,6 +3,8 @@ package messagesrepo
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 
@@ -12,7 +14,10 @@ import (
 	"github.com/keepcalmist/chat-service/pkg/pointer"
 )
 
-var ErrMsgNotFound = errors.New("message not found")
+var (
+	ErrMsgNotFound       = errors.New("message not found")
+	ErrMsgAlreadyChecked = errors.New("message already checked")
+)
 
 func (r *Repo) GetMessageByRequestID(ctx context.Context, reqID types.RequestID) (*Message, error) {
 	msg, err := r.db.Message(ctx).
@@ -68,3 +73,118 @@ func (r *Repo) CreateClientVisible(
 
 	return r.GetMessageByRequestID(ctx, reqID)
 }
+
+// CreateFullVisible creates a message that is visible to both the client and the manager.
+func (r *Repo) CreateFullVisible(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	msgBody string,
+) (*Message, error) {
+	err := r.db.Message(ctx).Create().
+		SetInitialRequestID(reqID).
+		SetProblemID(problemID).
+		SetChatID(chatID).
+		SetAuthorID(authorID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		OnConflict(sql.DoNothing()).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMessageByRequestID(ctx, reqID)
+}
+
+// CreateServiceMessageForClient creates a service message that is visible only to the client.
+func (r *Repo) CreateServiceMessageForClient(
+	ctx context.Context,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	msgBody string,
+) (types.MessageID, error) {
+	msg, err := r.db.Message(ctx).Create().
+		SetInitialRequestID(types.NewRequestID()).
+		SetProblemID(problemID).
+		SetChatID(chatID).
+		SetBody(msgBody).
+		SetIsService(true).
+		SetIsVisibleForClient(true).
+		Save(ctx)
+	if err != nil {
+		return types.MessageIDNil, err
+	}
+
+	return msg.ID, nil
+}
+
+// MarkAsVisibleForManager marks the message from the chat as checked by AFC and visible to the manager.
+// Returns ErrMsgNotFound if there is no such message and ErrMsgAlreadyChecked if AFC has already checked it.
+func (r *Repo) MarkAsVisibleForManager(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).
+		Update().
+		Where(
+			message.ID(msgID),
+			message.ChatID(chatID),
+			message.CheckedAtIsNil(),
+		).
+		SetIsVisibleForManager(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update message visibility: %w", err)
+	}
+
+	if n == 0 {
+		return r.notCheckedMessageErr(ctx, chatID, msgID)
+	}
+
+	return nil
+}
+
+// BlockMessage marks the message from the chat as checked by AFC and blocked.
+// Returns ErrMsgNotFound if there is no such message and ErrMsgAlreadyChecked if AFC has already checked it.
+func (r *Repo) BlockMessage(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).
+		Update().
+		Where(
+			message.ID(msgID),
+			message.ChatID(chatID),
+			message.CheckedAtIsNil(),
+		).
+		SetIsBlocked(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update message blocked: %w", err)
+	}
+
+	if n == 0 {
+		return r.notCheckedMessageErr(ctx, chatID, msgID)
+	}
+
+	return nil
+}
+
+// notCheckedMessageErr explains why the message of the chat hasn't been updated by the AFC verdict.
+func (r *Repo) notCheckedMessageErr(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error {
+	exists, err := r.db.Message(ctx).
+		Query().
+		Where(
+			message.ID(msgID),
+			message.ChatID(chatID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check message existence: %w", err)
+	}
+
+	if exists {
+		return ErrMsgAlreadyChecked
+	}
+	return ErrMsgNotFound
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/keepcalmist/chat-service/internal/store"
 	"github.com/keepcalmist/chat-service/internal/store/chat"
 	"github.com/keepcalmist/chat-service/internal/store/message"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+	"github.com/keepcalmist/chat-service/internal/store/problem"
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
@@ -32,6 +34,31 @@ func (r *Repo) GetClientChatMessages(
 	clientID types.UserID,
 	pageSize int,
 	cursor *Cursor,
+) ([]Message, *Cursor, error) {
+	return r.getMessagesPage(ctx, pageSize, cursor,
+		message.HasChatWith(chat.ClientIDEQ(clientID)),
+		message.IsVisibleForClientEQ(true),
+	)
+}
+
+// GetProblemMessages returns Nth page of messages of the problem for manager side.
+func (r *Repo) GetProblemMessages(
+	ctx context.Context,
+	problemID types.ProblemID,
+	pageSize int,
+	cursor *Cursor,
+) ([]Message, *Cursor, error) {
+	return r.getMessagesPage(ctx, pageSize, cursor,
+		message.HasProblemWith(problem.ID(problemID)),
+		message.IsVisibleForManagerEQ(true),
+	)
+}
+
+func (r *Repo) getMessagesPage(
+	ctx context.Context,
+	pageSize int,
+	cursor *Cursor,
+	predicates ...predicate.Message,
 ) ([]Message, *Cursor, error) {
 	var (
 		size      int
@@ -49,7 +76,7 @@ func (r *Repo) GetClientChatMessages(
 		size = cursor.PageSize
 		createdAt = &cursor.LastCreatedAt
 	default:
-		if pageSize < 10 || pageSize > maxPageSize {
+		if pageSize < minPageSize || pageSize > maxPageSize {
 			return nil, nil, ErrInvalidPageSize
 		}
 		size = pageSize
@@ -57,11 +84,8 @@ func (r *Repo) GetClientChatMessages(
 
 	qb := r.db.Message(ctx).
 		Query().
-		Unique(true).
-		Where(
-			message.HasChatWith(chat.ClientIDEQ(clientID)),
-			message.IsVisibleForClientEQ(true),
-		).Unique(false).
+		Unique(false).
+		Where(predicates...).
 		Order(store.Desc(message.FieldCreatedAt)).
 		Limit(size + 1)
 	if createdAt != nil {
@@ -159,6 +159,66 @@ func (s *MsgRepoHistoryAPISuite) Test_GetClientChatMessages() {
 	})
 }
 
+func (s *MsgRepoHistoryAPISuite) Test_GetProblemMessages() {
+	s.Run("too small page size", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 9, nil)
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidPageSize)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("invalid cursor", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 0, &messagesrepo.Cursor{
+			LastCreatedAt: time.Time{},
+			PageSize:      50,
+		})
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidCursor)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("problem has not got any messages", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 50, nil)
+		s.Require().NoError(err)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("only visible for manager messages of the problem", func() {
+		const messagesCount = 15
+		client := types.NewUserID()
+
+		oldProblem, chat := s.createProblemAndChat(client)
+		// Messages of the previous problem in the same chat must be ignored.
+		s.createMessages(3, chat, oldProblem, client, true, true, false)
+		s.Database.Problem(s.Ctx).UpdateOneID(oldProblem).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+		problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat).SaveX(s.Ctx).ID
+		preparedMsgs := s.createMessages(messagesCount, chat, problem, client, true, true, false)
+
+		// Invisible for manager messages must be ignored.
+		s.createMessages(2, chat, problem, types.UserIDNil, true, false, true)
+
+		const pageSize = 10
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, problem, pageSize, nil)
+		s.Require().NoError(err)
+		s.Require().NotNil(next)
+		s.Equal(pageSize, next.PageSize)
+		s.Equal(
+			apply[*store.Message, msg](preparedMsgs[:pageSize], newMsgFromStoreMsg),
+			apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg),
+		)
+
+		msgs, next, err = s.repo.GetProblemMessages(s.Ctx, problem, 0, next)
+		s.Require().NoError(err)
+		s.Nil(next)
+		s.Equal(
+			apply[*store.Message, msg](preparedMsgs[pageSize:], newMsgFromStoreMsg),
+			apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg),
+		)
+	})
+}
+
 func (s *MsgRepoHistoryAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
@@ -62,6 +62,7 @@ func (s *MsgRepoAPISuite) Test_GetMessageByID() {
 		s.Require().NoError(err)
 		s.Require().NotNil(actualMsg)
 		s.Equal(expectedMsg.ID, actualMsg.ID)
+		s.Equal(expectedMsg.InitialRequestID, actualMsg.InitialRequestID)
 		s.Equal(expectedMsg.ChatID, actualMsg.ChatID)
 		s.Equal(expectedMsg.AuthorID, actualMsg.AuthorID)
 		s.Equal(expectedMsg.Body, actualMsg.Body)
@@ -107,6 +108,7 @@ func (s *MsgRepoAPISuite) Test_GetMessageByRequestID() {
 		s.Require().NoError(err)
 		s.Require().NotNil(actualMsg)
 		s.Equal(expectedMsg.ID, actualMsg.ID)
+		s.Equal(expectedMsg.InitialRequestID, actualMsg.InitialRequestID)
 		s.Equal(expectedMsg.ChatID, actualMsg.ChatID)
 		s.Equal(expectedMsg.AuthorID, actualMsg.AuthorID)
 		s.Equal(expectedMsg.Body, actualMsg.Body)
@@ -177,6 +179,182 @@ func (s *MsgRepoAPISuite) Test_CreateClientVisible_DuplicationError() {
 	s.Require().Error(err)
 }
 
+func (s *MsgRepoAPISuite) Test_CreateFullVisible() {
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+
+	// Create chat and problem.
+	problemID, chatID := s.createProblemAndChat(clientID)
+	initialRequestID := types.NewRequestID()
+
+	// Check message was created.
+	msg, err := s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(managerID, msg.AuthorID)
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.CreatedAt.IsZero())
+	s.True(msg.IsVisibleForClient)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.IsBlocked)
+	s.False(msg.IsService)
+
+	dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+	s.Require().NoError(err)
+	s.Equal(initialRequestID, dbMsg.InitialRequestID)
+	s.Equal(problemID, dbMsg.QueryProblem().OnlyIDX(s.Ctx))
+}
+
+func (s *MsgRepoAPISuite) Test_CreateFullVisible_DuplicationError() {
+	managerID := types.NewUserID()
+
+	// Create chat and problem.
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	// Check message was created.
+	_, err := s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().NoError(err)
+
+	// Retry message creation.
+	_, err = s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().Error(err)
+}
+
+func (s *MsgRepoAPISuite) Test_CreateServiceMessageForClient() {
+	// Arrange.
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+
+	// Action.
+	msgID, err := s.repo.CreateServiceMessageForClient(s.Ctx, problemID, chatID, msgBody)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().NotEmpty(msgID)
+
+	msg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(msgBody, msg.Body)
+	s.Empty(msg.AuthorID)
+	s.True(msg.IsService)
+	s.True(msg.IsVisibleForClient)
+	s.False(msg.IsVisibleForManager)
+	s.NotEmpty(msg.InitialRequestID)
+}
+
+func (s *MsgRepoAPISuite) Test_MarkAsVisibleForManager() {
+	s.Run("message exists", func() {
+		// Arrange.
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		msg, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody)
+		s.Require().NoError(err)
+
+		// Action.
+		err = s.repo.MarkAsVisibleForManager(s.Ctx, chatID, msg.ID)
+
+		// Assert.
+		s.Require().NoError(err)
+
+		dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+		s.Require().NoError(err)
+		s.True(dbMsg.IsVisibleForClient)
+		s.True(dbMsg.IsVisibleForManager)
+		s.False(dbMsg.IsBlocked)
+		s.False(dbMsg.CheckedAt.IsZero())
+	})
+
+	s.Run("repeated verdict", func() {
+		// Arrange.
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		msg, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody)
+		s.Require().NoError(err)
+		s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, chatID, msg.ID))
+
+		// Action.
+		err = s.repo.MarkAsVisibleForManager(s.Ctx, chatID, msg.ID)
+
+		// Assert.
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyChecked)
+	})
+
+	s.Run("message from another chat", func() {
+		// Arrange.
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		msg, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody)
+		s.Require().NoError(err)
+
+		// Action.
+		err = s.repo.MarkAsVisibleForManager(s.Ctx, types.NewChatID(), msg.ID)
+
+		// Assert.
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+
+	s.Run("message does not exist", func() {
+		err := s.repo.MarkAsVisibleForManager(s.Ctx, types.NewChatID(), types.NewMessageID())
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+}
+
+func (s *MsgRepoAPISuite) Test_BlockMessage() {
+	s.Run("message exists", func() {
+		// Arrange.
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		msg, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody)
+		s.Require().NoError(err)
+
+		// Action.
+		err = s.repo.BlockMessage(s.Ctx, chatID, msg.ID)
+
+		// Assert.
+		s.Require().NoError(err)
+
+		dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+		s.Require().NoError(err)
+		s.True(dbMsg.IsVisibleForClient)
+		s.False(dbMsg.IsVisibleForManager)
+		s.True(dbMsg.IsBlocked)
+		s.False(dbMsg.CheckedAt.IsZero())
+	})
+
+	s.Run("conflicting verdicts", func() {
+		// Arrange.
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		msg, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody)
+		s.Require().NoError(err)
+		s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, chatID, msg.ID))
+
+		// Action.
+		err = s.repo.BlockMessage(s.Ctx, chatID, msg.ID)
+
+		// Assert.
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyChecked)
+
+		dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+		s.Require().NoError(err)
+		s.True(dbMsg.IsVisibleForManager)
+		s.False(dbMsg.IsBlocked)
+	})
+
+	s.Run("message does not exist", func() {
+		err := s.repo.BlockMessage(s.Ctx, types.NewChatID(), types.NewMessageID())
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+}
+
 func (s *MsgRepoAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
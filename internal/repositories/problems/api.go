@@ -4,18 +4,40 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	entSql "entgo.io/ent/dialect/sql"
 
+	"github.com/keepcalmist/chat-service/internal/store"
 	"github.com/keepcalmist/chat-service/internal/store/problem"
 	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
 )
 
-func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error) {
-	id, err := r.db.Problem(ctx).
+var ErrProblemNotFound = errors.New("problem not found")
+
+// CreateIfNotExists returns the open problem in the chat or creates the new one about the topic.
+// The empty topic means it is unknown. The topic of the existing problem is left as is.
+func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID, topic types.Topic) (types.ProblemID, error) {
+	// The open problem may be already assigned to the manager,
+	// so the unique index on problems without manager doesn't protect us from duplicates.
+	openProblem, err := r.getOpenProblem(ctx, chatID)
+	if err == nil {
+		return openProblem.ID, nil
+	}
+	if !store.IsNotFound(err) {
+		return types.ProblemIDNil, err
+	}
+
+	create := r.db.Problem(ctx).
 		Create().
-		SetChatID(chatID).
-		OnConflict(entSql.DoNothing()).DoNothing().ID(ctx)
+		SetChatID(chatID)
+	if topic != "" {
+		create.SetTopic(topic)
+	}
+
+	id, err := create.OnConflict(entSql.DoNothing()).DoNothing().ID(ctx)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return types.ProblemIDNil, err
 	}
@@ -24,7 +46,16 @@ func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (type
 		return id, nil
 	}
 
-	createdProblem, err := r.db.Problem(ctx).
+	createdProblem, err := r.getOpenProblem(ctx, chatID)
+	if err != nil {
+		return types.ProblemIDNil, err
+	}
+
+	return createdProblem.ID, nil
+}
+
+func (r *Repo) getOpenProblem(ctx context.Context, chatID types.ChatID) (*store.Problem, error) {
+	return r.db.Problem(ctx).
 		Query().
 		Unique(false).
 		Where(
@@ -33,11 +64,6 @@ func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (type
 		).Order(problem.ByCreatedAt(func(options *entSql.OrderTermOptions) {
 		options.Desc = true
 	})).First(ctx)
-	if err != nil {
-		return types.ProblemIDNil, err
-	}
-
-	return createdProblem.ID, nil
 }
 
 func (r *Repo) GetManagerOpenProblemsCount(ctx context.Context, managerID types.UserID) (int, error) {
@@ -49,3 +75,229 @@ func (r *Repo) GetManagerOpenProblemsCount(ctx context.Context, managerID types.
 			problem.ResolvedAtIsNil(),
 		).Count(ctx)
 }
+
+// GetProblemsWithoutManager returns the oldest open problems that are not assigned to any manager yet.
+// The offset skips the problems the caller has already looked at and left without manager.
+func (r *Repo) GetProblemsWithoutManager(ctx context.Context, offset, limit int) ([]Problem, error) {
+	problems, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ManagerIDIsNil(),
+			problem.ResolvedAtIsNil(),
+		).
+		Order(problem.ByCreatedAt(), problem.ByID()).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query problems without manager: %w", err)
+	}
+
+	result := make([]Problem, 0, len(problems))
+	for _, p := range problems {
+		result = append(result, adaptStoreProblem(p))
+	}
+
+	return result, nil
+}
+
+// SetManagerForProblem assigns the manager to the open problem without manager.
+// Returns ErrProblemNotFound if there is no such problem.
+func (r *Repo) SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error {
+	n, err := r.db.Problem(ctx).
+		Update().
+		Where(
+			problem.ID(problemID),
+			problem.ManagerIDIsNil(),
+			problem.ResolvedAtIsNil(),
+		).
+		SetManagerID(managerID).
+		SetAssignedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update problem manager: %w", err)
+	}
+
+	if n == 0 {
+		return ErrProblemNotFound
+	}
+
+	return nil
+}
+
+// GetManagerOpenChats returns the chats where the manager has an unresolved problem, oldest problems first.
+func (r *Repo) GetManagerOpenChats(ctx context.Context, managerID types.UserID) ([]ManagerChat, error) {
+	problems, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ManagerID(managerID),
+			problem.ResolvedAtIsNil(),
+		).
+		WithChat().
+		Order(problem.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query manager open problems: %w", err)
+	}
+
+	result := make([]ManagerChat, 0, len(problems))
+	for _, p := range problems {
+		result = append(result, ManagerChat{
+			ChatID:           p.ChatID,
+			ClientID:         p.Edges.Chat.ClientID,
+			ProblemCreatedAt: p.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// GetAssignedProblemID returns the open problem in the chat assigned to the manager.
+// Returns ErrProblemNotFound if there is no such problem.
+func (r *Repo) GetAssignedProblemID(
+	ctx context.Context,
+	managerID types.UserID,
+	chatID types.ChatID,
+) (types.ProblemID, error) {
+	id, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ChatID(chatID),
+			problem.ManagerID(managerID),
+			problem.ResolvedAtIsNil(),
+		).
+		FirstID(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.ProblemIDNil, ErrProblemNotFound
+		}
+		return types.ProblemIDNil, fmt.Errorf("query assigned problem: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetAssignedManagerID returns the manager of the open problem in the chat.
+// Returns ErrProblemNotFound if there is no open problem or it has no manager yet.
+func (r *Repo) GetAssignedManagerID(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	p, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ChatID(chatID),
+			problem.ManagerIDNotNil(),
+			problem.ResolvedAtIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.UserIDNil, ErrProblemNotFound
+		}
+		return types.UserIDNil, fmt.Errorf("query assigned manager: %w", err)
+	}
+
+	return pointer.Indirect(p.ManagerID), nil
+}
+
+// GetManagerLastProblem returns the latest problem in the chat assigned to the manager, resolved or not.
+// Returns ErrProblemNotFound if there is no such problem.
+func (r *Repo) GetManagerLastProblem(
+	ctx context.Context,
+	managerID types.UserID,
+	chatID types.ChatID,
+) (Problem, error) {
+	p, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ChatID(chatID),
+			problem.ManagerID(managerID),
+		).
+		Order(problem.ByCreatedAt(entSql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return Problem{}, ErrProblemNotFound
+		}
+		return Problem{}, fmt.Errorf("query manager last problem: %w", err)
+	}
+
+	return adaptStoreProblem(p), nil
+}
+
+// GetManagersLastAssignedAt returns the time of the latest assignment of each manager.
+// The managers who have never been assigned a problem are absent in the result.
+func (r *Repo) GetManagersLastAssignedAt(
+	ctx context.Context,
+	managerIDs []types.UserID,
+) (map[types.UserID]time.Time, error) {
+	var rows []struct {
+		ManagerID  types.UserID `json:"manager_id"`
+		AssignedAt time.Time    `json:"assigned_at"`
+	}
+	if err := r.db.Problem(ctx).
+		Query().
+		Where(
+			problem.ManagerIDIn(managerIDs...),
+			problem.AssignedAtNotNil(),
+		).
+		GroupBy(problem.FieldManagerID).
+		Aggregate(store.As(store.Max(problem.FieldAssignedAt), problem.FieldAssignedAt)).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("query managers last assignment: %w", err)
+	}
+
+	result := make(map[types.UserID]time.Time, len(rows))
+	for _, row := range rows {
+		result[row.ManagerID] = row.AssignedAt
+	}
+
+	return result, nil
+}
+
+// GetChatLastManagerID returns the manager of the latest problem in the chat having a manager, resolved or not.
+// Returns ErrProblemNotFound if no manager has ever handled the chat.
+func (r *Repo) GetChatLastManagerID(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	p, err := r.db.Problem(ctx).
+		Query().
+		Unique(false).
+		Where(
+			problem.ChatID(chatID),
+			problem.ManagerIDNotNil(),
+		).
+		Order(problem.ByCreatedAt(entSql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.UserIDNil, ErrProblemNotFound
+		}
+		return types.UserIDNil, fmt.Errorf("query chat last manager: %w", err)
+	}
+
+	return pointer.Indirect(p.ManagerID), nil
+}
+
+// ResolveProblem marks the open problem as resolved.
+// Returns ErrProblemNotFound if the problem doesn't exist or is already resolved.
+func (r *Repo) ResolveProblem(ctx context.Context, problemID types.ProblemID) error {
+	n, err := r.db.Problem(ctx).
+		Update().
+		Where(
+			problem.ID(problemID),
+			problem.ResolvedAtIsNil(),
+		).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update problem resolved at: %w", err)
+	}
+
+	if n == 0 {
+		return ErrProblemNotFound
+	}
+
+	return nil
+}
@@ -41,7 +41,7 @@ func (s *ProblemsRepoSuite) Test_CreateIfNotExists() {
 		chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
 		s.Require().NoError(err)
 
-		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID)
+		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID, types.TopicLoans)
 		s.Require().NoError(err)
 		s.NotEmpty(problemID)
 
@@ -49,6 +49,20 @@ func (s *ProblemsRepoSuite) Test_CreateIfNotExists() {
 		s.Require().NoError(err)
 		s.Equal(problemID, problem.ID)
 		s.Equal(chat.ID, problem.ChatID)
+		s.Require().NotNil(problem.Topic)
+		s.Equal(types.TopicLoans, *problem.Topic)
+	})
+
+	s.Run("unknown topic", func() {
+		chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+		s.Require().NoError(err)
+
+		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID, "")
+		s.Require().NoError(err)
+
+		problem, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Nil(problem.Topic)
 	})
 
 	s.Run("resolved problem already exists, should be created", func() {
@@ -65,7 +79,7 @@ func (s *ProblemsRepoSuite) Test_CreateIfNotExists() {
 			SetResolvedAt(time.Now()).Save(s.Ctx)
 		s.Require().NoError(err)
 
-		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID)
+		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID, "")
 		s.Require().NoError(err)
 		s.NotEmpty(problemID)
 		s.NotEqual(problem.ID, problemID)
@@ -82,10 +96,14 @@ func (s *ProblemsRepoSuite) Test_CreateIfNotExists() {
 		problem, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).Save(s.Ctx)
 		s.Require().NoError(err)
 
-		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID)
+		problemID, err := s.repo.CreateIfNotExists(s.Ctx, chat.ID, types.TopicCards)
 		s.Require().NoError(err)
 		s.NotEmpty(problemID)
 		s.Equal(problem.ID, problemID)
+
+		problem, err = s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Nil(problem.Topic, "topic of the existing problem is kept")
 	})
 }
 
@@ -138,6 +156,283 @@ func (s *ProblemsRepoSuite) Test_GetManagerOpenProblemsCount() {
 	})
 }
 
+func (s *ProblemsRepoSuite) Test_CreateIfNotExists_AssignedProblemExists() {
+	// Arrange.
+	_, chatID, problemID := s.createChatWithProblem(types.NewUserID())
+
+	// Action.
+	actualID, err := s.repo.CreateIfNotExists(s.Ctx, chatID, "")
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(problemID, actualID)
+	s.Equal(1, s.Database.Problem(s.Ctx).Query().Where(storeproblem.ChatID(chatID)).CountX(s.Ctx))
+}
+
+func (s *ProblemsRepoSuite) Test_GetProblemsWithoutManager() {
+	s.Database.Problem(s.Ctx).Delete().ExecX(s.Ctx)
+
+	// Arrange.
+	const problemsCount = 5
+
+	expected := make([]types.ProblemID, 0, problemsCount)
+	for i := 0; i < problemsCount; i++ {
+		_, _, pID := s.createChatWithProblem(types.UserIDNil)
+		expected = append(expected, pID)
+	}
+
+	// Problems that must be skipped.
+	s.createChatWithProblemAssignedTo(types.NewUserID())
+	{
+		_, _, pID := s.createChatWithProblem(types.UserIDNil)
+		s.Database.Problem(s.Ctx).UpdateOneID(pID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+	}
+
+	s.Run("oldest first", func() {
+		problems, err := s.repo.GetProblemsWithoutManager(s.Ctx, 0, problemsCount+1)
+		s.Require().NoError(err)
+		s.Require().Len(problems, problemsCount)
+
+		for i, p := range problems {
+			s.Equal(expected[i], p.ID)
+			s.Empty(p.ManagerID)
+			s.NotEmpty(p.ChatID)
+		}
+	})
+
+	s.Run("limit", func() {
+		problems, err := s.repo.GetProblemsWithoutManager(s.Ctx, 0, 2)
+		s.Require().NoError(err)
+		s.Require().Len(problems, 2)
+		s.Equal(expected[0], problems[0].ID)
+		s.Equal(expected[1], problems[1].ID)
+	})
+
+	s.Run("offset", func() {
+		problems, err := s.repo.GetProblemsWithoutManager(s.Ctx, 3, problemsCount)
+		s.Require().NoError(err)
+		s.Require().Len(problems, 2)
+		s.Equal(expected[3], problems[0].ID)
+		s.Equal(expected[4], problems[1].ID)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_SetManagerForProblem() {
+	s.Run("problem without manager", func() {
+		_, _, problemID := s.createChatWithProblem(types.UserIDNil)
+		managerID := types.NewUserID()
+
+		err := s.repo.SetManagerForProblem(s.Ctx, problemID, managerID)
+		s.Require().NoError(err)
+
+		p := s.Database.Problem(s.Ctx).GetX(s.Ctx, problemID)
+		s.Require().NotNil(p.ManagerID)
+		s.Equal(managerID, *p.ManagerID)
+		s.NotNil(p.AssignedAt)
+	})
+
+	s.Run("problem already assigned", func() {
+		_, _, problemID := s.createChatWithProblem(types.NewUserID())
+
+		err := s.repo.SetManagerForProblem(s.Ctx, problemID, types.NewUserID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("unknown problem", func() {
+		err := s.repo.SetManagerForProblem(s.Ctx, types.NewProblemID(), types.NewUserID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetManagerOpenChats() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	type chat struct {
+		clientID types.UserID
+		chatID   types.ChatID
+	}
+	expected := make([]chat, 0, 3)
+	for i := 0; i < 3; i++ {
+		clientID, chatID, _ := s.createChatWithProblem(managerID)
+		expected = append(expected, chat{clientID: clientID, chatID: chatID})
+	}
+
+	// Chats that must be skipped.
+	s.createChatWithProblem(types.NewUserID())
+	s.createChatWithProblem(types.UserIDNil)
+	{
+		_, _, pID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(pID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+	}
+
+	// Action.
+	chats, err := s.repo.GetManagerOpenChats(s.Ctx, managerID)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(chats, len(expected))
+	for i, c := range chats {
+		s.Equal(expected[i].chatID, c.ChatID)
+		s.Equal(expected[i].clientID, c.ClientID)
+		s.NotEmpty(c.ProblemCreatedAt)
+	}
+}
+
+func (s *ProblemsRepoSuite) Test_GetAssignedProblemID() {
+	s.Run("assigned problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, problemID := s.createChatWithProblem(managerID)
+
+		id, err := s.repo.GetAssignedProblemID(s.Ctx, managerID, chatID)
+		s.Require().NoError(err)
+		s.Equal(problemID, id)
+	})
+
+	s.Run("problem assigned to another manager", func() {
+		_, chatID, _ := s.createChatWithProblem(types.NewUserID())
+
+		_, err := s.repo.GetAssignedProblemID(s.Ctx, types.NewUserID(), chatID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("resolved problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, problemID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+		_, err := s.repo.GetAssignedProblemID(s.Ctx, managerID, chatID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetAssignedManagerID() {
+	s.Run("assigned problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, _ := s.createChatWithProblem(managerID)
+
+		id, err := s.repo.GetAssignedManagerID(s.Ctx, chatID)
+		s.Require().NoError(err)
+		s.Equal(managerID, id)
+	})
+
+	s.Run("problem without manager", func() {
+		_, chatID, _ := s.createChatWithProblem(types.UserIDNil)
+
+		_, err := s.repo.GetAssignedManagerID(s.Ctx, chatID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("resolved problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, problemID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+		_, err := s.repo.GetAssignedManagerID(s.Ctx, chatID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetManagerLastProblem() {
+	s.Run("no problems", func() {
+		_, err := s.repo.GetManagerLastProblem(s.Ctx, types.NewUserID(), types.NewChatID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("resolved problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, problemID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+		p, err := s.repo.GetManagerLastProblem(s.Ctx, managerID, chatID)
+		s.Require().NoError(err)
+		s.Equal(problemID, p.ID)
+		s.Equal(chatID, p.ChatID)
+		s.Equal(managerID, p.ManagerID)
+		s.False(p.ResolvedAt.IsZero())
+	})
+
+	s.Run("the latest problem is returned", func() {
+		managerID := types.NewUserID()
+		_, chatID, oldProblemID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(oldProblemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+		newProblem := s.Database.Problem(s.Ctx).Create().SetChatID(chatID).SetManagerID(managerID).SaveX(s.Ctx)
+
+		p, err := s.repo.GetManagerLastProblem(s.Ctx, managerID, chatID)
+		s.Require().NoError(err)
+		s.Equal(newProblem.ID, p.ID)
+		s.True(p.ResolvedAt.IsZero())
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetManagersLastAssignedAt() {
+	// Arrange.
+	neverAssigned := types.NewUserID()
+	managerID := types.NewUserID()
+
+	_, _, oldProblemID := s.createChatWithProblem(types.UserIDNil)
+	_, _, newProblemID := s.createChatWithProblem(types.UserIDNil)
+	s.Require().NoError(s.repo.SetManagerForProblem(s.Ctx, oldProblemID, managerID))
+	s.Require().NoError(s.repo.SetManagerForProblem(s.Ctx, newProblemID, managerID))
+	newProblem := s.Database.Problem(s.Ctx).GetX(s.Ctx, newProblemID)
+
+	// Action.
+	lastAssignedAt, err := s.repo.GetManagersLastAssignedAt(s.Ctx, []types.UserID{neverAssigned, managerID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(lastAssignedAt, 1)
+	s.WithinDuration(*newProblem.AssignedAt, lastAssignedAt[managerID], time.Millisecond)
+}
+
+func (s *ProblemsRepoSuite) Test_GetChatLastManagerID() {
+	s.Run("no manager", func() {
+		_, chatID, _ := s.createChatWithProblem(types.UserIDNil)
+
+		_, err := s.repo.GetChatLastManagerID(s.Ctx, chatID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("manager of the resolved problem", func() {
+		managerID := types.NewUserID()
+		_, chatID, problemID := s.createChatWithProblem(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+		s.Database.Problem(s.Ctx).Create().SetChatID(chatID).ExecX(s.Ctx)
+
+		lastManagerID, err := s.repo.GetChatLastManagerID(s.Ctx, chatID)
+		s.Require().NoError(err)
+		s.Equal(managerID, lastManagerID)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_ResolveProblem() {
+	// Arrange.
+	managerID := types.NewUserID()
+	_, _, problemID := s.createChatWithProblem(managerID)
+
+	s.Run("open problem", func() {
+		err := s.repo.ResolveProblem(s.Ctx, problemID)
+		s.Require().NoError(err)
+
+		p := s.Database.Problem(s.Ctx).GetX(s.Ctx, problemID)
+		s.NotNil(p.ResolvedAt)
+
+		count, err := s.repo.GetManagerOpenProblemsCount(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(0, count)
+	})
+
+	s.Run("already resolved problem", func() {
+		err := s.repo.ResolveProblem(s.Ctx, problemID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("unknown problem", func() {
+		err := s.repo.ResolveProblem(s.Ctx, types.NewProblemID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
 func (s *ProblemsRepoSuite) createChatWithProblemAssignedTo(managerID types.UserID) (types.ChatID, types.ProblemID) {
 	s.T().Helper()
 
@@ -151,3 +446,22 @@ func (s *ProblemsRepoSuite) createChatWithProblemAssignedTo(managerID types.User
 
 	return chat.ID, p.ID
 }
+
+// createChatWithProblem creates the chat with the open problem.
+// The problem is left without manager if managerID is zero.
+func (s *ProblemsRepoSuite) createChatWithProblem(managerID types.UserID) (types.UserID, types.ChatID, types.ProblemID) {
+	s.T().Helper()
+
+	clientID := types.NewUserID()
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	qb := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID)
+	if !managerID.IsZero() {
+		qb.SetManagerID(managerID)
+	}
+	p, err := qb.Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return clientID, chat.ID, p.ID
+}
@@ -0,0 +1,37 @@
+package problemsrepo
+
+import (
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
+)
+
+type Problem struct {
+	ID         types.ProblemID
+	ChatID     types.ChatID
+	ManagerID  types.UserID
+	CreatedAt  time.Time
+	ResolvedAt time.Time
+	// Topic is empty if it is unknown.
+	Topic types.Topic
+}
+
+func adaptStoreProblem(p *store.Problem) Problem {
+	return Problem{
+		ID:         p.ID,
+		ChatID:     p.ChatID,
+		ManagerID:  pointer.Indirect(p.ManagerID),
+		CreatedAt:  p.CreatedAt,
+		ResolvedAt: pointer.Indirect(p.ResolvedAt),
+		Topic:      pointer.Indirect(p.Topic),
+	}
+}
+
+// ManagerChat is the chat with the open problem assigned to the manager.
+type ManagerChat struct {
+	ChatID           types.ChatID
+	ClientID         types.UserID
+	ProblemCreatedAt time.Time
+}
@@ -0,0 +1,86 @@
+package skillsrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// GetManagerSkills returns the topics the manager is skilled in, sorted by name.
+func (r *Repo) GetManagerSkills(ctx context.Context, managerID types.UserID) ([]types.Topic, error) {
+	skills, err := r.db.ManagerSkill(ctx).Query().
+		Where(managerskill.ManagerID(managerID)).
+		Order(managerskill.BySkill()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query manager skills: %w", err)
+	}
+
+	result := make([]types.Topic, 0, len(skills))
+	for _, s := range skills {
+		result = append(result, s.Skill)
+	}
+
+	return result, nil
+}
+
+// SetManagerSkills replaces the skills of the manager. The empty skills clear them.
+func (r *Repo) SetManagerSkills(ctx context.Context, managerID types.UserID, skills []types.Topic) error {
+	return r.db.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := r.db.ManagerSkill(ctx).Delete().
+			Where(managerskill.ManagerID(managerID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("delete manager skills: %w", err)
+		}
+
+		unique := make(map[types.Topic]struct{}, len(skills))
+		creates := make([]*store.ManagerSkillCreate, 0, len(skills))
+		for _, skill := range skills {
+			if _, ok := unique[skill]; ok {
+				continue
+			}
+			unique[skill] = struct{}{}
+			creates = append(creates, r.db.ManagerSkill(ctx).Create().SetManagerID(managerID).SetSkill(skill))
+		}
+
+		if err := r.db.ManagerSkill(ctx).CreateBulk(creates...).Exec(ctx); err != nil {
+			return fmt.Errorf("create manager skills: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FilterSkilledManagers returns the managers skilled in the topic keeping their order.
+func (r *Repo) FilterSkilledManagers(
+	ctx context.Context,
+	managerIDs []types.UserID,
+	topic types.Topic,
+) ([]types.UserID, error) {
+	skilled, err := r.db.ManagerSkill(ctx).Query().
+		Where(
+			managerskill.ManagerIDIn(managerIDs...),
+			managerskill.SkillEQ(topic),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query skilled managers: %w", err)
+	}
+
+	isSkilled := make(map[types.UserID]struct{}, len(skilled))
+	for _, s := range skilled {
+		isSkilled[s.ManagerID] = struct{}{}
+	}
+
+	result := make([]types.UserID, 0, len(skilled))
+	for _, managerID := range managerIDs {
+		if _, ok := isSkilled[managerID]; ok {
+			result = append(result, managerID)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package skillsrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	skillsrepo "github.com/keepcalmist/chat-service/internal/repositories/skills"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type SkillsRepoSuite struct {
+	testingh.DBSuite
+	repo *skillsrepo.Repo
+}
+
+func TestSkillsRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &SkillsRepoSuite{DBSuite: testingh.NewDBSuite("TestSkillsRepoSuite")})
+}
+
+func (s *SkillsRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = skillsrepo.New(skillsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *SkillsRepoSuite) Test_SetManagerSkills() {
+	managerID := types.NewUserID()
+
+	s.Run("no skills", func() {
+		skills, err := s.repo.GetManagerSkills(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Empty(skills)
+	})
+
+	s.Run("set skills", func() {
+		err := s.repo.SetManagerSkills(s.Ctx, managerID, []types.Topic{types.TopicLoans, types.TopicCards, types.TopicLoans})
+		s.Require().NoError(err)
+
+		skills, err := s.repo.GetManagerSkills(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal([]types.Topic{types.TopicCards, types.TopicLoans}, skills)
+	})
+
+	s.Run("replace skills", func() {
+		err := s.repo.SetManagerSkills(s.Ctx, managerID, []types.Topic{types.TopicDeposits})
+		s.Require().NoError(err)
+
+		skills, err := s.repo.GetManagerSkills(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal([]types.Topic{types.TopicDeposits}, skills)
+	})
+
+	s.Run("clear skills", func() {
+		err := s.repo.SetManagerSkills(s.Ctx, managerID, nil)
+		s.Require().NoError(err)
+
+		skills, err := s.repo.GetManagerSkills(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Empty(skills)
+	})
+}
+
+func (s *SkillsRepoSuite) Test_FilterSkilledManagers() {
+	// Arrange.
+	m1, m2, m3, m4 := types.NewUserID(), types.NewUserID(), types.NewUserID(), types.NewUserID()
+	s.Require().NoError(s.repo.SetManagerSkills(s.Ctx, m1, []types.Topic{types.TopicCards, types.TopicLoans}))
+	s.Require().NoError(s.repo.SetManagerSkills(s.Ctx, m2, []types.Topic{types.TopicDeposits}))
+	s.Require().NoError(s.repo.SetManagerSkills(s.Ctx, m3, []types.Topic{types.TopicLoans}))
+
+	// Action.
+	skilled, err := s.repo.FilterSkilledManagers(s.Ctx, []types.UserID{m4, m3, m2, m1}, types.TopicLoans)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal([]types.UserID{m3, m1}, skilled)
+}
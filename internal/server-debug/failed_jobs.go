@@ -0,0 +1,130 @@
+package serverdebug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/failed_jobs_mock.gen.go -package=serverdebugmocks
+
+type failedJobsRepository interface {
+	GetFailedJobs(ctx context.Context, filter jobsrepo.FailedJobsFilter) ([]jobsrepo.FailedJob, error)
+	RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error)
+	DeleteFailedJobsBefore(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+type failedJob struct {
+	ID        types.FailedJobID `json:"id"`
+	Name      string            `json:"name"`
+	Payload   string            `json:"payload"`
+	Reason    string            `json:"reason"`
+	Requeues  int               `json:"requeues"`
+	JobID     types.JobID       `json:"jobId"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type requeueFailedJobsRequest struct {
+	IDs []types.FailedJobID `json:"ids"`
+}
+
+type requeueFailedJobsResponse struct {
+	JobIDs []types.JobID `json:"jobIds"`
+}
+
+type purgeFailedJobsResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+// GetFailedJobs lists the outbox DLQ.
+// Query params (all optional): name, from and to (RFC 3339), limit.
+func (s *Server) GetFailedJobs(eCtx echo.Context) error {
+	filter := jobsrepo.FailedJobsFilter{Name: eCtx.QueryParam("name")}
+
+	var err error
+	if filter.From, err = parseTimeParam(eCtx, "from"); err != nil {
+		return err
+	}
+	if filter.To, err = parseTimeParam(eCtx, "to"); err != nil {
+		return err
+	}
+	if v := eCtx.QueryParam("limit"); v != "" {
+		if filter.Limit, err = strconv.Atoi(v); err != nil || filter.Limit < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+	}
+
+	jobs, err := s.failedJobs.GetFailedJobs(eCtx.Request().Context(), filter)
+	if err != nil {
+		return fmt.Errorf("get failed jobs: %v", err)
+	}
+
+	result := make([]failedJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, failedJob(j))
+	}
+
+	return eCtx.JSON(http.StatusOK, result)
+}
+
+// RequeueFailedJobs moves the failed jobs with the given IDs back to the outbox.
+func (s *Server) RequeueFailedJobs(eCtx echo.Context) error {
+	var req requeueFailedJobsRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if len(req.IDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "no ids")
+	}
+
+	jobIDs, err := s.failedJobs.RequeueFailedJobs(eCtx.Request().Context(), req.IDs)
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrFailedJobNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return fmt.Errorf("requeue failed jobs: %v", err)
+	}
+
+	s.lg.Info("failed jobs requeued", zap.Any("failed_job_ids", req.IDs), zap.Any("job_ids", jobIDs))
+
+	return eCtx.JSON(http.StatusOK, requeueFailedJobsResponse{JobIDs: jobIDs})
+}
+
+// PurgeFailedJobs deletes the failed jobs older than the retention given in olderThan param, e.g. "168h".
+func (s *Server) PurgeFailedJobs(eCtx echo.Context) error {
+	retention, err := time.ParseDuration(eCtx.QueryParam("olderThan"))
+	if err != nil || retention <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid olderThan")
+	}
+
+	deleted, err := s.failedJobs.DeleteFailedJobsBefore(eCtx.Request().Context(), time.Now().Add(-retention))
+	if err != nil {
+		return fmt.Errorf("delete failed jobs: %v", err)
+	}
+
+	s.lg.Info("failed jobs purged", zap.Duration("older_than", retention), zap.Int("deleted", deleted))
+
+	return eCtx.JSON(http.StatusOK, purgeFailedJobsResponse{Deleted: deleted})
+}
+
+func parseTimeParam(eCtx echo.Context, name string) (time.Time, error) {
+	v := eCtx.QueryParam(name)
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s", name))
+	}
+	return t, nil
+}
@@ -0,0 +1,217 @@
+package serverdebug_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
+	serverdebugmocks "github.com/keepcalmist/chat-service/internal/server-debug/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type FailedJobsSuite struct {
+	suite.Suite
+
+	ctrl       *gomock.Controller
+	failedJobs *serverdebugmocks.MockfailedJobsRepository
+	srv        *serverdebug.Server
+}
+
+func TestFailedJobsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(FailedJobsSuite))
+}
+
+func (s *FailedJobsSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.failedJobs = serverdebugmocks.NewMockfailedJobsRepository(s.ctrl)
+
+	var err error
+	s.srv, err = serverdebug.New(serverdebug.NewOptions(
+		"localhost:8079",
+		new(openapi3.T),
+		new(openapi3.T),
+		s.failedJobs,
+		serverdebugmocks.NewMockjobAttemptsRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerCapacitiesRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerSkillsRepository(s.ctrl),
+		serverdebugmocks.NewMockhealthChecker(s.ctrl),
+		prometheus.NewRegistry(),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *FailedJobsSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *FailedJobsSuite) TestGetFailedJobs() {
+	// Arrange.
+	from := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	fJob := jobsrepo.FailedJob{
+		ID:        types.NewFailedJobID(),
+		Name:      "send-client-message",
+		Payload:   "{}",
+		Reason:    "kafka is down",
+		Requeues:  2,
+		JobID:     types.NewJobID(),
+		CreatedAt: from.Add(time.Hour),
+	}
+
+	s.failedJobs.EXPECT().GetFailedJobs(gomock.Any(), jobsrepo.FailedJobsFilter{
+		Name:  "send-client-message",
+		From:  from,
+		To:    to,
+		Limit: 10,
+	}).Return([]jobsrepo.FailedJob{fJob}, nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodGet,
+		"/outbox/failed-jobs?name=send-client-message&from=2023-10-01T00:00:00Z&to=2023-10-02T00:00:00Z&limit=10", "")
+
+	// Action.
+	err := s.srv.GetFailedJobs(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal(fJob.ID.String(), resp[0]["id"])
+	s.Equal(fJob.Name, resp[0]["name"])
+	s.Equal(fJob.Reason, resp[0]["reason"])
+	s.EqualValues(fJob.Requeues, resp[0]["requeues"])
+	s.Equal(fJob.JobID.String(), resp[0]["jobId"])
+}
+
+func (s *FailedJobsSuite) TestGetFailedJobs_InvalidParams() {
+	for _, query := range []string{"from=yesterday", "to=2023-10-01", "limit=-1", "limit=many"} {
+		s.Run(query, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodGet, "/outbox/failed-jobs?"+query, "")
+
+			err := s.srv.GetFailedJobs(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *FailedJobsSuite) TestRequeueFailedJobs() {
+	// Arrange.
+	ids := []types.FailedJobID{types.NewFailedJobID(), types.NewFailedJobID()}
+	jobIDs := []types.JobID{types.NewJobID(), types.NewJobID()}
+	s.failedJobs.EXPECT().RequeueFailedJobs(gomock.Any(), ids).Return(jobIDs, nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodPost, "/outbox/failed-jobs/requeue",
+		`{"ids":["`+ids[0].String()+`","`+ids[1].String()+`"]}`)
+
+	// Action.
+	err := s.srv.RequeueFailedJobs(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"jobIds":["`+jobIDs[0].String()+`","`+jobIDs[1].String()+`"]}`, rec.Body.String())
+}
+
+func (s *FailedJobsSuite) TestRequeueFailedJobs_NotFound() {
+	// Arrange.
+	id := types.NewFailedJobID()
+	s.failedJobs.EXPECT().RequeueFailedJobs(gomock.Any(), []types.FailedJobID{id}).
+		Return(nil, jobsrepo.ErrFailedJobNotFound)
+
+	eCtx, _ := s.newEchoCtx(http.MethodPost, "/outbox/failed-jobs/requeue", `{"ids":["`+id.String()+`"]}`)
+
+	// Action.
+	err := s.srv.RequeueFailedJobs(eCtx)
+
+	// Assert.
+	s.requireHTTPError(err, http.StatusNotFound)
+}
+
+func (s *FailedJobsSuite) TestRequeueFailedJobs_InvalidRequest() {
+	for _, body := range []string{`{"ids":[]}`, `{"ids":["42"]}`, `{`} {
+		s.Run(body, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodPost, "/outbox/failed-jobs/requeue", body)
+
+			err := s.srv.RequeueFailedJobs(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *FailedJobsSuite) TestPurgeFailedJobs() {
+	// Arrange.
+	s.failedJobs.EXPECT().DeleteFailedJobsBefore(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, cutoff time.Time) (int, error) {
+			s.WithinDuration(time.Now().Add(-168*time.Hour), cutoff, time.Minute)
+			return 3, nil
+		})
+
+	eCtx, rec := s.newEchoCtx(http.MethodDelete, "/outbox/failed-jobs?olderThan=168h", "")
+
+	// Action.
+	err := s.srv.PurgeFailedJobs(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"deleted":3}`, rec.Body.String())
+}
+
+func (s *FailedJobsSuite) TestPurgeFailedJobs_InvalidRetention() {
+	for _, query := range []string{"", "olderThan=week", "olderThan=-1h"} {
+		s.Run(query, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodDelete, "/outbox/failed-jobs?"+query, "")
+
+			err := s.srv.PurgeFailedJobs(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *FailedJobsSuite) TestPurgeFailedJobs_RepoError() {
+	// Arrange.
+	s.failedJobs.EXPECT().DeleteFailedJobsBefore(gomock.Any(), gomock.Any()).Return(0, errors.New("unexpected"))
+
+	eCtx, _ := s.newEchoCtx(http.MethodDelete, "/outbox/failed-jobs?olderThan=1h", "")
+
+	// Action.
+	err := s.srv.PurgeFailedJobs(eCtx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *FailedJobsSuite) newEchoCtx(method, target, body string) (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func (s *FailedJobsSuite) requireHTTPError(err error, code int) {
+	s.T().Helper()
+
+	var httpErr *echo.HTTPError
+	s.Require().ErrorAs(err, &httpErr)
+	s.Equal(code, httpErr.Code)
+}
@@ -0,0 +1,30 @@
+package serverdebug
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/health_mock.gen.go -package=serverdebugmocks
+
+type healthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+type healthResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Health responds with 503 if the service is unhealthy, e.g. the outbox jobs are stuck.
+func (s *Server) Health(eCtx echo.Context) error {
+	if err := s.health.CheckHealth(eCtx.Request().Context()); err != nil {
+		s.lg.Warn("unhealthy", zap.Error(err))
+		return eCtx.JSON(http.StatusServiceUnavailable, healthResponse{Status: "unhealthy", Error: err.Error()})
+	}
+
+	return eCtx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+}
@@ -0,0 +1,90 @@
+package serverdebug_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+
+	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
+	serverdebugmocks "github.com/keepcalmist/chat-service/internal/server-debug/mocks"
+)
+
+type HealthSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	health *serverdebugmocks.MockhealthChecker
+	srv    *serverdebug.Server
+}
+
+func TestHealthSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HealthSuite))
+}
+
+func (s *HealthSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.health = serverdebugmocks.NewMockhealthChecker(s.ctrl)
+
+	var err error
+	s.srv, err = serverdebug.New(serverdebug.NewOptions(
+		"localhost:8079",
+		new(openapi3.T),
+		new(openapi3.T),
+		serverdebugmocks.NewMockfailedJobsRepository(s.ctrl),
+		serverdebugmocks.NewMockjobAttemptsRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerCapacitiesRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerSkillsRepository(s.ctrl),
+		s.health,
+		prometheus.NewRegistry(),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *HealthSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *HealthSuite) TestHealthy() {
+	// Arrange.
+	s.health.EXPECT().CheckHealth(gomock.Any()).Return(nil)
+	eCtx, rec := s.newEchoCtx()
+
+	// Action.
+	err := s.srv.Health(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"status":"ok"}`, rec.Body.String())
+}
+
+func (s *HealthSuite) TestUnhealthy() {
+	// Arrange.
+	s.health.EXPECT().CheckHealth(gomock.Any()).Return(errors.New("jobs are stuck"))
+	eCtx, rec := s.newEchoCtx()
+
+	// Action.
+	err := s.srv.Health(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusServiceUnavailable, rec.Code)
+	s.JSONEq(`{"status":"unhealthy","error":"jobs are stuck"}`, rec.Body.String())
+}
+
+func (s *HealthSuite) newEchoCtx() (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
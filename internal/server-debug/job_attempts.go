@@ -0,0 +1,62 @@
+package serverdebug
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_attempts_mock.gen.go -package=serverdebugmocks
+
+type jobAttemptsRepository interface {
+	GetJobAttempts(ctx context.Context, jobID types.JobID) ([]jobsrepo.JobAttempt, error)
+}
+
+type jobAttempt struct {
+	ID         types.JobAttemptID `json:"id"`
+	JobID      types.JobID        `json:"jobId"`
+	JobName    string             `json:"jobName"`
+	Attempt    int                `json:"attempt"`
+	Worker     string             `json:"worker"`
+	StartedAt  time.Time          `json:"startedAt"`
+	FinishedAt time.Time          `json:"finishedAt"`
+	Outcome    string             `json:"outcome"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// GetJobAttempts lists the attempts of the outbox job given in jobId param, the job may be already
+// handled or moved to the DLQ.
+func (s *Server) GetJobAttempts(eCtx echo.Context) error {
+	jobID, err := types.Parse[types.JobID](eCtx.QueryParam("jobId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid jobId")
+	}
+
+	attempts, err := s.jobAttempts.GetJobAttempts(eCtx.Request().Context(), jobID)
+	if err != nil {
+		return fmt.Errorf("get job attempts: %v", err)
+	}
+
+	result := make([]jobAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		result = append(result, jobAttempt{
+			ID:         a.ID,
+			JobID:      a.JobID,
+			JobName:    a.JobName,
+			Attempt:    a.Attempt,
+			Worker:     a.Worker,
+			StartedAt:  a.StartedAt,
+			FinishedAt: a.FinishedAt,
+			Outcome:    string(a.Outcome),
+			Error:      a.Error,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, result)
+}
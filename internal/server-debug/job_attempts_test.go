@@ -0,0 +1,145 @@
+package serverdebug_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
+	serverdebugmocks "github.com/keepcalmist/chat-service/internal/server-debug/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type JobAttemptsSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	jobAttempts *serverdebugmocks.MockjobAttemptsRepository
+	srv         *serverdebug.Server
+}
+
+func TestJobAttemptsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(JobAttemptsSuite))
+}
+
+func (s *JobAttemptsSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.jobAttempts = serverdebugmocks.NewMockjobAttemptsRepository(s.ctrl)
+
+	var err error
+	s.srv, err = serverdebug.New(serverdebug.NewOptions(
+		"localhost:8079",
+		new(openapi3.T),
+		new(openapi3.T),
+		serverdebugmocks.NewMockfailedJobsRepository(s.ctrl),
+		s.jobAttempts,
+		serverdebugmocks.NewMockmanagerCapacitiesRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerSkillsRepository(s.ctrl),
+		serverdebugmocks.NewMockhealthChecker(s.ctrl),
+		prometheus.NewRegistry(),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *JobAttemptsSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *JobAttemptsSuite) TestGetJobAttempts() {
+	// Arrange.
+	jobID := types.NewJobID()
+	startedAt := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	attempts := []jobsrepo.JobAttempt{
+		{
+			ID:         types.NewJobAttemptID(),
+			JobID:      jobID,
+			JobName:    "send-client-message",
+			Attempt:    1,
+			Worker:     "host/0",
+			StartedAt:  startedAt,
+			FinishedAt: startedAt.Add(time.Second),
+			Outcome:    jobsrepo.JobAttemptFailed,
+			Error:      "kafka is down",
+		},
+		{
+			ID:         types.NewJobAttemptID(),
+			JobID:      jobID,
+			JobName:    "send-client-message",
+			Attempt:    2,
+			Worker:     "host/1",
+			StartedAt:  startedAt.Add(time.Minute),
+			FinishedAt: startedAt.Add(time.Minute + time.Second),
+			Outcome:    jobsrepo.JobAttemptSucceeded,
+		},
+	}
+	s.jobAttempts.EXPECT().GetJobAttempts(gomock.Any(), jobID).Return(attempts, nil)
+
+	eCtx, rec := s.newEchoCtx("/outbox/job-attempts?jobId=" + jobID.String())
+
+	// Action.
+	err := s.srv.GetJobAttempts(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Require().Len(resp, 2)
+	s.Equal(attempts[0].ID.String(), resp[0]["id"])
+	s.Equal(jobID.String(), resp[0]["jobId"])
+	s.EqualValues(1, resp[0]["attempt"])
+	s.Equal("host/0", resp[0]["worker"])
+	s.Equal("failed", resp[0]["outcome"])
+	s.Equal("kafka is down", resp[0]["error"])
+	s.Equal("succeeded", resp[1]["outcome"])
+	s.NotContains(resp[1], "error")
+}
+
+func (s *JobAttemptsSuite) TestGetJobAttempts_InvalidJobID() {
+	for _, query := range []string{"", "jobId=", "jobId=abra-cadabra"} {
+		s.Run(query, func() {
+			eCtx, _ := s.newEchoCtx("/outbox/job-attempts?" + query)
+
+			err := s.srv.GetJobAttempts(eCtx)
+
+			var httpErr *echo.HTTPError
+			s.Require().ErrorAs(err, &httpErr)
+			s.Equal(http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
+
+func (s *JobAttemptsSuite) TestGetJobAttempts_RepoError() {
+	// Arrange.
+	jobID := types.NewJobID()
+	s.jobAttempts.EXPECT().GetJobAttempts(gomock.Any(), jobID).Return(nil, errors.New("unexpected"))
+
+	eCtx, _ := s.newEchoCtx("/outbox/job-attempts?jobId=" + jobID.String())
+
+	// Action.
+	err := s.srv.GetJobAttempts(eCtx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *JobAttemptsSuite) newEchoCtx(target string) (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
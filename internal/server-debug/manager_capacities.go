@@ -0,0 +1,92 @@
+package serverdebug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
+	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/manager_capacities_mock.gen.go -package=serverdebugmocks
+
+type managerCapacitiesRepository interface {
+	GetManagerCapacities(ctx context.Context) ([]capacitiesrepo.ManagerCapacity, error)
+	SetManagerCapacity(ctx context.Context, managerID types.UserID, capacity int) error
+	DeleteManagerCapacity(ctx context.Context, managerID types.UserID) error
+}
+
+type managerCapacity struct {
+	ManagerID types.UserID `json:"managerId"`
+	Capacity  int          `json:"capacity"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+type setManagerCapacityRequest struct {
+	ManagerID types.UserID `json:"managerId"`
+	Capacity  int          `json:"capacity"`
+}
+
+// GetManagerCapacities lists the personal capacities of the managers.
+// The managers without them get the default one from the config.
+func (s *Server) GetManagerCapacities(eCtx echo.Context) error {
+	capacities, err := s.capacities.GetManagerCapacities(eCtx.Request().Context())
+	if err != nil {
+		return fmt.Errorf("get manager capacities: %v", err)
+	}
+
+	result := make([]managerCapacity, 0, len(capacities))
+	for _, c := range capacities {
+		result = append(result, managerCapacity(c))
+	}
+
+	return eCtx.JSON(http.StatusOK, result)
+}
+
+// SetManagerCapacity sets the number of the problems the manager can handle at the same time.
+func (s *Server) SetManagerCapacity(eCtx echo.Context) error {
+	var req setManagerCapacityRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if req.ManagerID.IsZero() {
+		return echo.NewHTTPError(http.StatusBadRequest, "no managerId")
+	}
+	if err := managerload.ValidateCapacity(req.Capacity); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.capacities.SetManagerCapacity(eCtx.Request().Context(), req.ManagerID, req.Capacity); err != nil {
+		return fmt.Errorf("set manager capacity: %v", err)
+	}
+
+	s.lg.Info("manager capacity set", zap.Stringer("manager_id", req.ManagerID), zap.Int("capacity", req.Capacity))
+
+	return eCtx.NoContent(http.StatusNoContent)
+}
+
+// DeleteManagerCapacity resets the capacity of the manager given in managerId param to the default one.
+func (s *Server) DeleteManagerCapacity(eCtx echo.Context) error {
+	managerID, err := types.Parse[types.UserID](eCtx.QueryParam("managerId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid managerId")
+	}
+
+	if err := s.capacities.DeleteManagerCapacity(eCtx.Request().Context(), managerID); err != nil {
+		if errors.Is(err, capacitiesrepo.ErrCapacityNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return fmt.Errorf("delete manager capacity: %v", err)
+	}
+
+	s.lg.Info("manager capacity reset", zap.Stringer("manager_id", managerID))
+
+	return eCtx.NoContent(http.StatusNoContent)
+}
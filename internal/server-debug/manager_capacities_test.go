@@ -0,0 +1,178 @@
+package serverdebug_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
+	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
+	serverdebugmocks "github.com/keepcalmist/chat-service/internal/server-debug/mocks"
+	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type ManagerCapacitiesSuite struct {
+	suite.Suite
+
+	ctrl       *gomock.Controller
+	capacities *serverdebugmocks.MockmanagerCapacitiesRepository
+	srv        *serverdebug.Server
+}
+
+func TestManagerCapacitiesSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ManagerCapacitiesSuite))
+}
+
+func (s *ManagerCapacitiesSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.capacities = serverdebugmocks.NewMockmanagerCapacitiesRepository(s.ctrl)
+
+	var err error
+	s.srv, err = serverdebug.New(serverdebug.NewOptions(
+		"localhost:8079",
+		new(openapi3.T),
+		new(openapi3.T),
+		serverdebugmocks.NewMockfailedJobsRepository(s.ctrl),
+		serverdebugmocks.NewMockjobAttemptsRepository(s.ctrl),
+		s.capacities,
+		serverdebugmocks.NewMockmanagerSkillsRepository(s.ctrl),
+		serverdebugmocks.NewMockhealthChecker(s.ctrl),
+		prometheus.NewRegistry(),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *ManagerCapacitiesSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *ManagerCapacitiesSuite) TestGetManagerCapacities() {
+	// Arrange.
+	managerID := types.NewUserID()
+	updatedAt := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	s.capacities.EXPECT().GetManagerCapacities(gomock.Any()).Return([]capacitiesrepo.ManagerCapacity{
+		{ManagerID: managerID, Capacity: 3, UpdatedAt: updatedAt},
+	}, nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodGet, "/managers/capacities", "")
+
+	// Action.
+	err := s.srv.GetManagerCapacities(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`[{"managerId":"`+managerID.String()+`","capacity":3,"updatedAt":"2023-10-01T00:00:00Z"}]`,
+		rec.Body.String())
+}
+
+func (s *ManagerCapacitiesSuite) TestSetManagerCapacity() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.capacities.EXPECT().SetManagerCapacity(gomock.Any(), managerID, 12).Return(nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodPut, "/managers/capacities",
+		`{"managerId":"`+managerID.String()+`","capacity":12}`)
+
+	// Action.
+	err := s.srv.SetManagerCapacity(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ManagerCapacitiesSuite) TestSetManagerCapacity_InvalidRequest() {
+	managerID := types.NewUserID().String()
+
+	for _, body := range []string{
+		`{`,
+		`{"capacity":3}`,
+		`{"managerId":"42","capacity":3}`,
+		`{"managerId":"` + managerID + `"}`,
+		`{"managerId":"` + managerID + `","capacity":-1}`,
+		`{"managerId":"` + managerID + `","capacity":` + strconv.Itoa(managerload.MaxCapacity+1) + `}`,
+	} {
+		s.Run(body, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodPut, "/managers/capacities", body)
+
+			err := s.srv.SetManagerCapacity(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *ManagerCapacitiesSuite) TestDeleteManagerCapacity() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.capacities.EXPECT().DeleteManagerCapacity(gomock.Any(), managerID).Return(nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodDelete, "/managers/capacities?managerId="+managerID.String(), "")
+
+	// Action.
+	err := s.srv.DeleteManagerCapacity(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ManagerCapacitiesSuite) TestDeleteManagerCapacity_NotFound() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.capacities.EXPECT().DeleteManagerCapacity(gomock.Any(), managerID).Return(capacitiesrepo.ErrCapacityNotFound)
+
+	eCtx, _ := s.newEchoCtx(http.MethodDelete, "/managers/capacities?managerId="+managerID.String(), "")
+
+	// Action.
+	err := s.srv.DeleteManagerCapacity(eCtx)
+
+	// Assert.
+	s.requireHTTPError(err, http.StatusNotFound)
+}
+
+func (s *ManagerCapacitiesSuite) TestDeleteManagerCapacity_RepoError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.capacities.EXPECT().DeleteManagerCapacity(gomock.Any(), managerID).Return(errors.New("unexpected"))
+
+	eCtx, _ := s.newEchoCtx(http.MethodDelete, "/managers/capacities?managerId="+managerID.String(), "")
+
+	// Action.
+	err := s.srv.DeleteManagerCapacity(eCtx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *ManagerCapacitiesSuite) newEchoCtx(method, target, body string) (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func (s *ManagerCapacitiesSuite) requireHTTPError(err error, code int) {
+	s.T().Helper()
+
+	var httpErr *echo.HTTPError
+	s.Require().ErrorAs(err, &httpErr)
+	s.Equal(code, httpErr.Code)
+}
@@ -0,0 +1,64 @@
+package serverdebug
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/manager_skills_mock.gen.go -package=serverdebugmocks
+
+type managerSkillsRepository interface {
+	GetManagerSkills(ctx context.Context, managerID types.UserID) ([]types.Topic, error)
+	SetManagerSkills(ctx context.Context, managerID types.UserID, skills []types.Topic) error
+}
+
+type managerSkills struct {
+	ManagerID types.UserID  `json:"managerId"`
+	Skills    []types.Topic `json:"skills"`
+}
+
+// GetManagerSkills lists the topics the manager given in managerId param is skilled in.
+func (s *Server) GetManagerSkills(eCtx echo.Context) error {
+	managerID, err := types.Parse[types.UserID](eCtx.QueryParam("managerId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid managerId")
+	}
+
+	skills, err := s.skills.GetManagerSkills(eCtx.Request().Context(), managerID)
+	if err != nil {
+		return fmt.Errorf("get manager skills: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, managerSkills{ManagerID: managerID, Skills: skills})
+}
+
+// SetManagerSkills replaces the skills of the manager, the empty skills clear them.
+// With the "keycloak" skills source they are overwritten by the token roles when the manager frees the hands.
+func (s *Server) SetManagerSkills(eCtx echo.Context) error {
+	var req managerSkills
+	if err := eCtx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if req.ManagerID.IsZero() {
+		return echo.NewHTTPError(http.StatusBadRequest, "no managerId")
+	}
+	for _, skill := range req.Skills {
+		if !skill.Valid() {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown skill %q", skill))
+		}
+	}
+
+	if err := s.skills.SetManagerSkills(eCtx.Request().Context(), req.ManagerID, req.Skills); err != nil {
+		return fmt.Errorf("set manager skills: %v", err)
+	}
+
+	s.lg.Info("manager skills set", zap.Stringer("manager_id", req.ManagerID), zap.Any("skills", req.Skills))
+
+	return eCtx.NoContent(http.StatusNoContent)
+}
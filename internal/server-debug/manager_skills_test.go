@@ -0,0 +1,151 @@
+package serverdebug_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+
+	serverdebug "github.com/keepcalmist/chat-service/internal/server-debug"
+	serverdebugmocks "github.com/keepcalmist/chat-service/internal/server-debug/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type ManagerSkillsSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	skills *serverdebugmocks.MockmanagerSkillsRepository
+	srv    *serverdebug.Server
+}
+
+func TestManagerSkillsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ManagerSkillsSuite))
+}
+
+func (s *ManagerSkillsSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.skills = serverdebugmocks.NewMockmanagerSkillsRepository(s.ctrl)
+
+	var err error
+	s.srv, err = serverdebug.New(serverdebug.NewOptions(
+		"localhost:8079",
+		new(openapi3.T),
+		new(openapi3.T),
+		serverdebugmocks.NewMockfailedJobsRepository(s.ctrl),
+		serverdebugmocks.NewMockjobAttemptsRepository(s.ctrl),
+		serverdebugmocks.NewMockmanagerCapacitiesRepository(s.ctrl),
+		s.skills,
+		serverdebugmocks.NewMockhealthChecker(s.ctrl),
+		prometheus.NewRegistry(),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *ManagerSkillsSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *ManagerSkillsSuite) TestGetManagerSkills() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.skills.EXPECT().GetManagerSkills(gomock.Any(), managerID).
+		Return([]types.Topic{types.TopicCards, types.TopicLoans}, nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodGet, "/managers/skills?managerId="+managerID.String(), "")
+
+	// Action.
+	err := s.srv.GetManagerSkills(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"managerId":"`+managerID.String()+`","skills":["cards","loans"]}`, rec.Body.String())
+}
+
+func (s *ManagerSkillsSuite) TestGetManagerSkills_InvalidManagerID() {
+	for _, query := range []string{"", "managerId=", "managerId=42"} {
+		s.Run(query, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodGet, "/managers/skills?"+query, "")
+
+			err := s.srv.GetManagerSkills(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *ManagerSkillsSuite) TestSetManagerSkills() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.skills.EXPECT().SetManagerSkills(gomock.Any(), managerID, []types.Topic{types.TopicDeposits}).Return(nil)
+
+	eCtx, rec := s.newEchoCtx(http.MethodPut, "/managers/skills",
+		`{"managerId":"`+managerID.String()+`","skills":["deposits"]}`)
+
+	// Action.
+	err := s.srv.SetManagerSkills(eCtx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ManagerSkillsSuite) TestSetManagerSkills_InvalidRequest() {
+	managerID := types.NewUserID().String()
+
+	for _, body := range []string{
+		`{`,
+		`{"skills":["cards"]}`,
+		`{"managerId":"42","skills":["cards"]}`,
+		`{"managerId":"` + managerID + `","skills":["mortgages"]}`,
+	} {
+		s.Run(body, func() {
+			eCtx, _ := s.newEchoCtx(http.MethodPut, "/managers/skills", body)
+
+			err := s.srv.SetManagerSkills(eCtx)
+			s.requireHTTPError(err, http.StatusBadRequest)
+		})
+	}
+}
+
+func (s *ManagerSkillsSuite) TestSetManagerSkills_RepoError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.skills.EXPECT().SetManagerSkills(gomock.Any(), managerID, nil).Return(errors.New("unexpected"))
+
+	eCtx, _ := s.newEchoCtx(http.MethodPut, "/managers/skills", `{"managerId":"`+managerID.String()+`"}`)
+
+	// Action.
+	err := s.srv.SetManagerSkills(eCtx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *ManagerSkillsSuite) newEchoCtx(method, target, body string) (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func (s *ManagerSkillsSuite) requireHTTPError(err error, code int) {
+	s.T().Helper()
+
+	var httpErr *echo.HTTPError
+	s.Require().ErrorAs(err, &httpErr)
+	s.Equal(code, httpErr.Code)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: failed_jobs.go
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockfailedJobsRepository is a mock of failedJobsRepository interface.
+type MockfailedJobsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfailedJobsRepositoryMockRecorder
+}
+
+// MockfailedJobsRepositoryMockRecorder is the mock recorder for MockfailedJobsRepository.
+type MockfailedJobsRepositoryMockRecorder struct {
+	mock *MockfailedJobsRepository
+}
+
+// NewMockfailedJobsRepository creates a new mock instance.
+func NewMockfailedJobsRepository(ctrl *gomock.Controller) *MockfailedJobsRepository {
+	mock := &MockfailedJobsRepository{ctrl: ctrl}
+	mock.recorder = &MockfailedJobsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfailedJobsRepository) EXPECT() *MockfailedJobsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteFailedJobsBefore mocks base method.
+func (m *MockfailedJobsRepository) DeleteFailedJobsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedJobsBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFailedJobsBefore indicates an expected call of DeleteFailedJobsBefore.
+func (mr *MockfailedJobsRepositoryMockRecorder) DeleteFailedJobsBefore(ctx, cutoff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedJobsBefore", reflect.TypeOf((*MockfailedJobsRepository)(nil).DeleteFailedJobsBefore), ctx, cutoff)
+}
+
+// GetFailedJobs mocks base method.
+func (m *MockfailedJobsRepository) GetFailedJobs(ctx context.Context, filter jobsrepo.FailedJobsFilter) ([]jobsrepo.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedJobs", ctx, filter)
+	ret0, _ := ret[0].([]jobsrepo.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedJobs indicates an expected call of GetFailedJobs.
+func (mr *MockfailedJobsRepositoryMockRecorder) GetFailedJobs(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedJobs", reflect.TypeOf((*MockfailedJobsRepository)(nil).GetFailedJobs), ctx, filter)
+}
+
+// RequeueFailedJobs mocks base method.
+func (m *MockfailedJobsRepository) RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueFailedJobs", ctx, ids)
+	ret0, _ := ret[0].([]types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueFailedJobs indicates an expected call of RequeueFailedJobs.
+func (mr *MockfailedJobsRepositoryMockRecorder) RequeueFailedJobs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueFailedJobs", reflect.TypeOf((*MockfailedJobsRepository)(nil).RequeueFailedJobs), ctx, ids)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health.go
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockhealthChecker is a mock of healthChecker interface.
+type MockhealthChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockhealthCheckerMockRecorder
+}
+
+// MockhealthCheckerMockRecorder is the mock recorder for MockhealthChecker.
+type MockhealthCheckerMockRecorder struct {
+	mock *MockhealthChecker
+}
+
+// NewMockhealthChecker creates a new mock instance.
+func NewMockhealthChecker(ctrl *gomock.Controller) *MockhealthChecker {
+	mock := &MockhealthChecker{ctrl: ctrl}
+	mock.recorder = &MockhealthCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhealthChecker) EXPECT() *MockhealthCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckHealth mocks base method.
+func (m *MockhealthChecker) CheckHealth(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockhealthCheckerMockRecorder) CheckHealth(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockhealthChecker)(nil).CheckHealth), ctx)
+}
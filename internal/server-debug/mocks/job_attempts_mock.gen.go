@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job_attempts.go
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockjobAttemptsRepository is a mock of jobAttemptsRepository interface.
+type MockjobAttemptsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobAttemptsRepositoryMockRecorder
+}
+
+// MockjobAttemptsRepositoryMockRecorder is the mock recorder for MockjobAttemptsRepository.
+type MockjobAttemptsRepositoryMockRecorder struct {
+	mock *MockjobAttemptsRepository
+}
+
+// NewMockjobAttemptsRepository creates a new mock instance.
+func NewMockjobAttemptsRepository(ctrl *gomock.Controller) *MockjobAttemptsRepository {
+	mock := &MockjobAttemptsRepository{ctrl: ctrl}
+	mock.recorder = &MockjobAttemptsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobAttemptsRepository) EXPECT() *MockjobAttemptsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetJobAttempts mocks base method.
+func (m *MockjobAttemptsRepository) GetJobAttempts(ctx context.Context, jobID types.JobID) ([]jobsrepo.JobAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobAttempts", ctx, jobID)
+	ret0, _ := ret[0].([]jobsrepo.JobAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobAttempts indicates an expected call of GetJobAttempts.
+func (mr *MockjobAttemptsRepositoryMockRecorder) GetJobAttempts(ctx, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobAttempts", reflect.TypeOf((*MockjobAttemptsRepository)(nil).GetJobAttempts), ctx, jobID)
+}
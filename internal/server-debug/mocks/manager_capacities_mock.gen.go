@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager_capacities.go
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockmanagerCapacitiesRepository is a mock of managerCapacitiesRepository interface.
+type MockmanagerCapacitiesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerCapacitiesRepositoryMockRecorder
+}
+
+// MockmanagerCapacitiesRepositoryMockRecorder is the mock recorder for MockmanagerCapacitiesRepository.
+type MockmanagerCapacitiesRepositoryMockRecorder struct {
+	mock *MockmanagerCapacitiesRepository
+}
+
+// NewMockmanagerCapacitiesRepository creates a new mock instance.
+func NewMockmanagerCapacitiesRepository(ctrl *gomock.Controller) *MockmanagerCapacitiesRepository {
+	mock := &MockmanagerCapacitiesRepository{ctrl: ctrl}
+	mock.recorder = &MockmanagerCapacitiesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerCapacitiesRepository) EXPECT() *MockmanagerCapacitiesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteManagerCapacity mocks base method.
+func (m *MockmanagerCapacitiesRepository) DeleteManagerCapacity(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagerCapacity", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagerCapacity indicates an expected call of DeleteManagerCapacity.
+func (mr *MockmanagerCapacitiesRepositoryMockRecorder) DeleteManagerCapacity(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagerCapacity", reflect.TypeOf((*MockmanagerCapacitiesRepository)(nil).DeleteManagerCapacity), ctx, managerID)
+}
+
+// GetManagerCapacities mocks base method.
+func (m *MockmanagerCapacitiesRepository) GetManagerCapacities(ctx context.Context) ([]capacitiesrepo.ManagerCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerCapacities", ctx)
+	ret0, _ := ret[0].([]capacitiesrepo.ManagerCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerCapacities indicates an expected call of GetManagerCapacities.
+func (mr *MockmanagerCapacitiesRepositoryMockRecorder) GetManagerCapacities(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerCapacities", reflect.TypeOf((*MockmanagerCapacitiesRepository)(nil).GetManagerCapacities), ctx)
+}
+
+// SetManagerCapacity mocks base method.
+func (m *MockmanagerCapacitiesRepository) SetManagerCapacity(ctx context.Context, managerID types.UserID, capacity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManagerCapacity", ctx, managerID, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetManagerCapacity indicates an expected call of SetManagerCapacity.
+func (mr *MockmanagerCapacitiesRepositoryMockRecorder) SetManagerCapacity(ctx, managerID, capacity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagerCapacity", reflect.TypeOf((*MockmanagerCapacitiesRepository)(nil).SetManagerCapacity), ctx, managerID, capacity)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager_skills.go
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockmanagerSkillsRepository is a mock of managerSkillsRepository interface.
+type MockmanagerSkillsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerSkillsRepositoryMockRecorder
+}
+
+// MockmanagerSkillsRepositoryMockRecorder is the mock recorder for MockmanagerSkillsRepository.
+type MockmanagerSkillsRepositoryMockRecorder struct {
+	mock *MockmanagerSkillsRepository
+}
+
+// NewMockmanagerSkillsRepository creates a new mock instance.
+func NewMockmanagerSkillsRepository(ctrl *gomock.Controller) *MockmanagerSkillsRepository {
+	mock := &MockmanagerSkillsRepository{ctrl: ctrl}
+	mock.recorder = &MockmanagerSkillsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerSkillsRepository) EXPECT() *MockmanagerSkillsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagerSkills mocks base method.
+func (m *MockmanagerSkillsRepository) GetManagerSkills(ctx context.Context, managerID types.UserID) ([]types.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerSkills", ctx, managerID)
+	ret0, _ := ret[0].([]types.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerSkills indicates an expected call of GetManagerSkills.
+func (mr *MockmanagerSkillsRepositoryMockRecorder) GetManagerSkills(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerSkills", reflect.TypeOf((*MockmanagerSkillsRepository)(nil).GetManagerSkills), ctx, managerID)
+}
+
+// SetManagerSkills mocks base method.
+func (m *MockmanagerSkillsRepository) SetManagerSkills(ctx context.Context, managerID types.UserID, skills []types.Topic) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManagerSkills", ctx, managerID, skills)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetManagerSkills indicates an expected call of SetManagerSkills.
+func (mr *MockmanagerSkillsRepositoryMockRecorder) SetManagerSkills(ctx, managerID, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagerSkills", reflect.TypeOf((*MockmanagerSkillsRepository)(nil).SetManagerSkills), ctx, managerID, skills)
+}
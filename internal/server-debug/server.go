@@ -11,6 +11,8 @@ import (
 	"github.com/labstack/echo-contrib/pprof"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
@@ -28,14 +30,25 @@ const (
 type Options struct {
 	addr          string `option:"mandatory" validate:"required,hostname_port"`
 	lvlSetter     func(level zapcore.Level)
-	clientSchema  *openapi3.T `option:"mandatory" validate:"required"`
-	managerSchema *openapi3.T `option:"mandatory" validate:"required"`
+	clientSchema  *openapi3.T                 `option:"mandatory" validate:"required"`
+	managerSchema *openapi3.T                 `option:"mandatory" validate:"required"`
+	failedJobs    failedJobsRepository        `option:"mandatory" validate:"required"`
+	jobAttempts   jobAttemptsRepository       `option:"mandatory" validate:"required"`
+	capacities    managerCapacitiesRepository `option:"mandatory" validate:"required"`
+	skills        managerSkillsRepository     `option:"mandatory" validate:"required"`
+	health        healthChecker               `option:"mandatory" validate:"required"`
+	metrics       prometheus.Gatherer         `option:"mandatory" validate:"required"`
 }
 
 type Server struct {
-	lg        *zap.Logger
-	srv       *http.Server
-	lvlSetter func(level zapcore.Level)
+	lg          *zap.Logger
+	srv         *http.Server
+	lvlSetter   func(level zapcore.Level)
+	failedJobs  failedJobsRepository
+	jobAttempts jobAttemptsRepository
+	capacities  managerCapacitiesRepository
+	skills      managerSkillsRepository
+	health      healthChecker
 }
 
 func New(opts Options) (*Server, error) {
@@ -60,22 +73,44 @@ func New(opts Options) (*Server, error) {
 			Handler:           e,
 			ReadHeaderTimeout: readHeaderTimeout,
 		},
-		lvlSetter: opts.lvlSetter,
+		lvlSetter:   opts.lvlSetter,
+		failedJobs:  opts.failedJobs,
+		jobAttempts: opts.jobAttempts,
+		capacities:  opts.capacities,
+		skills:      opts.skills,
+		health:      opts.health,
 	}
 	index := newIndexPage()
 
 	e.GET("/version", s.Version)
+	e.GET("/health", s.Health)
+	e.GET("/metrics", echo.WrapHandler(promhttp.HandlerFor(opts.metrics, promhttp.HandlerOpts{})))
 	index.addPage("/version", "Get build information")
+	index.addPage("/health", "Health check")
+	index.addPage("/metrics", "Prometheus metrics")
 	index.addPage("/debug/pprof/profile?seconds=30", "Takes half-minute profile")
 	index.addPage("/debug/sentry", "Heap profile")
 	index.addPage("/schema/client", "Swagger schema for client")
 	index.addPage("/schema/manager", "Swagger schema for manager")
+	index.addPage("/outbox/failed-jobs", "Outbox failed jobs (filters: name, from, to, limit)")
+	index.addPage("/outbox/job-attempts?jobId=", "Outbox job attempts history")
+	index.addPage("/managers/capacities", "Personal capacities of the managers")
+	index.addPage("/managers/skills?managerId=", "Topics the manager is skilled in")
 
 	// Обработка "/log/level"
 	e.PUT("/log/level", s.SetLogLvl)
 	e.GET("/debug/sentry", s.DebugSentry)
 	e.GET("/schema/client", s.ExposeSchema(opts.clientSchema))
 	e.GET("/schema/manager", s.ExposeSchema(opts.managerSchema))
+	e.GET("/outbox/failed-jobs", s.GetFailedJobs)
+	e.POST("/outbox/failed-jobs/requeue", s.RequeueFailedJobs)
+	e.DELETE("/outbox/failed-jobs", s.PurgeFailedJobs)
+	e.GET("/outbox/job-attempts", s.GetJobAttempts)
+	e.GET("/managers/capacities", s.GetManagerCapacities)
+	e.PUT("/managers/capacities", s.SetManagerCapacity)
+	e.DELETE("/managers/capacities", s.DeleteManagerCapacity)
+	e.GET("/managers/skills", s.GetManagerSkills)
+	e.PUT("/managers/skills", s.SetManagerSkills)
 
 	// Обработка "/debug/pprof/" и связанных команд
 	pprof.Register(e)
@@ -7,6 +7,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -16,6 +17,12 @@ func NewOptions(
 	addr string,
 	clientSchema *openapi3.T,
 	managerSchema *openapi3.T,
+	failedJobs failedJobsRepository,
+	jobAttempts jobAttemptsRepository,
+	capacities managerCapacitiesRepository,
+	skills managerSkillsRepository,
+	health healthChecker,
+	metrics prometheus.Gatherer,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -25,6 +32,12 @@ func NewOptions(
 	o.addr = addr
 	o.clientSchema = clientSchema
 	o.managerSchema = managerSchema
+	o.failedJobs = failedJobs
+	o.jobAttempts = jobAttempts
+	o.capacities = capacities
+	o.skills = skills
+	o.health = health
+	o.metrics = metrics
 
 	for _, opt := range options {
 		opt(&o)
@@ -43,6 +56,12 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("addr", _validate_Options_addr(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("clientSchema", _validate_Options_clientSchema(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("managerSchema", _validate_Options_managerSchema(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("failedJobs", _validate_Options_failedJobs(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("jobAttempts", _validate_Options_jobAttempts(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("capacities", _validate_Options_capacities(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("skills", _validate_Options_skills(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("health", _validate_Options_health(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("metrics", _validate_Options_metrics(o)))
 	return errs.AsError()
 }
 
@@ -66,3 +85,45 @@ func _validate_Options_managerSchema(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_failedJobs(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.failedJobs, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `failedJobs` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_jobAttempts(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.jobAttempts, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `jobAttempts` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_capacities(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.capacities, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `capacities` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_skills(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.skills, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `skills` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_health(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.health, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `health` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_metrics(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.metrics, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `metrics` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package clientevents
+
+import (
+	"fmt"
+
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
+)
+
+type Adapter struct{}
+
+func (Adapter) Adapt(ev eventstream.Event) (any, error) {
+	var e Event
+	var err error
+
+	switch v := ev.(type) {
+	case *eventstream.NewMessageEvent:
+		err = e.FromNewMessageEvent(NewMessageEvent{
+			EventId:   v.EventID,
+			RequestId: v.RequestID,
+			MessageId: v.MessageID,
+			AuthorId:  pointer.PtrWithZeroAsNil(v.AuthorID),
+			Body:      v.MessageBody,
+			CreatedAt: v.CreatedAt,
+			IsService: v.IsService,
+		})
+
+	case *eventstream.MessageSentEvent:
+		err = e.FromMessageSentEvent(MessageSentEvent{
+			EventId:   v.EventID,
+			RequestId: v.RequestID,
+			MessageId: v.MessageID,
+		})
+
+	case *eventstream.MessageBlockedEvent:
+		err = e.FromMessageBlockedEvent(MessageBlockedEvent{
+			EventId:   v.EventID,
+			RequestId: v.RequestID,
+			MessageId: v.MessageID,
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown client event: %v (%T)", v, v)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
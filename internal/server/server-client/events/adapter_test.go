@@ -0,0 +1,108 @@
+package clientevents_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientevents "github.com/keepcalmist/chat-service/internal/server/server-client/events"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestAdapter_Adapt(t *testing.T) {
+	cases := []struct {
+		name    string
+		ev      eventstream.Event
+		expJSON string
+	}{
+		{
+			name: "client message",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cb-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a855b28c-bc30-11ed-9e1a-461e464ebed8"),
+				time.Unix(1, 1).UTC(),
+				"Hello!",
+				false,
+			),
+			expJSON: `{
+				"authorId": "a855b28c-bc30-11ed-9e1a-461e464ebed8",
+				"body": "Hello!",
+				"createdAt": "1970-01-01T00:00:01.000000001Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"isService": false,
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "service message",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cb-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.UserIDNil,
+				time.Unix(1, 1).UTC(),
+				"Manager will answer you",
+				true,
+			),
+			expJSON: `{
+				"body": "Manager will answer you",
+				"createdAt": "1970-01-01T00:00:01.000000001Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"isService": true,
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "message sent",
+			ev: eventstream.NewMessageSentEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageSentEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "message blocked",
+			ev: eventstream.NewMessageBlockedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageBlockedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			adapted, err := clientevents.Adapter{}.Adapt(tt.ev)
+			require.NoError(t, err)
+
+			raw, err := json.Marshal(adapted)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expJSON, string(raw))
+		})
+	}
+}
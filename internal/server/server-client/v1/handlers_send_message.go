@@ -9,6 +9,7 @@ import (
 
 	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
 	"github.com/keepcalmist/chat-service/internal/middlewares"
+	"github.com/keepcalmist/chat-service/internal/types"
 	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/client/send-message"
 	"github.com/keepcalmist/chat-service/pkg/pointer"
 )
@@ -31,6 +32,7 @@ func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParam
 		ID:          params.XRequestID,
 		ClientID:    clientID,
 		MessageBody: reqBody.MessageBody,
+		Topic:       types.Topic(pointer.Indirect(reqBody.Topic)),
 	})
 	if err != nil {
 		if errors.Is(err, sendmessage.ErrInvalidRequest) {
@@ -117,3 +117,27 @@ func (s *HandlersSuite) TestSendMessage_Usecase_Success() {
     }
 }`, s.clientID, msgID), resp.Body.String())
 }
+
+func (s *HandlersSuite) TestSendMessage_WithTopic() {
+	// Arrange.
+	reqID := types.NewRequestID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", `{"messageBody": "Hello!", "topic": "loans"}`)
+	s.sendMsgUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:          reqID,
+		ClientID:    s.clientID,
+		MessageBody: "Hello!",
+		Topic:       types.TopicLoans,
+	}).Return(sendmessage.Response{
+		AuthorID:  s.clientID,
+		MessageID: types.NewMessageID(),
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, clientv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+}
@@ -30,6 +30,13 @@ const (
 	ErrorCodeCreateProblemError ErrorCode = 1001
 )
 
+// Defines values for ProblemTopic.
+const (
+	ProblemTopicCards    ProblemTopic = "cards"
+	ProblemTopicDeposits ProblemTopic = "deposits"
+	ProblemTopicLoans    ProblemTopic = "loans"
+)
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
@@ -77,9 +84,15 @@ type MessagesPage struct {
 	Next     string    `json:"next"`
 }
 
+// ProblemTopic The subject of the problem chosen by the client when starting a chat. It is inferred from the message if omitted.
+type ProblemTopic string
+
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
 	MessageBody string `json:"messageBody"`
+
+	// Topic The subject of the problem chosen by the client when starting a chat. It is inferred from the message if omitted.
+	Topic *ProblemTopic `json:"topic,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.
@@ -224,23 +237,25 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xW32/jNgz+VwRuDxvgxM66h4OBPfTHdu2wA4prhx3Q5UGx2VirLfkkOktW+H8fKCmx",
-	"06S7brgbtntKLEoi+X0fKT5CYZrWaNTkIH+EVlrZIKH1X+/e4vsOHV1dXKIs0fKa0pBDFT4T0LJByOHd",
-	"JO6cXF1AAhbfd8piCTnZDhNwRYWN5NP3xjaSIIeuUyUkQJuWzzuySi8hgfVkaSaqaY2lEA5VkMNSUdUt",
-	"poVp0gfEtpB1oxylRSVp4tCuVIGp0oRWyzrlKx308a7owC9Od+lA3/fbsHym31trfHqtNS1aUuiXC1Mi",
-	"/35p8R5y+CId0Erj6dQfPeeNfQIlklS1P7ufWp9Ag87JJR6x9WPI7nYbk+B/3icwOMkfoURXWNWSMsxF",
-	"YTRJpZ24vL29FsgbBZ9zQupSuBYLda8Kseic0uicqM1SFXv7vqIKRS0diaZzJBYofu2y7AS/E7Msy76e",
-	"QgKouwbyO/5OZlk2myfQKK0aXv02y3ZEMgtLr4z1hM9MVtKyRhzntUvi3KIkPK8kBdyTp6ZraxY1NsHK",
-	"+b9GulSOjN1EDo9w1VkXODxAvpVLvFF/ePAauQ5hzzjsXRKzwxy8RsaOXWu0w0PPpST5IZW8CZy6aya2",
-	"TwC3gvugtEIcbwbx7DuXHVXGXpUvrq69ivjZofUluzN9nOrrE1iYcnOUjcJTXJ7SXsilJJyQavAg7j4B",
-	"9Q/Ti6h9ogyVO6tN8YDlKM2FMTVK7YN2b7FAtXrefhPuPmZ+0hJ8vh7RMX57PsbxjC+fD+oZuvj/REOf",
-	"iVSOkTmkNiIotIcDfuKD4P8rwsa9sNswHjFJaa3c8LfGNb34CXIQD3CMN6jLePGzTTieO4u138j1T6iX",
-	"jNxJFvvtdmGWvCwGf9eB/4/Qi2M1/O1mzJMDFp1VtLlhW3C8QGnRnnac2vbrh60Kf/zlFuK84avcWwdZ",
-	"VkRtkInS98azo6hmy5nUD+Kma1mFgh9McV4r1CROr68ggRVaF6aA1YwTMS1q2SrI4WSaTU8g8bL18aXL",
-	"3UvmUTOBvv1Z4jWSYCmLKuzkp5/RlWzn/gDXxtHwJnoHw8R4dxzBYUt6MFH280A6OtqKhgca1D462ba1",
-	"Krz39DfHIT6Ohsm/YutwYHhShjyZ+oWgJI/RN1n2SQKIYvUR7AO+rXtRK0fTqK7UDUp/nisuB6HxdxHr",
-	"RJARPMgxf8d5GxXQf5e4I13mX2buWJ95njoRG3kgb9QbPKrjrnA3Z8z4kdhivn/hBa6wNm3D5R12QQKd",
-	"rWODyNO0NoWsK+Mof5W9ylKu+Xn/ZwAAAP//n9r+mcMNAAA=",
+	"H4sIAAAAAAAC/9xW32/bNhD+V4jbHjZAtuRlD4WAPeTH1nhogaDJsAKZH2jqbLGRSJV3cuMF+t8HkrIt",
+	"x06bDe2w9ckWyeP9+L77jg+gbN1Yg4YJ8gdopJM1Mrrw9fYNvm+ReHpxibJA59e0gRzK+JmAkTVCDm9H",
+	"/cnR9AIScPi+1Q4LyNm1mACpEmvprRfW1ZIhh7bVBSTA68bbEzttlpDA/WhpR7purOMYDpeQw1Jz2c7H",
+	"ytbpHWKjZFVr4lSVkkeEbqUVptowOiOr1F9J0PV39Q7C4nibDnRdtwkrZPqzczak1zjboGONYVnZAv3v",
+	"tw4XkMM36a5aaW+dBtNzf7BLoECWugq2+6l1CdRIJJd4ZK8blux2ezCJ/mddAjsn+QMUSMrphrX1WChr",
+	"WGpD4vLm5kqgPyi8HQlpCkENKr3QSsxb0gaJRGWXWu2d+45LFJUkFnVLLOYo/miz7AR/EpMsy74fQwJo",
+	"2hryW/+dTLJsMkug1kbXfvXHLNsC6VFYBmbcj7zNaCWd5wj5vLZJnDuUjOel5LAEyeOtK2fnFdZx1+f/",
+	"EvlSE1u37jE8glXrKGJ4UPlGLvFa/xmKV8v7GPYkywZJTA5z6LpHjqmxhvDQcyFZfoolryOmdOWB7RLA",
+	"DeE+Sa0Yx+sdefady5ZL66bFs7trryN+I3TTi+HW5+m+LoG5LdZH0VAB4uKU90IuJOOIdY0HcXcJ6H+Y",
+	"Xl+1L5ShprPKqjssBmnOra1QmhA0vUGFevX0/nW8+9j2I0kI+YaKDuu352MYz/Dy2Y49OxX/n3DoK6HK",
+	"MTB3qQ0AivJwgE8/EMJ/zVjTM9XG16NPUjon1/7b4D0/ewQR9AY+xl6Ub2yj1eEYuilRUDt/h4qFXQg/",
+	"UppoIFRpCY2Yr8OqqjQaFh9KNIJYOtZmKaTwFRyLKQtNQpsFOoeFWDhbB6M+HqEXwtaaGYvBVAIlXeFD",
+	"raw0/rfAxpJmgtkx5A/m0jCx8/6q4dqr/trh2sXWRZfANZqiL/mT46nP4KxXxVrev0Kz9Jw6yfpJtFmY",
+	"HKE1b6r+Mdj3EHoC0hDAQdCfYbT14vK3Z1uXAKFqneb1td+LjucoHbrTlsvd1y+bpv719xvon29BNMPu",
+	"rstL5iZ2nTYLG8iuufI7Z9Lcieu28U0t/PtDnEc2nl5NIYEVOopsXk18IrZBIxsNOZyMs/EJJEEFQnzp",
+	"cvsw8J+NJT7siZfIgdeijCc9Z311pd/3cgtXlnj3xIBk7wF+e7yCuyPpwQO9m0XQkXjDNP8+RBOik01T",
+	"aRW8p+/Ih/gweJt/DK3D99cjVWPXYliITAo1+iHLvkgA0UWMYL/gGxkVlSYe9+xKacf0p7Hy7SAMfthK",
+	"DdsoV16XjuI2aKD/LnBHpOlfRu6YzjwNnejnYgRvoA2hqkNVuJ35mvmZu6n5/oUXuMLKNrVv73gKEmhd",
+	"1QtEnqaVVbIqLXH+InuRpb7nZ91fAwAxdID1Eg8AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
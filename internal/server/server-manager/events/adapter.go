@@ -0,0 +1,52 @@
+package managerevents
+
+import (
+	"fmt"
+
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+)
+
+type Adapter struct{}
+
+func (Adapter) Adapt(ev eventstream.Event) (any, error) {
+	var e Event
+	var err error
+
+	switch v := ev.(type) {
+	case *eventstream.NewChatEvent:
+		err = e.FromNewChatEvent(NewChatEvent{
+			EventId:             v.EventID,
+			RequestId:           v.RequestID,
+			ChatId:              v.ChatID,
+			ClientId:            v.ClientID,
+			CanTakeMoreProblems: v.CanTakeMoreProblems,
+		})
+
+	case *eventstream.NewMessageEvent:
+		err = e.FromNewMessageEvent(NewMessageEvent{
+			EventId:   v.EventID,
+			RequestId: v.RequestID,
+			ChatId:    v.ChatID,
+			MessageId: v.MessageID,
+			AuthorId:  v.AuthorID,
+			Body:      v.MessageBody,
+			CreatedAt: v.CreatedAt,
+		})
+
+	case *eventstream.ChatClosedEvent:
+		err = e.FromChatClosedEvent(ChatClosedEvent{
+			EventId:             v.EventID,
+			RequestId:           v.RequestID,
+			ChatId:              v.ChatID,
+			CanTakeMoreProblems: v.CanTakeMoreProblems,
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown manager event: %v (%T)", v, v)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,99 @@
+package managerevents_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	managerevents "github.com/keepcalmist/chat-service/internal/server/server-manager/events"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestAdapter_Adapt(t *testing.T) {
+	cases := []struct {
+		name    string
+		ev      eventstream.Event
+		expJSON string
+	}{
+		{
+			name: "new chat",
+			ev: eventstream.NewNewChatEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cb-461e464ebed8"),
+				types.MustParse[types.UserID]("a855b28c-bc30-11ed-9e1a-461e464ebed8"),
+				true,
+			),
+			expJSON: `{
+				"canTakeMoreProblems": true,
+				"chatId": "31b4dc06-bc31-11ed-93cb-461e464ebed8",
+				"clientId": "a855b28c-bc30-11ed-9e1a-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewChatEvent",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "new message",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cb-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a855b28c-bc30-11ed-9e1a-461e464ebed8"),
+				time.Unix(1, 1).UTC(),
+				"Hello!",
+				false,
+			),
+			expJSON: `{
+				"authorId": "a855b28c-bc30-11ed-9e1a-461e464ebed8",
+				"body": "Hello!",
+				"chatId": "31b4dc06-bc31-11ed-93cb-461e464ebed8",
+				"createdAt": "1970-01-01T00:00:01.000000001Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "chat closed",
+			ev: eventstream.NewChatClosedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cb-461e464ebed8"),
+				false,
+			),
+			expJSON: `{
+				"canTakeMoreProblems": false,
+				"chatId": "31b4dc06-bc31-11ed-93cb-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ChatClosedEvent",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			adapted, err := managerevents.Adapter{}.Adapt(tt.ev)
+			require.NoError(t, err)
+
+			raw, err := json.Marshal(adapted)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expJSON, string(raw))
+		})
+	}
+}
+
+func TestAdapter_Adapt_ClientEvent(t *testing.T) {
+	_, err := managerevents.Adapter{}.Adapt(eventstream.NewMessageSentEvent(
+		types.NewEventID(), types.NewRequestID(), types.NewMessageID(),
+	))
+	require.Error(t, err)
+}
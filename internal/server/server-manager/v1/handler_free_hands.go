@@ -3,15 +3,20 @@ package managerv1
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 
 	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
 	"github.com/keepcalmist/chat-service/internal/middlewares"
+	"github.com/keepcalmist/chat-service/internal/types"
 	freehands "github.com/keepcalmist/chat-service/internal/usecases/manager/free-hands"
 	"github.com/keepcalmist/chat-service/pkg/pointer"
 )
 
+// skillRolePrefix marks the Keycloak roles of the manager resource granting the skills, e.g. "skill:cards".
+const skillRolePrefix = "skill:"
+
 func (h Handlers) PostFreeHands(eCtx echo.Context, params PostFreeHandsParams) error {
 	ctx := eCtx.Request().Context()
 
@@ -23,6 +28,7 @@ func (h Handlers) PostFreeHands(eCtx echo.Context, params PostFreeHandsParams) e
 	err := h.freeHandsUseCase.Handle(ctx, freehands.Request{
 		ID:        params.XRequestID,
 		ManagerID: managerID,
+		Skills:    skillsFromRoles(middlewares.GetResourceRoles(eCtx)),
 	})
 	if err != nil {
 		if errors.Is(err, freehands.ErrManagerCannotTakeMoreProblems) {
@@ -40,3 +46,15 @@ func (h Handlers) PostFreeHands(eCtx echo.Context, params PostFreeHandsParams) e
 
 	return nil
 }
+
+// skillsFromRoles returns the skills granted by the roles. The roles of the unknown topics are ignored.
+func skillsFromRoles(roles []string) []types.Topic {
+	var skills []types.Topic
+	for _, r := range roles {
+		skill, ok := strings.CutPrefix(r, skillRolePrefix)
+		if ok && types.Topic(skill).Valid() {
+			skills = append(skills, types.Topic(skill))
+		}
+	}
+	return skills
+}
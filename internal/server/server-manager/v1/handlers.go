@@ -5,6 +5,11 @@ import (
 
 	canreceiveproblems "github.com/keepcalmist/chat-service/internal/usecases/manager/can-receive-problems"
 	freehands "github.com/keepcalmist/chat-service/internal/usecases/manager/free-hands"
+	getchathistory "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history"
+	getchats "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats"
+	leavehands "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands"
+	resolveproblem "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem"
+	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message"
 )
 
 var _ ServerInterface = (*Handlers)(nil)
@@ -19,10 +24,35 @@ type freeHandsUseCase interface {
 	Handle(ctx context.Context, req freehands.Request) error
 }
 
+type leaveHandsUseCase interface {
+	Handle(ctx context.Context, req leavehands.Request) error
+}
+
+type getChatsUseCase interface {
+	Handle(ctx context.Context, req getchats.Request) (getchats.Response, error)
+}
+
+type getChatHistoryUseCase interface {
+	Handle(ctx context.Context, req getchathistory.Request) (getchathistory.Response, error)
+}
+
+type sendMessageUseCase interface {
+	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
+}
+
+type resolveProblemUseCase interface {
+	Handle(ctx context.Context, req resolveproblem.Request) error
+}
+
 //go:generate options-gen -out-filename=handlers_options.gen.go -from-struct=Options
 type Options struct {
 	canReceiveProblemsUseCase canReceiveProblemsUseCase `option:"mandatory" validate:"required"`
 	freeHandsUseCase          freeHandsUseCase          `option:"mandatory" validate:"required"`
+	leaveHandsUseCase         leaveHandsUseCase         `option:"mandatory" validate:"required"`
+	getChatsUseCase           getChatsUseCase           `option:"mandatory" validate:"required"`
+	getChatHistoryUseCase     getChatHistoryUseCase     `option:"mandatory" validate:"required"`
+	sendMessageUseCase        sendMessageUseCase        `option:"mandatory" validate:"required"`
+	resolveProblemUseCase     resolveProblemUseCase     `option:"mandatory" validate:"required"`
 }
 
 type Handlers struct {
@@ -39,6 +39,9 @@ func adaptGetFreeHandsBtnAvailabilityResponse(resp canreceiveproblems.Response)
 	return GetFreeHandsBtnAvailabilityResponse{
 		Data: &GetFreeHandsBtnAvailability{
 			Available: resp.Result,
+			InPool:    resp.InPool,
+			Load:      resp.Load,
+			Capacity:  resp.Capacity,
 		},
 	}
 }
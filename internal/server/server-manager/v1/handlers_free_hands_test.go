@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/keepcalmist/chat-service/internal/middlewares"
 	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
 	"github.com/keepcalmist/chat-service/internal/types"
 	canreceiveproblems "github.com/keepcalmist/chat-service/internal/usecases/manager/can-receive-problems"
@@ -36,7 +37,7 @@ func (s *HandlersSuite) TestGetFreeHandsBtnAvailability_Usecase_Success() {
 	s.canReceiveProblemsUseCase.EXPECT().Handle(eCtx.Request().Context(), canreceiveproblems.Request{
 		ID:        reqID,
 		ManagerID: s.managerID,
-	}).Return(canreceiveproblems.Response{Result: true}, nil)
+	}).Return(canreceiveproblems.Response{Result: true, Load: 3, Capacity: 5}, nil)
 
 	// Action.
 	err := s.handlers.PostGetFreeHandsBtnAvailability(eCtx, managerv1.PostGetFreeHandsBtnAvailabilityParams{XRequestID: reqID})
@@ -48,7 +49,37 @@ func (s *HandlersSuite) TestGetFreeHandsBtnAvailability_Usecase_Success() {
 {
     "data":
     {
-        "available": true
+        "available": true,
+        "inPool": false,
+        "load": 3,
+        "capacity": 5
+    }
+}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestGetFreeHandsBtnAvailability_ManagerInPool() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getFreeHandsBtnAvailability", "")
+	s.canReceiveProblemsUseCase.EXPECT().Handle(eCtx.Request().Context(), canreceiveproblems.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(canreceiveproblems.Response{Result: false, InPool: true, Load: 1, Capacity: 5}, nil)
+
+	// Action.
+	err := s.handlers.PostGetFreeHandsBtnAvailability(eCtx, managerv1.PostGetFreeHandsBtnAvailabilityParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":
+    {
+        "available": false,
+        "inPool": true,
+        "load": 1,
+        "capacity": 5
     }
 }`, resp.Body.String())
 }
@@ -74,6 +105,25 @@ func (s *HandlersSuite) TestPostFreeHands_UseCase_Success() {
 }`, resp.Body.String())
 }
 
+func (s *HandlersSuite) TestPostFreeHands_SkillsFromRoles() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/freeHands", "")
+	middlewares.SetResourceRoles(eCtx, "support-chat-manager", "skill:loans", "skill:mortgage", "cards", "skill:cards")
+	s.freeHandsUseCase.EXPECT().Handle(gomock.Any(), freehands.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Skills:    []types.Topic{types.TopicLoans, types.TopicCards},
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostFreeHands(eCtx, managerv1.PostFreeHandsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+}
+
 func (s *HandlersSuite) TestPostFreeHands_ManagerIsBusy() {
 	// Arrange.
 
@@ -0,0 +1,71 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	getchathistory "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostGetChatHistory(eCtx echo.Context, params PostGetChatHistoryParams) error {
+	ctx := eCtx.Request().Context()
+
+	reqBody := new(GetChatHistoryRequest)
+	if err := eCtx.Bind(reqBody); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	managerID, ok := middlewares.GetUserID(eCtx)
+	if !ok {
+		return internalErrors.NewServerError(http.StatusBadRequest, "cannot get managerID from context", nil)
+	}
+
+	resp, err := h.getChatHistoryUseCase.Handle(ctx, getchathistory.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    reqBody.ChatId,
+		PageSize:  pointer.Indirect(reqBody.PageSize),
+		Cursor:    pointer.Indirect(reqBody.Cursor),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, getchathistory.ErrInvalidRequest), errors.Is(err, getchathistory.ErrInvalidCursor):
+			return internalErrors.NewServerError(http.StatusBadRequest, "h.getChatHistoryUseCase.Handle err", err)
+		case errors.Is(err, getchathistory.ErrProblemNotFound):
+			return internalErrors.NewServerError(int(ErrorManagerHasNoProblemInChat), "manager has no open problem in the chat", err)
+		}
+		return internalErrors.NewServerError(http.StatusInternalServerError, "h.getChatHistoryUseCase.Handle err", err)
+	}
+
+	err = eCtx.JSONPretty(http.StatusOK, adaptGetChatHistoryResponse(resp), "  ")
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "JSONPretty err", err)
+	}
+
+	return nil
+}
+
+func adaptGetChatHistoryResponse(resp getchathistory.Response) GetChatHistoryResponse {
+	messages := make([]Message, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		messages = append(messages, Message{
+			Id:        m.ID,
+			AuthorId:  m.AuthorID,
+			Body:      m.Body,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return GetChatHistoryResponse{
+		Data: &MessagesPage{
+			Messages: messages,
+			Next:     resp.NextCursor,
+		},
+	}
+}
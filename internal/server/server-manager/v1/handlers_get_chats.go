@@ -0,0 +1,50 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	getchats "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats"
+)
+
+func (h Handlers) PostGetChats(eCtx echo.Context, params PostGetChatsParams) error {
+	ctx := eCtx.Request().Context()
+
+	managerID, ok := middlewares.GetUserID(eCtx)
+	if !ok {
+		return internalErrors.NewServerError(http.StatusBadRequest, "cannot get managerID from context", nil)
+	}
+
+	resp, err := h.getChatsUseCase.Handle(ctx, getchats.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "h.getChatsUseCase.Handle err", err)
+	}
+
+	err = eCtx.JSONPretty(http.StatusOK, adaptGetChatsResponse(resp), "  ")
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "JSONPretty err", err)
+	}
+
+	return nil
+}
+
+func adaptGetChatsResponse(resp getchats.Response) GetChatsResponse {
+	chats := make([]Chat, 0, len(resp.Chats))
+	for _, c := range resp.Chats {
+		chats = append(chats, Chat{
+			ChatId:    c.ID,
+			ClientId:  c.ClientID,
+			CreatedAt: c.ProblemCreatedAt,
+		})
+	}
+
+	return GetChatsResponse{
+		Data: &ChatList{Chats: chats},
+	}
+}
@@ -0,0 +1,97 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	"github.com/keepcalmist/chat-service/internal/types"
+	getchats "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats"
+)
+
+func (s *HandlersSuite) TestGetChats_UseCase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+	s.getChatsUseCase.EXPECT().Handle(gomock.Any(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetChats_UseCase_NoChats() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+	s.getChatsUseCase.EXPECT().Handle(gomock.Any(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":
+    {
+        "chats": []
+    }
+}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestGetChats_UseCase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	createdAt := time.Unix(1, 1).UTC()
+
+	s.getChatsUseCase.EXPECT().Handle(gomock.Any(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{
+		Chats: []getchats.Chat{{
+			ID:               chatID,
+			ClientID:         clientID,
+			ProblemCreatedAt: createdAt,
+		}},
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "chats":
+        [
+            {
+                "chatId": %q,
+                "clientId": %q,
+                "createdAt": "1970-01-01T00:00:01.000000001Z"
+            }
+        ]
+    }
+}`, chatID, clientID), resp.Body.String())
+}
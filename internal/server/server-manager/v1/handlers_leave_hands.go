@@ -0,0 +1,38 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	leavehands "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostLeaveHands(eCtx echo.Context, params PostLeaveHandsParams) error {
+	ctx := eCtx.Request().Context()
+
+	managerID, ok := middlewares.GetUserID(eCtx)
+	if !ok {
+		return internalErrors.NewServerError(http.StatusBadRequest, "cannot get managerID from context", nil)
+	}
+
+	err := h.leaveHandsUseCase.Handle(ctx, leavehands.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "h.leaveHandsUseCase.Handle err", err)
+	}
+
+	err = eCtx.JSONPretty(http.StatusOK, LeaveHandsResponse{
+		Data: pointer.Ptr(make(map[string]interface{})),
+	}, "  ")
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "JSONPretty err", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package managerv1_test
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+
+	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	"github.com/keepcalmist/chat-service/internal/types"
+	leavehands "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands"
+)
+
+func (s *HandlersSuite) TestPostLeaveHands_UseCase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/leaveHands", "")
+	s.leaveHandsUseCase.EXPECT().Handle(gomock.Any(), leavehands.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostLeaveHands(eCtx, managerv1.PostLeaveHandsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":{}
+}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestPostLeaveHands_UseCase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/leaveHands", "")
+	s.leaveHandsUseCase.EXPECT().Handle(gomock.Any(), leavehands.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostLeaveHands(eCtx, managerv1.PostLeaveHandsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body.String())
+}
@@ -13,6 +13,11 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	canReceiveProblemsUseCase canReceiveProblemsUseCase,
 	freeHandsUseCase freeHandsUseCase,
+	leaveHandsUseCase leaveHandsUseCase,
+	getChatsUseCase getChatsUseCase,
+	getChatHistoryUseCase getChatHistoryUseCase,
+	sendMessageUseCase sendMessageUseCase,
+	resolveProblemUseCase resolveProblemUseCase,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -21,6 +26,11 @@ func NewOptions(
 
 	o.canReceiveProblemsUseCase = canReceiveProblemsUseCase
 	o.freeHandsUseCase = freeHandsUseCase
+	o.leaveHandsUseCase = leaveHandsUseCase
+	o.getChatsUseCase = getChatsUseCase
+	o.getChatHistoryUseCase = getChatHistoryUseCase
+	o.sendMessageUseCase = sendMessageUseCase
+	o.resolveProblemUseCase = resolveProblemUseCase
 
 	for _, opt := range options {
 		opt(&o)
@@ -32,6 +42,11 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("canReceiveProblemsUseCase", _validate_Options_canReceiveProblemsUseCase(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("freeHandsUseCase", _validate_Options_freeHandsUseCase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("leaveHandsUseCase", _validate_Options_leaveHandsUseCase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getChatsUseCase", _validate_Options_getChatsUseCase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getChatHistoryUseCase", _validate_Options_getChatHistoryUseCase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sendMessageUseCase", _validate_Options_sendMessageUseCase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("resolveProblemUseCase", _validate_Options_resolveProblemUseCase(o)))
 	return errs.AsError()
 }
 
@@ -48,3 +63,38 @@ func _validate_Options_freeHandsUseCase(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_leaveHandsUseCase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.leaveHandsUseCase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `leaveHandsUseCase` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getChatsUseCase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getChatsUseCase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getChatsUseCase` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getChatHistoryUseCase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getChatHistoryUseCase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getChatHistoryUseCase` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_sendMessageUseCase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendMessageUseCase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sendMessageUseCase` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_resolveProblemUseCase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolveProblemUseCase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `resolveProblemUseCase` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	resolveproblem "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem"
+	"github.com/keepcalmist/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostResolveProblem(eCtx echo.Context, params PostResolveProblemParams) error {
+	ctx := eCtx.Request().Context()
+
+	reqBody := new(ResolveProblemRequest)
+	if err := eCtx.Bind(reqBody); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	managerID, ok := middlewares.GetUserID(eCtx)
+	if !ok {
+		return internalErrors.NewServerError(http.StatusBadRequest, "cannot get managerID from context", nil)
+	}
+
+	err := h.resolveProblemUseCase.Handle(ctx, resolveproblem.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    reqBody.ChatId,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, resolveproblem.ErrInvalidRequest):
+			return internalErrors.NewServerError(http.StatusBadRequest, "h.resolveProblemUseCase.Handle err", err)
+		case errors.Is(err, resolveproblem.ErrProblemNotFound):
+			return internalErrors.NewServerError(int(ErrorManagerHasNoProblemInChat), "manager has no open problem in the chat", err)
+		}
+		return internalErrors.NewServerError(http.StatusInternalServerError, "h.resolveProblemUseCase.Handle err", err)
+	}
+
+	err = eCtx.JSONPretty(http.StatusOK, ResolveProblemResponse{
+		Data: pointer.Ptr(make(map[string]interface{})),
+	}, "  ")
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "JSONPretty err", err)
+	}
+
+	return nil
+}
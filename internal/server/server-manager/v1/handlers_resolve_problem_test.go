@@ -0,0 +1,77 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	"github.com/keepcalmist/chat-service/internal/types"
+	resolveproblem "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem"
+)
+
+func (s *HandlersSuite) TestResolveProblem_UseCase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(resolveproblem.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(int(managerv1.ErrorManagerHasNoProblemInChat), internalErrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_UseCase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusInternalServerError, internalErrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_UseCase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":{}
+}`, resp.Body.String())
+}
@@ -0,0 +1,56 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message"
+)
+
+func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParams) error {
+	ctx := eCtx.Request().Context()
+
+	reqBody := new(SendMessageRequest)
+	if err := eCtx.Bind(reqBody); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	managerID, ok := middlewares.GetUserID(eCtx)
+	if !ok {
+		return internalErrors.NewServerError(http.StatusBadRequest, "cannot get managerID from context", nil)
+	}
+
+	resp, err := h.sendMessageUseCase.Handle(ctx, sendmessage.Request{
+		ID:          params.XRequestID,
+		ManagerID:   managerID,
+		ChatID:      reqBody.ChatId,
+		MessageBody: reqBody.MessageBody,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sendmessage.ErrInvalidRequest):
+			return internalErrors.NewServerError(http.StatusBadRequest, "h.sendMessageUseCase.Handle err", err)
+		case errors.Is(err, sendmessage.ErrProblemNotFound):
+			return internalErrors.NewServerError(int(ErrorManagerHasNoProblemInChat), "manager has no open problem in the chat", err)
+		}
+		return internalErrors.NewServerError(http.StatusInternalServerError, "h.sendMessageUseCase.Handle err", err)
+	}
+
+	err = eCtx.JSONPretty(http.StatusOK, SendMessageResponse{
+		Data: &MessageWithoutBody{
+			Id:        resp.MessageID,
+			AuthorId:  resp.AuthorID,
+			CreatedAt: resp.CreatedAt,
+		},
+	}, "  ")
+	if err != nil {
+		return internalErrors.NewServerError(http.StatusInternalServerError, "JSONPretty err", err)
+	}
+
+	return nil
+}
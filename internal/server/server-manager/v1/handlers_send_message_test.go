@@ -0,0 +1,124 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	internalErrors "github.com/keepcalmist/chat-service/internal/errors"
+	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	"github.com/keepcalmist/chat-service/internal/types"
+	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message"
+)
+
+func (s *HandlersSuite) TestSendMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", `{"chatId":`)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_UseCase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":""}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(gomock.Any(), sendmessage.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(sendmessage.Response{}, sendmessage.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalErrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_UseCase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(gomock.Any(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{}, sendmessage.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(int(managerv1.ErrorManagerHasNoProblemInChat), internalErrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_UseCase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(gomock.Any(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusInternalServerError, internalErrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_UseCase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(gomock.Any(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{
+		MessageID: msgID,
+		AuthorID:  s.managerID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": %q,
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": %q
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
@@ -24,6 +24,11 @@ type HandlersSuite struct {
 	canReceiveProblemsUseCase *managerv1mocks.MockcanReceiveProblemsUseCase
 	handlers                  managerv1.Handlers
 	freeHandsUseCase          *managerv1mocks.MockfreeHandsUseCase
+	leaveHandsUseCase         *managerv1mocks.MockleaveHandsUseCase
+	getChatsUseCase           *managerv1mocks.MockgetChatsUseCase
+	getChatHistoryUseCase     *managerv1mocks.MockgetChatHistoryUseCase
+	sendMessageUseCase        *managerv1mocks.MocksendMessageUseCase
+	resolveProblemUseCase     *managerv1mocks.MockresolveProblemUseCase
 
 	managerID types.UserID
 }
@@ -37,10 +42,23 @@ func (s *HandlersSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.canReceiveProblemsUseCase = managerv1mocks.NewMockcanReceiveProblemsUseCase(s.ctrl)
 	s.freeHandsUseCase = managerv1mocks.NewMockfreeHandsUseCase(s.ctrl)
+	s.leaveHandsUseCase = managerv1mocks.NewMockleaveHandsUseCase(s.ctrl)
+	s.getChatsUseCase = managerv1mocks.NewMockgetChatsUseCase(s.ctrl)
+	s.getChatHistoryUseCase = managerv1mocks.NewMockgetChatHistoryUseCase(s.ctrl)
+	s.sendMessageUseCase = managerv1mocks.NewMocksendMessageUseCase(s.ctrl)
+	s.resolveProblemUseCase = managerv1mocks.NewMockresolveProblemUseCase(s.ctrl)
 
 	{
 		var err error
-		s.handlers, err = managerv1.NewHandlers(managerv1.NewOptions(s.canReceiveProblemsUseCase, s.freeHandsUseCase))
+		s.handlers, err = managerv1.NewHandlers(managerv1.NewOptions(
+			s.canReceiveProblemsUseCase,
+			s.freeHandsUseCase,
+			s.leaveHandsUseCase,
+			s.getChatsUseCase,
+			s.getChatHistoryUseCase,
+			s.sendMessageUseCase,
+			s.resolveProblemUseCase,
+		))
 		s.Require().NoError(err)
 	}
 	s.managerID = types.NewUserID()
@@ -57,7 +75,7 @@ func (s *HandlersSuite) TearDownTest() {
 func (s *HandlersSuite) newEchoCtx(
 	requestID types.RequestID,
 	path string,
-	body string, //nolint:unparam
+	body string,
 ) (*httptest.ResponseRecorder, echo.Context) {
 	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
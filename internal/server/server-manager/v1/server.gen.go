@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/keepcalmist/chat-service/internal/types"
@@ -26,8 +27,21 @@ const (
 // Defines values for ErrorCode.
 const (
 	ErrorManagerCannotTakeMoreProblems ErrorCode = 5000
+	ErrorManagerHasNoProblemInChat     ErrorCode = 5001
 )
 
+// Chat defines model for Chat.
+type Chat struct {
+	ChatId    types.ChatID `json:"chatId"`
+	ClientId  types.UserID `json:"clientId"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// ChatList defines model for ChatList.
+type ChatList struct {
+	Chats []Chat `json:"chats"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
@@ -45,9 +59,37 @@ type FreeHandsResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// GetChatHistoryRequest defines model for GetChatHistoryRequest.
+type GetChatHistoryRequest struct {
+	ChatId   types.ChatID `json:"chatId"`
+	Cursor   *string      `json:"cursor,omitempty"`
+	PageSize *int         `json:"pageSize,omitempty"`
+}
+
+// GetChatHistoryResponse defines model for GetChatHistoryResponse.
+type GetChatHistoryResponse struct {
+	Data  *MessagesPage `json:"data,omitempty"`
+	Error *Error        `json:"error,omitempty"`
+}
+
+// GetChatsResponse defines model for GetChatsResponse.
+type GetChatsResponse struct {
+	Data  *ChatList `json:"data,omitempty"`
+	Error *Error    `json:"error,omitempty"`
+}
+
 // GetFreeHandsBtnAvailability defines model for GetFreeHandsBtnAvailability.
 type GetFreeHandsBtnAvailability struct {
 	Available bool `json:"available"`
+
+	// Capacity The number of the problems the manager can handle at the same time.
+	Capacity int `json:"capacity"`
+
+	// InPool The manager is waiting for problems and can leave the pool.
+	InPool bool `json:"inPool"`
+
+	// Load The number of the manager's open problems.
+	Load int `json:"load"`
 }
 
 // GetFreeHandsBtnAvailabilityResponse defines model for GetFreeHandsBtnAvailabilityResponse.
@@ -56,6 +98,56 @@ type GetFreeHandsBtnAvailabilityResponse struct {
 	Error *Error                       `json:"error,omitempty"`
 }
 
+// LeaveHandsResponse defines model for LeaveHandsResponse.
+type LeaveHandsResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	Body      string          `json:"body"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// MessageWithoutBody defines model for MessageWithoutBody.
+type MessageWithoutBody struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// MessagesPage defines model for MessagesPage.
+type MessagesPage struct {
+	Messages []Message `json:"messages"`
+	Next     string    `json:"next"`
+}
+
+// ResolveProblemRequest defines model for ResolveProblemRequest.
+type ResolveProblemRequest struct {
+	ChatId types.ChatID `json:"chatId"`
+}
+
+// ResolveProblemResponse defines model for ResolveProblemResponse.
+type ResolveProblemResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// SendMessageRequest defines model for SendMessageRequest.
+type SendMessageRequest struct {
+	ChatId      types.ChatID `json:"chatId"`
+	MessageBody string       `json:"messageBody"`
+}
+
+// SendMessageResponse defines model for SendMessageResponse.
+type SendMessageResponse struct {
+	Data  *MessageWithoutBody `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
@@ -64,19 +156,68 @@ type PostFreeHandsParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetChatHistoryParams defines parameters for PostGetChatHistory.
+type PostGetChatHistoryParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetChatsParams defines parameters for PostGetChats.
+type PostGetChatsParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetFreeHandsBtnAvailabilityParams defines parameters for PostGetFreeHandsBtnAvailability.
 type PostGetFreeHandsBtnAvailabilityParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostLeaveHandsParams defines parameters for PostLeaveHands.
+type PostLeaveHandsParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostResolveProblemParams defines parameters for PostResolveProblem.
+type PostResolveProblemParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSendMessageParams defines parameters for PostSendMessage.
+type PostSendMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetChatHistoryJSONRequestBody defines body for PostGetChatHistory for application/json ContentType.
+type PostGetChatHistoryJSONRequestBody = GetChatHistoryRequest
+
+// PostResolveProblemJSONRequestBody defines body for PostResolveProblem for application/json ContentType.
+type PostResolveProblemJSONRequestBody = ResolveProblemRequest
+
+// PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
+type PostSendMessageJSONRequestBody = SendMessageRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
 	// (POST /freeHands)
 	PostFreeHands(ctx echo.Context, params PostFreeHandsParams) error
 
+	// (POST /getChatHistory)
+	PostGetChatHistory(ctx echo.Context, params PostGetChatHistoryParams) error
+
+	// (POST /getChats)
+	PostGetChats(ctx echo.Context, params PostGetChatsParams) error
+
 	// (POST /getFreeHandsBtnAvailability)
 	PostGetFreeHandsBtnAvailability(ctx echo.Context, params PostGetFreeHandsBtnAvailabilityParams) error
+
+	// (POST /leaveHands)
+	PostLeaveHands(ctx echo.Context, params PostLeaveHandsParams) error
+
+	// (POST /resolveProblem)
+	PostResolveProblem(ctx echo.Context, params PostResolveProblemParams) error
+
+	// (POST /sendMessage)
+	PostSendMessage(ctx echo.Context, params PostSendMessageParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -117,6 +258,72 @@ func (w *ServerInterfaceWrapper) PostFreeHands(ctx echo.Context) error {
 	return err
 }
 
+// PostGetChatHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChatHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatHistoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChatHistory(ctx, params)
+	return err
+}
+
+// PostGetChats converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChats(ctx, params)
+	return err
+}
+
 // PostGetFreeHandsBtnAvailability converts echo context to params.
 func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Context) error {
 	var err error
@@ -150,6 +357,105 @@ func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Contex
 	return err
 }
 
+// PostLeaveHands converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLeaveHands(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLeaveHandsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLeaveHands(ctx, params)
+	return err
+}
+
+// PostResolveProblem converts echo context to params.
+func (w *ServerInterfaceWrapper) PostResolveProblem(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostResolveProblemParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostResolveProblem(ctx, params)
+	return err
+}
+
+// PostSendMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSendMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSendMessage(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -179,29 +485,47 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/freeHands", wrapper.PostFreeHands)
+	router.POST(baseURL+"/getChatHistory", wrapper.PostGetChatHistory)
+	router.POST(baseURL+"/getChats", wrapper.PostGetChats)
 	router.POST(baseURL+"/getFreeHandsBtnAvailability", wrapper.PostGetFreeHandsBtnAvailability)
+	router.POST(baseURL+"/leaveHands", wrapper.PostLeaveHands)
+	router.POST(baseURL+"/resolveProblem", wrapper.PostResolveProblem)
+	router.POST(baseURL+"/sendMessage", wrapper.PostSendMessage)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xVzW7bRhB+lcW0hxagRLpugYBAD47d1C4QwEgMNICtw4ocS1uTu8zuUohhEEjjQ489",
-	"tPe+gmPEbuAf5RVGb1TMUrLkypDbnnqSdmdmZ77vmxmeQGbKymjU3kF6ApW0skSPNpxevcDXNTq/s7WN",
-	"MkfLd0pDCsP2GIGWJUIKrzpTz87OFkRg8XWtLOaQeltjBC4bYik5+tDYUnpIoa5VDhH444rjnbdKDyCC",
-	"N52B6aiyMta35fghpDBQflj3u5kp4yPEKpNFqZyPs6H0HYd2pDKMlfZotSxiftJBM31rmiBcdu/gQNM0",
-	"s7IC0u+sNQFeZU2F1isM15nJkX8/t3gIKXwWz9mKp9FxCN1kxyaCHL1URYi9D62JoETn5AAfsDWLlO3f",
-	"OUZt/l4TwTxJegI5usyqyivDWmRGe6m0E9t7e7sC2VFwnBNS58JVmKlDlYl+7ZRG50RhBiq75/eFH6Io",
-	"pPOirJ0XfRQHdZKs47diLUmSL7sQAeq6hHT/myRJehGUSquSL75OkjsNWYBBaIo3HXbvjKTl9nAMKdT/",
-	"XGo5QLsptTZ+Tx7hc2Nx15p+gaULMJ9ZxG2pc/cCXWW0w2VJculDJ8k8V8yALHYX7NxvTQQ4k/NR4dpO",
-	"+B79XeqnXm+MpCpkXxXKHy9XIFtrsahk35gCpV6Scu7bW53mccCroKyq/1/TwZOBWW2VP37JtraMPkqL",
-	"dqPmgZydns2m+Ycf92A6T4GNYJ2P99D7qiVa6UMTaFOe+YOnUh+Jl3XFAy82h9KLaZOIjd0diGCE1rVt",
-	"PlpjJKZCLSsFKax3k+46RGFFhALjwxkDgUPj/PKs0O80pvd0Rud0TRd0Sx/pQtBN+HtBH+iSLiZv6UzQ",
-	"uaBPk1O6XjaO2faBrie/Cn6L3ek9jSfv6Io+ctTbkIKfv4FQsJWcfSeHFHaNmwsVip+v2/2H5Zm7xEvr",
-	"uOlxt7WNE1B/lSTt2tIedcAvq6pQWagg/skxCScL63hVPyzPYlDwPqHsJIbsJfq190YLudB73Wk7xYNH",
-	"5uthsf6gMV1PTie/zKU6pzH9STc0pku6pfHk58k7Zv2WzuiSzvjAutyyhFfsQJ/oanIqDoB+CxoF9a7+",
-	"JhOd0c0BPCjWqsH6f8v3T3bNfxR0YUEE3IurYb/HqPijPGPl/vtbOMLCVCVqL1oviKC2xXRLpHFcmEwW",
-	"Q+N8+iR5shbz3PeavwIAAP//31+60qgIAAA=",
+	"H4sIAAAAAAAC/+RY3W4buRV+FYIt0BYYW+OmCywE9CI/3dpFUhiJi10g8QU1cyyxmSFnSY5qNxAQ2xeL",
+	"IkD3ogV61170BbRGnGjtWHkFzhsVh0NJI81IdpyfOtgbW8O/8/Odc/gdPqORTDMpQBhN289oxhRLwYBy",
+	"X988hG9z0Gbr3iawGBSOcUHbtFd+BlSwFGibfrPmV65t3aMBVfBtzhXEtG1UDgHVUQ9Shrv3pEqZoW2a",
+	"5zymATUHGe7XRnHRpQHdX+vKNZ5mUplSHdOjbdrlppd31iOZtp4CZBFLUq5NK+oxs6ZB9XkELS4MKMGS",
+	"Fh6p6cCf5QW4wfWpOXQwGEzUcpbe7bFSoJIZKMPBjaKArfjKes/JwhO37lWnPoxdg4BGCQdxbcX+pEF9",
+	"LMUUMAPxbTOnWcwMrBmeQk29waAaK48n/q5YWD1zdxA4nO5zvQQr94MbSN2PnyvYo236s9YsxFse8pbD",
+	"ezDVhynFDhrV0U7s75SSqkGmjOEySW7rXVw4CGgMhvHE7V3wREBT0Jp1oWFuQa3JwqCUP9XvrtcmBh0p",
+	"nhkuMVUjKQzjQpPNnZ1tAriQ4D5NmIiJziDiezwinVxzAVqTRHZ5NLful6YHJGHakDTXhnSAPMnD8Bb8",
+	"lmyEYfirdRpQEHlK24+/CMMw+CIMN3YDmnLBUxz9TRhO/Ywx03WFY38N96z1mcISotEuZ8QDJlgX1F0m",
+	"hDQ77Ck8kAq2lewkiGowt2iT6T9KP7clHKTojK8UwCYTsX4IOpNCQx24mBlXjlgcc/QTS7Yr81i0BgGF",
+	"CeiXwluWk9+DQR02uTZSHfha8/kUlVzp0txaZGasC4/4X50fU7ZfwroRhhWQN+oYL0nu3QZPXQbTKgAe",
+	"lNmgtzElro+afj8tpnXpehpMQ/aOEbf7jCeswxNuDurKsHI2qdaJjpQJMIGyI5axyG+crwM7PSAiTzug",
+	"iNwjmNKZzyr3kZYpRSImSI+JOAHCjJvRLAWC5Xud1jEOKBfbUibN8iaHck3+wrjhokv2pJoJxgqEAhNg",
+	"fSh1kjKpyKlYlkgWX8UqL/MXmsgMxFTWOr00QGeunVrlxVb8ursasPeLohUHXyew7qNb/x+V8MHsKluI",
+	"3tz0pLqBzKUj44PG2vfOlCag/Jrmea99FAsXQt1pNAXDm7/Itbw+X3PTk7m54z30mQD608CtEbDyKqxB",
+	"5Wnj1TmyP65OkwMqYN9cmahq6jegjg9By6Q/YXSfF0laTmgWrfqk1fYRiNhj9bmRTh8hk9qSsv37ILp4",
+	"4q3Q88vJwMaVm8fqobuL/vkAVLNaEN8ZLnx2gChX3Bw8wrlSegeYAnU7N73Z11cToP7w9Q71jxWOE7nZ",
+	"GXI9Y7IyELjYky4ruUF+SO8w8ZQ8yjPEiiCKxDdO5Pb2Fg1oH5QuaVR/Ay1BwsQyTtv01nq4fosGDl2n",
+	"YGtvwkvwK5Pa1LmY/acd2x/s0J7Yc3tqL+zInhL7xv08tS/tK3taPLdDYk+IfVsc2/P65BjnXtrz4nuC",
+	"Z+Fy+4MdF0f2zI5w13MnAo9/Q53CiqF0DG26LfWMPtFg7i3rcTM8syWt2lvXYBfjq4wXZ/Wvw7Bs+oUB",
+	"4exnWZbwyGnQ+rNGJzyrvHWtiod6j+oQnHcoLnJkXJNObowUhFUY4boPp1Z3rptagc9/7NieF8fFdzN0",
+	"RsVhcWTHxXM7siNSHNqx8/vf/IofSXFkT+1ZceyGflyAoHiBYBbf2SEuawRkvtX7UKi4sUnV+CCANDfv",
+	"CzXfF+iPFhVL+uKG0Jhc9STh2ixGgn6nGCgO7VuMA3tmhxMsMQ+LQ1JmXvG8eFEcFS/sm1oO2mE5WEvy",
+	"VbFw03Oz9iLQ5H9fSF2P6d4JqyCsbOevnJsndmxf2zd2bF/ZCzt2mToi9sIO7SuH0gjL5AVW1DNcYN9i",
+	"opIn1P7Djj2I9qwBsSd0GTxLFb/xiF3akF+/vibTNnoFfv+1L+3wkntvZF/7m88O6wvG9iQgDseT4oU9",
+	"XcANSy3+O8Ylp2UUvMa/LmP/XhwVh8X3jbDOXgFuOIoNzxUrMi+BPVN5MvJYqTkivgKvf9nhpLLZUaO3",
+	"G4nLyrtuvgu4uXddcw/2ie+6JS1TA+J+CfHYxlOw9YzYr0D638WRAxd5qbviFkgOFtEze45RYC+Ko+J4",
+	"hvPiHWjHNQpUDl3t/qs0Ijc3OBq6yU8cGU392nIKRPwbSBkVlfbKebXaWD3eRZ9hNzrx+fyB96APicxS",
+	"EIaUq2hAc5X4HqvdaiUyYklPatP+Mvxyo4Vd0+7gfwMAo2jSI0MfAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -19,6 +19,7 @@ import (
 	"github.com/keepcalmist/chat-service/internal/server/server-client/errhandler"
 	clientv1 "github.com/keepcalmist/chat-service/internal/server/server-client/v1"
 	managerv1 "github.com/keepcalmist/chat-service/internal/server/server-manager/v1"
+	websocketstream "github.com/keepcalmist/chat-service/internal/websocket-stream"
 )
 
 const (
@@ -30,6 +31,10 @@ type handlersToRegister interface {
 	clientv1.Handlers | managerv1.Handlers
 }
 
+type wsHTTPHandler interface {
+	Serve(eCtx echo.Context) error
+}
+
 //go:generate options-gen -out-filename=server_options.gen.go -from-struct=Options
 type Options struct {
 	logger       *zap.Logger              `option:"mandatory" validate:"required"`
@@ -40,6 +45,7 @@ type Options struct {
 	role         string                   `option:"mandatory" validate:"required"`
 	resource     string                   `option:"mandatory" validate:"required"`
 	isProduction bool                     `option:"mandatory"`
+	wsHandler    wsHTTPHandler            `option:"mandatory" validate:"required"`
 }
 
 type Server struct {
@@ -70,16 +76,21 @@ func New[t handlersToRegister](opts Options, handlers t) (*Server, error) {
 			AllowMethods: []string{http.MethodPost},
 		}),
 		middleware.BodyLimit("3K"),
-		middlewares.NewKeycloakTokenAuth(opts.introspector, opts.resource, opts.role),
 	)
 
-	v1 := e.Group("v1", oapimdlwr.OapiRequestValidatorWithOptions(opts.v1Swagger, &oapimdlwr.Options{
-		Options: openapi3filter.Options{
-			ExcludeRequestBody:  false,
-			ExcludeResponseBody: true,
-			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
-		},
-	}))
+	e.GET("/ws", opts.wsHandler.Serve,
+		middlewares.NewKeycloakWsTokenAuth(opts.introspector, opts.resource, opts.role, websocketstream.SecWsProtocol))
+
+	v1 := e.Group("v1",
+		middlewares.NewKeycloakTokenAuth(opts.introspector, opts.resource, opts.role),
+		oapimdlwr.OapiRequestValidatorWithOptions(opts.v1Swagger, &oapimdlwr.Options{
+			Options: openapi3filter.Options{
+				ExcludeRequestBody:  false,
+				ExcludeResponseBody: true,
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			},
+		}),
+	)
 
 	switch any(handlers).(type) {
 	case clientv1.Handlers:
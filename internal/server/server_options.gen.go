@@ -22,6 +22,7 @@ func NewOptions(
 	role string,
 	resource string,
 	isProduction bool,
+	wsHandler wsHTTPHandler,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -36,6 +37,7 @@ func NewOptions(
 	o.role = role
 	o.resource = resource
 	o.isProduction = isProduction
+	o.wsHandler = wsHandler
 
 	for _, opt := range options {
 		opt(&o)
@@ -52,6 +54,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("introspector", _validate_Options_introspector(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("role", _validate_Options_role(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("resource", _validate_Options_resource(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("wsHandler", _validate_Options_wsHandler(o)))
 	return errs.AsError()
 }
 
@@ -103,3 +106,10 @@ func _validate_Options_resource(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_wsHandler(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.wsHandler, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `wsHandler` did not pass the test: %w", err)
+	}
+	return nil
+}
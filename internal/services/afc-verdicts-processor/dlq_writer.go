@@ -0,0 +1,27 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"io"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/keepcalmist/chat-service/internal/logger"
+)
+
+type KafkaDLQWriter interface {
+	io.Closer
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+func NewKafkaDLQWriter(brokers []string, topic string) KafkaDLQWriter {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     kafka.CRC32Balancer{},
+		RequiredAcks: kafka.RequireOne,
+		Async:        false,
+		Logger:       logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	}
+}
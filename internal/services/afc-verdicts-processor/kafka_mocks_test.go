@@ -0,0 +1,85 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+
+	afcverdictsprocessor "github.com/keepcalmist/chat-service/internal/services/afc-verdicts-processor"
+)
+
+var (
+	_ afcverdictsprocessor.KafkaReader    = (*kafkaReaderMock)(nil)
+	_ afcverdictsprocessor.KafkaDLQWriter = (*kafkaWriterMock)(nil)
+)
+
+// kafkaReaderMock returns the queued messages one by one.
+// Once the queue is drained, onDrained is called and the reader blocks until the context is done.
+type kafkaReaderMock struct {
+	mu        sync.Mutex
+	queue     []kafka.Message
+	committed []kafka.Message
+	closed    bool
+	onDrained func()
+}
+
+func newKafkaReaderMock(onDrained func(), msgs ...kafka.Message) *kafkaReaderMock {
+	for i := range msgs {
+		msgs[i].Offset = int64(i)
+	}
+	return &kafkaReaderMock{queue: msgs, onDrained: onDrained}
+}
+
+func (r *kafkaReaderMock) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	r.mu.Lock()
+	if len(r.queue) > 0 {
+		msg := r.queue[0]
+		r.queue = r.queue[1:]
+		r.mu.Unlock()
+		return msg, nil
+	}
+	r.mu.Unlock()
+
+	r.onDrained()
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (r *kafkaReaderMock) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.committed = append(r.committed, msgs...)
+	return nil
+}
+
+func (r *kafkaReaderMock) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+	return nil
+}
+
+type kafkaWriterMock struct {
+	mu       sync.Mutex
+	messages []kafka.Message
+	closed   bool
+}
+
+func (w *kafkaWriterMock) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *kafkaWriterMock) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	return nil
+}
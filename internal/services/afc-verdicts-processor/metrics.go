@@ -0,0 +1,27 @@
+package afcverdictsprocessor
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	metricsNamespace = "chat_service"
+	metricsSubsystem = "afc_verdicts"
+)
+
+type metrics struct {
+	invalidSignatures prometheus.Counter
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		invalidSignatures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "invalid_signatures_total",
+			Help:      "Number of verdicts rejected because they are not signed by the AFC.",
+		}),
+	}
+}
+
+func (m *metrics) register(reg prometheus.Registerer) error {
+	return reg.Register(m.invalidSignatures)
+}
@@ -0,0 +1,29 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"io"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/keepcalmist/chat-service/internal/logger"
+)
+
+type KafkaReader interface {
+	io.Closer
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+type KafkaReaderFactory func(brokers []string, groupID string, topic string) KafkaReader
+
+func NewKafkaReader(brokers []string, groupID string, topic string) KafkaReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		GroupID:     groupID,
+		Topic:       topic,
+		StartOffset: kafka.FirstOffset,
+		Logger:      logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger: logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	})
+}
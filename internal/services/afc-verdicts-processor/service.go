@@ -0,0 +1,261 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	clientmessageblockedjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=afcverdictsprocessormocks
+
+const (
+	serviceName = "afc-verdicts-processor"
+
+	headerLastError         = "LAST_ERROR"
+	headerOriginalPartition = "ORIGINAL_PARTITION"
+)
+
+type messagesRepository interface {
+	MarkAsVisibleForManager(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error
+	BlockMessage(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	retryInterval time.Duration `default:"1s" validate:"omitempty,min=10ms,max=1m"`
+
+	brokers       []string           `option:"mandatory" validate:"min=1"`
+	consumers     int                `option:"mandatory" validate:"min=1,max=16"`
+	consumerGroup string             `option:"mandatory" validate:"required"`
+	verdictsTopic string             `option:"mandatory" validate:"required"`
+	verdictsKey   string             `option:"mandatory" validate:"required"`
+	readerFactory KafkaReaderFactory `option:"mandatory" validate:"required"`
+	dlqWriter     KafkaDLQWriter     `option:"mandatory" validate:"required"`
+	txtor         transactor         `option:"mandatory" validate:"required"`
+	msgRepo       messagesRepository `option:"mandatory" validate:"required"`
+	outBox        outboxService      `option:"mandatory" validate:"required"`
+	registerer    prometheus.Registerer
+	logger        *zap.Logger
+}
+
+// Service consumes the AFC verdicts and applies them to the client messages.
+// The verdicts are JWTs signed by the AFC with RS256 and verified with verdictsKey,
+// a public key in PEM format.
+//
+// The offset is committed only after the verdict is applied or sent to the DLQ,
+// so every verdict is processed at least once. Verdicts are keyed by chat,
+// so the verdicts of the same chat come from one partition and are applied in order.
+type Service struct {
+	Options
+	decoder *verdictsDecoder
+	metrics *metrics
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(serviceName)
+	}
+
+	if opts.registerer == nil {
+		opts.registerer = prometheus.NewRegistry()
+	}
+
+	decoder, err := newVerdictsDecoder(opts.verdictsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	m := newMetrics()
+	if err := m.register(opts.registerer); err != nil {
+		return nil, fmt.Errorf("register metrics: %v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		decoder: decoder,
+		metrics: m,
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	defer func() {
+		if err := s.dlqWriter.Close(); err != nil {
+			s.logger.Error("close dlq writer", zap.Error(err))
+		}
+	}()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < s.consumers; i++ {
+		eg.Go(func() error {
+			r := s.readerFactory(s.brokers, s.consumerGroup, s.verdictsTopic)
+			defer func() {
+				if err := r.Close(); err != nil {
+					s.logger.Error("close kafka reader", zap.Error(err))
+				}
+			}()
+
+			return s.consume(ctx, r)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (s *Service) consume(ctx context.Context, r KafkaReader) error {
+	for {
+		msg, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("fetch message: %w", err)
+		}
+
+		if err := s.handleMessage(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("handle message: %w", err)
+		}
+
+		if err := r.CommitMessages(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("commit message: %w", err)
+		}
+	}
+}
+
+// handleMessage applies the verdict or moves the message to the DLQ if it can never be applied.
+// Transient errors are retried until the context is done.
+func (s *Service) handleMessage(ctx context.Context, msg kafka.Message) error {
+	v, err := s.decoder.decode(msg.Value)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSignature) {
+			s.metrics.invalidSignatures.Inc()
+			s.logger.Warn("verdict with invalid signature",
+				zap.Error(err), zap.Int("partition", msg.Partition), zap.Int64("offset", msg.Offset))
+		} else {
+			s.logger.Warn("invalid verdict",
+				zap.Error(err), zap.Int("partition", msg.Partition), zap.Int64("offset", msg.Offset))
+		}
+		return s.retry(ctx, func() error { return s.sendToDLQ(ctx, msg, err) })
+	}
+
+	return s.retry(ctx, func() error {
+		err := s.applyVerdict(ctx, v)
+		if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+			s.logger.Warn("verdict for unknown message",
+				zap.Stringer("chat_id", v.ChatID), zap.Stringer("message_id", v.MessageID),
+				zap.Int("partition", msg.Partition), zap.Int64("offset", msg.Offset))
+			return s.sendToDLQ(ctx, msg, err)
+		}
+		return err
+	})
+}
+
+// applyVerdict updates the message and puts the job notifying about it.
+// The redelivered verdict and the verdict conflicting with the applied one are ignored,
+// the first verdict of the message wins.
+func (s *Service) applyVerdict(ctx context.Context, v verdict) error {
+	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		var (
+			jobName string
+			payload string
+			err     error
+		)
+
+		switch v.Status {
+		case verdictStatusOK:
+			err = s.msgRepo.MarkAsVisibleForManager(ctx, v.ChatID, v.MessageID)
+			if err == nil {
+				jobName = clientmessagesentjob.Name
+				payload, err = clientmessagesentjob.MarshalPayload(v.MessageID)
+			}
+
+		case verdictStatusSuspicious:
+			err = s.msgRepo.BlockMessage(ctx, v.ChatID, v.MessageID)
+			if err == nil {
+				jobName = clientmessageblockedjob.Name
+				payload, err = clientmessageblockedjob.MarshalPayload(v.MessageID)
+			}
+		}
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgAlreadyChecked) {
+				s.logger.Info("verdict for already checked message",
+					zap.Stringer("chat_id", v.ChatID), zap.Stringer("message_id", v.MessageID),
+					zap.String("status", string(v.Status)))
+				return nil
+			}
+			return fmt.Errorf("apply %q verdict: %w", v.Status, err)
+		}
+
+		if _, err := s.outBox.Put(ctx, jobName, payload, time.Now()); err != nil {
+			return fmt.Errorf("put %q job: %w", jobName, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Service) sendToDLQ(ctx context.Context, msg kafka.Message, reason error) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers)+2)
+	headers = append(headers, msg.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: headerLastError, Value: []byte(reason.Error())},
+		kafka.Header{Key: headerOriginalPartition, Value: []byte(strconv.Itoa(msg.Partition))},
+	)
+
+	if err := s.dlqWriter.WriteMessages(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}); err != nil {
+		return fmt.Errorf("write message to dlq: %w", err)
+	}
+
+	return nil
+}
+
+// retry calls f until it succeeds or the context is done.
+func (s *Service) retry(ctx context.Context, f func() error) error {
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+
+		s.logger.Error("process verdict, retrying", zap.Error(err), zap.Duration("retry_in", s.retryInterval))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.retryInterval):
+		}
+	}
+}
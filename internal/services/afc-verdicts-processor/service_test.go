@@ -0,0 +1,455 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	afcverdictsprocessor "github.com/keepcalmist/chat-service/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/keepcalmist/chat-service/internal/services/afc-verdicts-processor/mocks"
+	clientmessageblockedjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl    *gomock.Controller
+	msgRepo *afcverdictsprocessormocks.MockmessagesRepository
+	outBox  *afcverdictsprocessormocks.MockoutboxService
+	txtor   *afcverdictsprocessormocks.Mocktransactor
+	dlq     *kafkaWriterMock
+	metrics *prometheus.Registry
+
+	signKey     *rsa.PrivateKey
+	verdictsKey string
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ContextSuite.SetupSuite()
+
+	s.signKey = s.generateKey()
+	s.verdictsKey = s.publicKeyPEM(s.signKey)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = afcverdictsprocessormocks.NewMockmessagesRepository(s.ctrl)
+	s.outBox = afcverdictsprocessormocks.NewMockoutboxService(s.ctrl)
+	s.txtor = afcverdictsprocessormocks.NewMocktransactor(s.ctrl)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+	s.dlq = new(kafkaWriterMock)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestNew_InvalidVerdictsKey() {
+	_, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		[]string{"localhost:9092"},
+		1,
+		"chat-service",
+		"afc.msg-verdicts",
+		"not a pem",
+		afcverdictsprocessor.NewKafkaReader,
+		s.dlq,
+		s.txtor,
+		s.msgRepo,
+		s.outBox,
+	))
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) TestVerdictOK() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).Return(nil)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, msgID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "ok"))
+
+	// Assert.
+	s.Len(reader.committed, 1)
+	s.True(reader.closed)
+	s.Empty(s.dlq.messages)
+	s.True(s.dlq.closed)
+}
+
+func (s *ServiceSuite) TestVerdictSuspicious() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), chatID, msgID).Return(nil)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, msgID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "suspicious"))
+
+	// Assert.
+	s.Len(reader.committed, 1)
+	s.Empty(s.dlq.messages)
+}
+
+func (s *ServiceSuite) TestVerdictsOfChatAppliedInOrder() {
+	// Arrange.
+	chatID := types.NewChatID()
+	msgIDs := []types.MessageID{types.NewMessageID(), types.NewMessageID(), types.NewMessageID()}
+
+	calls := make([]*gomock.Call, 0, len(msgIDs))
+	msgs := make([]kafka.Message, 0, len(msgIDs))
+	for _, msgID := range msgIDs {
+		calls = append(calls, s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).Return(nil))
+		msgs = append(msgs, s.newVerdictMessage(chatID, msgID, "ok"))
+	}
+	gomock.InOrder(calls...)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil).Times(len(msgIDs))
+
+	// Action.
+	reader := s.run(msgs...)
+
+	// Assert.
+	s.Require().Len(reader.committed, len(msgIDs))
+	for i, msg := range reader.committed {
+		s.Equal(int64(i), msg.Offset)
+	}
+}
+
+func (s *ServiceSuite) TestRepeatedVerdictIgnored() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+
+	gomock.InOrder(
+		s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).Return(nil),
+		s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).
+			Return(messagesrepo.ErrMsgAlreadyChecked),
+	)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, msgID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "ok"), s.newVerdictMessage(chatID, msgID, "ok"))
+
+	// Assert.
+	s.Len(reader.committed, 2)
+	s.Empty(s.dlq.messages)
+}
+
+func (s *ServiceSuite) TestConflictingVerdictIgnored() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+
+	gomock.InOrder(
+		s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).Return(nil),
+		s.msgRepo.EXPECT().BlockMessage(gomock.Any(), chatID, msgID).Return(messagesrepo.ErrMsgAlreadyChecked),
+	)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, msgID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "ok"), s.newVerdictMessage(chatID, msgID, "suspicious"))
+
+	// Assert.
+	s.Len(reader.committed, 2)
+	s.Empty(s.dlq.messages)
+}
+
+func (s *ServiceSuite) TestInvalidVerdictsSentToDLQ() {
+	cases := []struct {
+		name   string
+		value  string
+		forged bool
+	}{
+		{
+			name:  "not a jwt",
+			value: "{",
+		},
+		{
+			name: "unknown status",
+			value: s.sign(jwt.SigningMethodRS256, s.signKey, jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "whatever",
+			}),
+		},
+		{
+			name: "no message id",
+			value: s.sign(jwt.SigningMethodRS256, s.signKey, jwt.MapClaims{
+				"chatId": types.NewChatID(), "status": "ok",
+			}),
+		},
+		{
+			name: "invalid chat id",
+			value: s.sign(jwt.SigningMethodRS256, s.signKey, jwt.MapClaims{
+				"chatId": "42", "messageId": types.NewMessageID(), "status": "ok",
+			}),
+		},
+		{
+			name: "expired",
+			value: s.sign(jwt.SigningMethodRS256, s.signKey, jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "ok",
+				"exp": time.Now().Add(-time.Minute).Unix(),
+			}),
+		},
+		{
+			name: "signed by another key",
+			value: s.sign(jwt.SigningMethodRS256, s.generateKey(), jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "ok",
+			}),
+			forged: true,
+		},
+		{
+			name: "signed with hmac",
+			value: s.sign(jwt.SigningMethodHS256, []byte(s.verdictsKey), jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "ok",
+			}),
+			forged: true,
+		},
+		{
+			name: "unsigned",
+			value: s.sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "ok",
+			}),
+			forged: true,
+		},
+		{
+			name: "signed with unknown alg",
+			value: s.signWithUnknownAlg(jwt.MapClaims{
+				"chatId": types.NewChatID(), "messageId": types.NewMessageID(), "status": "ok",
+			}),
+			forged: true,
+		},
+	}
+
+	for _, tt := range cases {
+		s.Run(tt.name, func() {
+			// Arrange.
+			s.dlq = new(kafkaWriterMock)
+			msg := kafka.Message{
+				Partition: 3,
+				Key:       []byte(types.NewChatID().String()),
+				Value:     []byte(tt.value),
+				Headers:   []kafka.Header{{Key: "trace-id", Value: []byte("42")}},
+			}
+
+			// Action.
+			reader := s.run(msg)
+
+			// Assert.
+			s.Len(reader.committed, 1)
+			s.Require().Len(s.dlq.messages, 1)
+
+			dlqMsg := s.dlq.messages[0]
+			s.Equal(msg.Key, dlqMsg.Key)
+			s.Equal(msg.Value, dlqMsg.Value)
+			s.Equal(msg.Headers[0], dlqMsg.Headers[0])
+			s.NotEmpty(header(dlqMsg, "LAST_ERROR"))
+			s.Equal(strconv.Itoa(msg.Partition), header(dlqMsg, "ORIGINAL_PARTITION"))
+
+			if tt.forged {
+				s.Equal(1., s.invalidSignaturesTotal())
+			} else {
+				s.Equal(0., s.invalidSignaturesTotal())
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestUnknownMessageSentToDLQ() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).Return(messagesrepo.ErrMsgNotFound)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "ok"))
+
+	// Assert.
+	s.Len(reader.committed, 1)
+	s.Require().Len(s.dlq.messages, 1)
+	s.Contains(header(s.dlq.messages[0], "LAST_ERROR"), messagesrepo.ErrMsgNotFound.Error())
+}
+
+func (s *ServiceSuite) TestTransientErrorRetried() {
+	// Arrange.
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+
+	gomock.InOrder(
+		s.msgRepo.EXPECT().BlockMessage(gomock.Any(), chatID, msgID).Return(errors.New("connection reset")),
+		s.msgRepo.EXPECT().BlockMessage(gomock.Any(), chatID, msgID).Return(nil),
+	)
+	s.outBox.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, msgID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	reader := s.run(s.newVerdictMessage(chatID, msgID, "suspicious"))
+
+	// Assert.
+	s.Len(reader.committed, 1)
+	s.Empty(s.dlq.messages)
+}
+
+func (s *ServiceSuite) TestNotAppliedVerdictIsNotCommitted() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	chatID, msgID := types.NewChatID(), types.NewMessageID()
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), chatID, msgID).
+		DoAndReturn(func(_ context.Context, _ types.ChatID, _ types.MessageID) error {
+			cancel()
+			return errors.New("connection reset")
+		})
+
+	reader := newKafkaReaderMock(cancel, s.newVerdictMessage(chatID, msgID, "ok"))
+
+	// Action.
+	err := s.newService(reader).Run(ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Empty(reader.committed)
+}
+
+func (s *ServiceSuite) run(msgs ...kafka.Message) *kafkaReaderMock {
+	s.T().Helper()
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	reader := newKafkaReaderMock(cancel, msgs...)
+	svc := s.newService(reader)
+	err := svc.Run(ctx)
+	s.Require().NoError(err)
+
+	return reader
+}
+
+func (s *ServiceSuite) newService(reader afcverdictsprocessor.KafkaReader) *afcverdictsprocessor.Service {
+	s.T().Helper()
+
+	s.metrics = prometheus.NewRegistry()
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		[]string{"localhost:9092"},
+		1,
+		"chat-service",
+		"afc.msg-verdicts",
+		s.verdictsKey,
+		func(_ []string, _, _ string) afcverdictsprocessor.KafkaReader { return reader },
+		s.dlq,
+		s.txtor,
+		s.msgRepo,
+		s.outBox,
+		afcverdictsprocessor.WithRetryInterval(10*time.Millisecond),
+		afcverdictsprocessor.WithRegisterer(s.metrics),
+	))
+	s.Require().NoError(err)
+
+	return svc
+}
+
+func (s *ServiceSuite) newVerdictMessage(chatID types.ChatID, msgID types.MessageID, status string) kafka.Message {
+	s.T().Helper()
+
+	return kafka.Message{
+		Key: []byte(chatID.String()),
+		Value: []byte(s.sign(jwt.SigningMethodRS256, s.signKey, jwt.MapClaims{
+			"chatId":    chatID,
+			"messageId": msgID,
+			"status":    status,
+		})),
+	}
+}
+
+func (s *ServiceSuite) sign(method jwt.SigningMethod, key any, claims jwt.MapClaims) string {
+	s.T().Helper()
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	s.Require().NoError(err)
+	return token
+}
+
+// signWithUnknownAlg makes the token the JWT library has no signing method for.
+func (s *ServiceSuite) signWithUnknownAlg(claims jwt.MapClaims) string {
+	s.T().Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS1024", "typ": "JWT"})
+	s.Require().NoError(err)
+	payload, err := json.Marshal(claims)
+	s.Require().NoError(err)
+
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("signature"))
+}
+
+func (s *ServiceSuite) invalidSignaturesTotal() float64 {
+	s.T().Helper()
+
+	families, err := s.metrics.Gather()
+	s.Require().NoError(err)
+
+	for _, f := range families {
+		if f.GetName() == "chat_service_afc_verdicts_invalid_signatures_total" {
+			return f.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	s.Fail("no invalid signatures metric")
+	return 0
+}
+
+func (s *ServiceSuite) generateKey() *rsa.PrivateKey {
+	s.T().Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	return key
+}
+
+func (s *ServiceSuite) publicKeyPEM(key *rsa.PrivateKey) string {
+	s.T().Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	s.Require().NoError(err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
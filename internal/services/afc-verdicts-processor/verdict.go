@@ -0,0 +1,71 @@
+package afcverdictsprocessor
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/internal/validator"
+)
+
+var ErrInvalidSignature = errors.New("invalid verdict signature")
+
+type verdictStatus string
+
+const (
+	verdictStatusOK         verdictStatus = "ok"
+	verdictStatusSuspicious verdictStatus = "suspicious"
+)
+
+// verdict is the claims of the JWT the AFC signs the verdict with.
+type verdict struct {
+	jwt.RegisteredClaims
+	ChatID    types.ChatID    `json:"chatId" validate:"required"`
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+	Status    verdictStatus   `json:"status" validate:"required,oneof=ok suspicious"`
+}
+
+func (v verdict) Valid() error {
+	if err := v.RegisteredClaims.Valid(); err != nil {
+		return err
+	}
+	return validator.Validator.Struct(v)
+}
+
+// verdictsDecoder decodes the RS256-signed verdicts.
+type verdictsDecoder struct {
+	parser    *jwt.Parser
+	publicKey *rsa.PublicKey
+}
+
+func newVerdictsDecoder(publicKeyPEM string) (*verdictsDecoder, error) {
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(publicKeyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("parse verdicts sign key: %v", err)
+	}
+
+	return &verdictsDecoder{
+		parser:    jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()})),
+		publicKey: publicKey,
+	}, nil
+}
+
+// decode returns ErrInvalidSignature if the verdict is not signed by the AFC.
+func (d *verdictsDecoder) decode(data []byte) (verdict, error) {
+	var v verdict
+	_, err := d.parser.ParseWithClaims(string(data), &v, func(*jwt.Token) (any, error) {
+		return d.publicKey, nil
+	})
+	if err != nil {
+		// The token with the unknown signing alg can't be verified at all, it is forged as well.
+		if errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenUnverifiable) {
+			return verdict{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		return verdict{}, fmt.Errorf("parse verdict: %v", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,163 @@
+package eventstream
+
+import (
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/internal/validator"
+)
+
+type Event interface {
+	eventMarker()
+	Validate() error
+}
+
+type event struct{}
+
+func (*event) eventMarker() {}
+
+// NewMessageEvent is a signal about the appearance of a new message in the chat.
+// The manager gets it only for the client messages that passed AFC.
+type NewMessageEvent struct {
+	event
+	EventID     types.EventID   `validate:"required"`
+	RequestID   types.RequestID `validate:"required"`
+	ChatID      types.ChatID    `validate:"required"`
+	MessageID   types.MessageID `validate:"required"`
+	AuthorID    types.UserID    `validate:"required_if=IsService false,excluded_if=IsService true"`
+	CreatedAt   time.Time       `validate:"required"`
+	MessageBody string          `validate:"required"`
+	IsService   bool
+}
+
+func NewNewMessageEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+	authorID types.UserID,
+	createdAt time.Time,
+	body string,
+	isService bool,
+) *NewMessageEvent {
+	return &NewMessageEvent{
+		EventID:     eventID,
+		RequestID:   requestID,
+		ChatID:      chatID,
+		MessageID:   messageID,
+		AuthorID:    authorID,
+		CreatedAt:   createdAt,
+		MessageBody: body,
+		IsService:   isService,
+	}
+}
+
+func (e NewMessageEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// MessageSentEvent indicates that the message was checked by AFC
+// and was sent to the manager. Two gray ticks.
+type MessageSentEvent struct {
+	event
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+}
+
+func NewMessageSentEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	messageID types.MessageID,
+) *MessageSentEvent {
+	return &MessageSentEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		MessageID: messageID,
+	}
+}
+
+func (e MessageSentEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// MessageBlockedEvent indicates that the message was blocked by AFC.
+type MessageBlockedEvent struct {
+	event
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+}
+
+func NewMessageBlockedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	messageID types.MessageID,
+) *MessageBlockedEvent {
+	return &MessageBlockedEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		MessageID: messageID,
+	}
+}
+
+func (e MessageBlockedEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// NewChatEvent is a signal about the problem in the chat assigned to the manager.
+type NewChatEvent struct {
+	event
+	EventID             types.EventID   `validate:"required"`
+	RequestID           types.RequestID `validate:"required"`
+	ChatID              types.ChatID    `validate:"required"`
+	ClientID            types.UserID    `validate:"required"`
+	CanTakeMoreProblems bool
+}
+
+func NewNewChatEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	clientID types.UserID,
+	canTakeMoreProblems bool,
+) *NewChatEvent {
+	return &NewChatEvent{
+		EventID:             eventID,
+		RequestID:           requestID,
+		ChatID:              chatID,
+		ClientID:            clientID,
+		CanTakeMoreProblems: canTakeMoreProblems,
+	}
+}
+
+func (e NewChatEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// ChatClosedEvent is a signal about the problem in the chat resolved by the manager.
+type ChatClosedEvent struct {
+	event
+	EventID             types.EventID   `validate:"required"`
+	RequestID           types.RequestID `validate:"required"`
+	ChatID              types.ChatID    `validate:"required"`
+	CanTakeMoreProblems bool
+}
+
+func NewChatClosedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	canTakeMoreProblems bool,
+) *ChatClosedEvent {
+	return &ChatClosedEvent{
+		EventID:             eventID,
+		RequestID:           requestID,
+		ChatID:              chatID,
+		CanTakeMoreProblems: canTakeMoreProblems,
+	}
+}
+
+func (e ChatClosedEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
@@ -0,0 +1,78 @@
+package eventstream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestNewMessageEvent_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		ev      eventstream.Event
+		wantErr bool
+	}{
+		{
+			name: "valid client message",
+			ev: eventstream.NewNewMessageEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.NewUserID(), time.Now(), "Hello!", false,
+			),
+		},
+		{
+			name: "valid service message",
+			ev: eventstream.NewNewMessageEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.UserIDNil, time.Now(), "Manager will answer you", true,
+			),
+		},
+		{
+			name: "no author in client message",
+			ev: eventstream.NewNewMessageEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.UserIDNil, time.Now(), "Hello!", false,
+			),
+			wantErr: true,
+		},
+		{
+			name: "author in service message",
+			ev: eventstream.NewNewMessageEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.NewUserID(), time.Now(), "Manager will answer you", true,
+			),
+			wantErr: true,
+		},
+		{
+			name: "no event id",
+			ev: eventstream.NewNewMessageEvent(
+				types.EventIDNil, types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.NewUserID(), time.Now(), "Hello!", false,
+			),
+			wantErr: true,
+		},
+		{
+			name: "empty body",
+			ev: eventstream.NewNewMessageEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID(),
+				types.NewUserID(), time.Now(), "", false,
+			),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ev.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
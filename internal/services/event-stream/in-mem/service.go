@@ -0,0 +1,127 @@
+package inmemeventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const (
+	serviceName          = "event-stream"
+	subscriberBufferSize = 100
+)
+
+var ErrStreamClosed = errors.New("event stream is closed")
+
+type Service struct {
+	logger *zap.Logger
+	mu     *sync.RWMutex
+	subs   map[types.UserID]map[chan eventstream.Event]struct{}
+	closed bool
+}
+
+func New() *Service {
+	return &Service{
+		logger: zap.L().Named(serviceName),
+		mu:     new(sync.RWMutex),
+		subs:   make(map[types.UserID]map[chan eventstream.Event]struct{}),
+	}
+}
+
+// Subscribe returns the channel of the user events.
+// The channel is closed when ctx is done or the stream is closed.
+func (s *Service) Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error) {
+	ch := make(chan eventstream.Event, subscriberBufferSize)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrStreamClosed
+	}
+
+	if _, ok := s.subs[userID]; !ok {
+		s.subs[userID] = make(map[chan eventstream.Event]struct{})
+	}
+	s.subs[userID][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		s.unsubscribe(userID, ch)
+	}()
+
+	return ch, nil
+}
+
+func (s *Service) unsubscribe(userID types.UserID, ch chan eventstream.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userSubs, ok := s.subs[userID]
+	if !ok {
+		return
+	}
+	if _, ok := userSubs[ch]; !ok {
+		return
+	}
+
+	delete(userSubs, ch)
+	close(ch)
+
+	if len(userSubs) == 0 {
+		delete(s.subs, userID)
+	}
+}
+
+// Publish sends the event to all subscriptions of the user.
+// Slow subscribers with the full buffer miss the event.
+func (s *Service) Publish(_ context.Context, userID types.UserID, event eventstream.Event) error {
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("validate event: %v", err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return ErrStreamClosed
+	}
+
+	for ch := range s.subs[userID] {
+		select {
+		case ch <- event:
+		default:
+			s.logger.Warn("subscriber buffer is full, event dropped",
+				zap.Stringer("user_id", userID),
+				zap.String("event_type", fmt.Sprintf("%T", event)),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	for userID, userSubs := range s.subs {
+		for ch := range userSubs {
+			close(ch)
+		}
+		delete(s.subs, userID)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package inmemeventstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	inmemeventstream "github.com/keepcalmist/chat-service/internal/services/event-stream/in-mem"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const receiveTimeout = time.Second
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+	stream *inmemeventstream.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+	s.stream = inmemeventstream.New()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.NoError(s.stream.Close())
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestEveryUserSubscriptionReceivesEvent() {
+	// Arrange.
+	userID := types.NewUserID()
+
+	events1, err := s.stream.Subscribe(s.Ctx, userID)
+	s.Require().NoError(err)
+
+	events2, err := s.stream.Subscribe(s.Ctx, userID)
+	s.Require().NoError(err)
+
+	otherEvents, err := s.stream.Subscribe(s.Ctx, types.NewUserID())
+	s.Require().NoError(err)
+
+	event := newMessageSentEvent()
+
+	// Action.
+	err = s.stream.Publish(s.Ctx, userID, event)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(event, s.receive(events1))
+	s.Equal(event, s.receive(events2))
+	s.Empty(otherEvents)
+}
+
+func (s *ServiceSuite) TestPublishWithoutSubscribers() {
+	err := s.stream.Publish(s.Ctx, types.NewUserID(), newMessageSentEvent())
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestPublishInvalidEvent() {
+	err := s.stream.Publish(s.Ctx, types.NewUserID(), &eventstream.MessageSentEvent{})
+	s.Error(err)
+}
+
+func (s *ServiceSuite) TestChannelClosedOnContextCancel() {
+	// Arrange.
+	userID := types.NewUserID()
+	ctx, cancel := context.WithCancel(s.Ctx)
+
+	events, err := s.stream.Subscribe(ctx, userID)
+	s.Require().NoError(err)
+
+	// Action.
+	cancel()
+
+	// Assert.
+	s.assertClosed(events)
+	s.NoError(s.stream.Publish(s.Ctx, userID, newMessageSentEvent()))
+}
+
+func (s *ServiceSuite) TestChannelsClosedOnStreamClose() {
+	// Arrange.
+	userID := types.NewUserID()
+
+	events, err := s.stream.Subscribe(s.Ctx, userID)
+	s.Require().NoError(err)
+
+	// Action.
+	s.Require().NoError(s.stream.Close())
+
+	// Assert.
+	s.assertClosed(events)
+
+	_, err = s.stream.Subscribe(s.Ctx, userID)
+	s.ErrorIs(err, inmemeventstream.ErrStreamClosed)
+
+	err = s.stream.Publish(s.Ctx, userID, newMessageSentEvent())
+	s.ErrorIs(err, inmemeventstream.ErrStreamClosed)
+}
+
+func (s *ServiceSuite) TestSlowSubscriberDoesNotBlockPublish() {
+	// Arrange.
+	userID := types.NewUserID()
+
+	_, err := s.stream.Subscribe(s.Ctx, userID)
+	s.Require().NoError(err)
+
+	// Action & assert.
+	for i := 0; i < 1000; i++ {
+		s.Require().NoError(s.stream.Publish(s.Ctx, userID, newMessageSentEvent()))
+	}
+}
+
+func (s *ServiceSuite) receive(events <-chan eventstream.Event) eventstream.Event {
+	s.T().Helper()
+
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(receiveTimeout):
+		s.FailNow("no event received")
+	}
+	return nil
+}
+
+func (s *ServiceSuite) assertClosed(events <-chan eventstream.Event) {
+	s.T().Helper()
+
+	select {
+	case _, ok := <-events:
+		s.False(ok)
+	case <-time.After(receiveTimeout):
+		s.Fail("events channel was not closed")
+	}
+}
+
+func newMessageSentEvent() eventstream.Event {
+	return eventstream.NewMessageSentEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID())
+}
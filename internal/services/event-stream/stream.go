@@ -0,0 +1,15 @@
+package eventstream
+
+import (
+	"context"
+	"io"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// EventStream delivers events to every active subscription of the user.
+type EventStream interface {
+	io.Closer
+	Subscribe(ctx context.Context, userID types.UserID) (<-chan Event, error)
+	Publish(ctx context.Context, userID types.UserID, event Event) error
+}
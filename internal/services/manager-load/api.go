@@ -2,16 +2,37 @@ package managerload
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
 func (s *Service) CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error) {
-	count, err := s.problemsRepo.GetManagerOpenProblemsCount(ctx, managerID)
+	load, capacity, err := s.GetManagerLoad(ctx, managerID)
 	if err != nil {
-		return false, fmt.Errorf("failed to get counf of manager's open problems: %w", err)
+		return false, err
 	}
 
-	return s.maxProblemsAtTime > count, nil
+	return capacity > load, nil
+}
+
+// GetManagerLoad returns the number of the manager's open problems and
+// the number of the problems the manager can handle at the same time.
+func (s *Service) GetManagerLoad(ctx context.Context, managerID types.UserID) (load, capacity int, err error) {
+	load, err = s.problemsRepo.GetManagerOpenProblemsCount(ctx, managerID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get counf of manager's open problems: %w", err)
+	}
+
+	capacity, err = s.capacitiesRepo.GetManagerCapacity(ctx, managerID)
+	if err != nil {
+		if !errors.Is(err, capacitiesrepo.ErrCapacityNotFound) {
+			return 0, 0, fmt.Errorf("failed to get manager's capacity: %w", err)
+		}
+		capacity = s.maxProblemsAtTime
+	}
+
+	return load, capacity, nil
 }
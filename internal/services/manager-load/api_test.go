@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	capacitiesrepo "github.com/keepcalmist/chat-service/internal/repositories/capacities"
 	managerload "github.com/keepcalmist/chat-service/internal/services/manager-load"
 	managerloadmocks "github.com/keepcalmist/chat-service/internal/services/manager-load/mocks"
 	"github.com/keepcalmist/chat-service/internal/testingh"
@@ -18,7 +19,8 @@ type ServiceSuite struct {
 
 	ctrl *gomock.Controller
 
-	problemsRepo *managerloadmocks.MockproblemsRepository
+	problemsRepo   *managerloadmocks.MockproblemsRepository
+	capacitiesRepo *managerloadmocks.MockcapacitiesRepository
 }
 
 func TestServiceSuite(t *testing.T) {
@@ -29,6 +31,7 @@ func TestServiceSuite(t *testing.T) {
 func (s *ServiceSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.problemsRepo = managerloadmocks.NewMockproblemsRepository(s.ctrl)
+	s.capacitiesRepo = managerloadmocks.NewMockcapacitiesRepository(s.ctrl)
 	s.ContextSuite.SetupTest()
 }
 
@@ -57,6 +60,12 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_Validation() {
 			repo:              s.problemsRepo,
 			isError:           true,
 		},
+		{
+			name:              "maxProblemsAtTime is incorrect #3",
+			maxProblemsAtTime: managerload.MaxCapacity + 1,
+			repo:              s.problemsRepo,
+			isError:           true,
+		},
 		{
 			name:              "repository is nil",
 			maxProblemsAtTime: 20,
@@ -69,11 +78,17 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_Validation() {
 			repo:              s.problemsRepo,
 			isError:           false,
 		},
+		{
+			name:              "max capacity",
+			maxProblemsAtTime: managerload.MaxCapacity,
+			repo:              s.problemsRepo,
+			isError:           false,
+		},
 	}
 
 	for _, c := range tCase {
 		s.Run(c.name, func() {
-			managerLoad, err := managerload.New(managerload.NewOptions(c.maxProblemsAtTime, c.repo))
+			managerLoad, err := managerload.New(managerload.NewOptions(c.maxProblemsAtTime, c.repo, s.capacitiesRepo))
 			if c.isError {
 				s.Require().Error(err)
 			} else {
@@ -85,12 +100,14 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_Validation() {
 }
 
 func (s *ServiceSuite) TestCanManagerTakeProblem_Successful() {
-	managerLoadService, err := managerload.New(managerload.NewOptions(20, s.problemsRepo))
+	managerLoadService, err := managerload.New(managerload.NewOptions(20, s.problemsRepo, s.capacitiesRepo))
 	s.Require().NoError(err)
 	managerID := types.NewUserID()
 
 	s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).
 		Return(10, nil)
+	s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).
+		Return(0, capacitiesrepo.ErrCapacityNotFound)
 
 	ok, err := managerLoadService.CanManagerTakeProblem(s.Ctx, managerID)
 	s.Require().NoError(err)
@@ -98,12 +115,14 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_Successful() {
 }
 
 func (s *ServiceSuite) TestCanManagerTakeProblem_ManagerIsBusy() {
-	managerLoadService, err := managerload.New(managerload.NewOptions(5, s.problemsRepo))
+	managerLoadService, err := managerload.New(managerload.NewOptions(5, s.problemsRepo, s.capacitiesRepo))
 	s.Require().NoError(err)
 	managerID := types.NewUserID()
 
 	s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).
 		Return(10, nil)
+	s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).
+		Return(0, capacitiesrepo.ErrCapacityNotFound)
 
 	ok, err := managerLoadService.CanManagerTakeProblem(s.Ctx, managerID)
 	s.Require().NoError(err)
@@ -112,12 +131,14 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_ManagerIsBusy() {
 
 func (s *ServiceSuite) TestCanManagerTakeProblem_BorderCases() {
 	maxProblems := 10
-	managerLoadService, err := managerload.New(managerload.NewOptions(maxProblems, s.problemsRepo))
+	managerLoadService, err := managerload.New(managerload.NewOptions(maxProblems, s.problemsRepo, s.capacitiesRepo))
 	s.Require().NoError(err)
 	managerID := types.NewUserID()
 
 	s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).
 		Return(maxProblems, nil)
+	s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).
+		Return(0, capacitiesrepo.ErrCapacityNotFound)
 
 	ok, err := managerLoadService.CanManagerTakeProblem(s.Ctx, managerID)
 	s.Require().NoError(err)
@@ -126,7 +147,7 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_BorderCases() {
 
 func (s *ServiceSuite) TestCanManagerTakeProblem_ErrorFromRepo() {
 	maxProblems := 10
-	managerLoadService, err := managerload.New(managerload.NewOptions(maxProblems, s.problemsRepo))
+	managerLoadService, err := managerload.New(managerload.NewOptions(maxProblems, s.problemsRepo, s.capacitiesRepo))
 	s.Require().NoError(err)
 
 	managerID := types.NewUserID()
@@ -139,3 +160,61 @@ func (s *ServiceSuite) TestCanManagerTakeProblem_ErrorFromRepo() {
 	s.Require().ErrorIs(err, errFromRepo)
 	s.Require().False(ok)
 }
+
+func (s *ServiceSuite) TestCanManagerTakeProblem_PersonalCapacity() {
+	managerLoadService, err := managerload.New(managerload.NewOptions(5, s.problemsRepo, s.capacitiesRepo))
+	s.Require().NoError(err)
+
+	senior, trainee := types.NewUserID(), types.NewUserID()
+
+	s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), senior).Return(7, nil)
+	s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), senior).Return(10, nil)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), trainee).Return(2, nil)
+	s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), trainee).Return(2, nil)
+
+	ok, err := managerLoadService.CanManagerTakeProblem(s.Ctx, senior)
+	s.Require().NoError(err)
+	s.True(ok)
+
+	ok, err = managerLoadService.CanManagerTakeProblem(s.Ctx, trainee)
+	s.Require().NoError(err)
+	s.False(ok)
+}
+
+func (s *ServiceSuite) TestGetManagerLoad() {
+	managerLoadService, err := managerload.New(managerload.NewOptions(5, s.problemsRepo, s.capacitiesRepo))
+	s.Require().NoError(err)
+
+	s.Run("default capacity", func() {
+		managerID := types.NewUserID()
+		s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).Return(3, nil)
+		s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).
+			Return(0, capacitiesrepo.ErrCapacityNotFound)
+
+		load, capacity, err := managerLoadService.GetManagerLoad(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(3, load)
+		s.Equal(5, capacity)
+	})
+
+	s.Run("personal capacity", func() {
+		managerID := types.NewUserID()
+		s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).Return(3, nil)
+		s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).Return(12, nil)
+
+		load, capacity, err := managerLoadService.GetManagerLoad(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(3, load)
+		s.Equal(12, capacity)
+	})
+
+	s.Run("capacities repo error", func() {
+		managerID := types.NewUserID()
+		errFromRepo := errors.New("unknown error")
+		s.problemsRepo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).Return(3, nil)
+		s.capacitiesRepo.EXPECT().GetManagerCapacity(gomock.Any(), managerID).Return(0, errFromRepo)
+
+		_, _, err := managerLoadService.GetManagerLoad(s.Ctx, managerID)
+		s.Require().ErrorIs(err, errFromRepo)
+	})
+}
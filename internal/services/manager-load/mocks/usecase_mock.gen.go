@@ -49,3 +49,41 @@ func (mr *MockproblemsRepositoryMockRecorder) GetManagerOpenProblemsCount(ctx, m
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerOpenProblemsCount", reflect.TypeOf((*MockproblemsRepository)(nil).GetManagerOpenProblemsCount), ctx, managerID)
 }
+
+// MockcapacitiesRepository is a mock of capacitiesRepository interface.
+type MockcapacitiesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcapacitiesRepositoryMockRecorder
+}
+
+// MockcapacitiesRepositoryMockRecorder is the mock recorder for MockcapacitiesRepository.
+type MockcapacitiesRepositoryMockRecorder struct {
+	mock *MockcapacitiesRepository
+}
+
+// NewMockcapacitiesRepository creates a new mock instance.
+func NewMockcapacitiesRepository(ctrl *gomock.Controller) *MockcapacitiesRepository {
+	mock := &MockcapacitiesRepository{ctrl: ctrl}
+	mock.recorder = &MockcapacitiesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcapacitiesRepository) EXPECT() *MockcapacitiesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagerCapacity mocks base method.
+func (m *MockcapacitiesRepository) GetManagerCapacity(ctx context.Context, managerID types.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerCapacity", ctx, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerCapacity indicates an expected call of GetManagerCapacity.
+func (mr *MockcapacitiesRepositoryMockRecorder) GetManagerCapacity(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerCapacity", reflect.TypeOf((*MockcapacitiesRepository)(nil).GetManagerCapacity), ctx, managerID)
+}
@@ -2,21 +2,34 @@ package managerload
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
+// MaxCapacity is the upper bound of both the default and the personal capacities of the managers.
+const MaxCapacity = 30
+
+var ErrInvalidCapacity = errors.New("invalid capacity")
+
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=managerloadmocks
 
 type problemsRepository interface {
 	GetManagerOpenProblemsCount(ctx context.Context, managerID types.UserID) (int, error)
 }
 
+type capacitiesRepository interface {
+	GetManagerCapacity(ctx context.Context, managerID types.UserID) (int, error)
+}
+
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
-	maxProblemsAtTime int `option:"mandatory" validate:"required,min=1,max=30"`
+	// maxProblemsAtTime is the default capacity of the managers without the personal one.
+	maxProblemsAtTime int `option:"mandatory" validate:"required"`
 
-	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	problemsRepo   problemsRepository   `option:"mandatory" validate:"required"`
+	capacitiesRepo capacitiesRepository `option:"mandatory" validate:"required"`
 }
 
 type Service struct {
@@ -27,6 +40,17 @@ func New(opts Options) (*Service, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
+	if err := ValidateCapacity(opts.maxProblemsAtTime); err != nil {
+		return nil, fmt.Errorf("validate maxProblemsAtTime: %w", err)
+	}
 
 	return &Service{Options: opts}, nil
 }
+
+// ValidateCapacity returns ErrInvalidCapacity if the capacity is not in [1, MaxCapacity].
+func ValidateCapacity(capacity int) error {
+	if capacity < 1 || capacity > MaxCapacity {
+		return fmt.Errorf("%w: %d is not in [1, %d]", ErrInvalidCapacity, capacity, MaxCapacity)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	maxProblemsAtTime int,
 	problemsRepo problemsRepository,
+	capacitiesRepo capacitiesRepository,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -21,6 +22,7 @@ func NewOptions(
 
 	o.maxProblemsAtTime = maxProblemsAtTime
 	o.problemsRepo = problemsRepo
+	o.capacitiesRepo = capacitiesRepo
 
 	for _, opt := range options {
 		opt(&o)
@@ -32,11 +34,12 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("maxProblemsAtTime", _validate_Options_maxProblemsAtTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("capacitiesRepo", _validate_Options_capacitiesRepo(o)))
 	return errs.AsError()
 }
 
 func _validate_Options_maxProblemsAtTime(o *Options) error {
-	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxProblemsAtTime, "required,min=1,max=30"); err != nil {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxProblemsAtTime, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `maxProblemsAtTime` did not pass the test: %w", err)
 	}
 	return nil
@@ -48,3 +51,10 @@ func _validate_Options_problemsRepo(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_capacitiesRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.capacitiesRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `capacitiesRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package inmemmanagerpool
 
 import (
+	"container/list"
 	"context"
 	"sync"
 
@@ -10,42 +11,40 @@ import (
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
-const (
-	serviceName = "manager-pool"
-	managersMax = 1000
-)
+const serviceName = "manager-pool"
 
 type Service struct {
 	logger   *zap.Logger
-	ch       chan types.UserID
-	contains map[types.UserID]struct{}
+	queue    *list.List // Of types.UserID.
+	contains map[types.UserID]*list.Element
 	mu       *sync.Mutex
 }
 
 func New() *Service {
 	return &Service{
 		logger:   zap.L().Named(serviceName),
-		ch:       make(chan types.UserID, managersMax),
-		contains: make(map[types.UserID]struct{}, managersMax),
+		queue:    list.New(),
+		contains: make(map[types.UserID]*list.Element),
 		mu:       new(sync.Mutex),
 	}
 }
 
 func (s *Service) Close() error {
-	close(s.ch)
 	return nil
 }
 
 func (s *Service) Get(_ context.Context) (types.UserID, error) {
-	select {
-	case managerID := <-s.ch:
-		s.mu.Lock()
-		delete(s.contains, managerID)
-		s.mu.Unlock()
-		return managerID, nil
-	default:
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	front := s.queue.Front()
+	if front == nil {
 		return types.UserIDNil, managerpool.ErrNoAvailableManagers
 	}
+
+	managerID := s.queue.Remove(front).(types.UserID)
+	delete(s.contains, managerID)
+	return managerID, nil
 }
 
 func (s *Service) Put(_ context.Context, managerID types.UserID) error {
@@ -56,8 +55,45 @@ func (s *Service) Put(_ context.Context, managerID types.UserID) error {
 		return nil
 	}
 
-	s.contains[managerID] = struct{}{}
-	s.ch <- managerID
+	s.contains[managerID] = s.queue.PushBack(managerID)
+	return nil
+}
+
+func (s *Service) Managers(_ context.Context) ([]types.UserID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	managers := make([]types.UserID, 0, s.queue.Len())
+	for e := s.queue.Front(); e != nil; e = e.Next() {
+		managers = append(managers, e.Value.(types.UserID))
+	}
+
+	return managers, nil
+}
+
+func (s *Service) Take(_ context.Context, managerID types.UserID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.contains[managerID]
+	if !ok {
+		return managerpool.ErrManagerNotInPool
+	}
+
+	s.queue.Remove(e)
+	delete(s.contains, managerID)
+
+	return nil
+}
+
+func (s *Service) Remove(_ context.Context, managerID types.UserID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.contains[managerID]; ok {
+		s.queue.Remove(e)
+		delete(s.contains, managerID)
+	}
 	return nil
 }
 
@@ -8,13 +8,23 @@ import (
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
-var ErrNoAvailableManagers = errors.New("no available managers")
+var (
+	ErrNoAvailableManagers = errors.New("no available managers")
+	ErrManagerNotInPool    = errors.New("manager is not in the pool")
+)
 
 // Pool represents concurrent-safe FIFO queue.
 type Pool interface {
 	io.Closer
 	Get(ctx context.Context) (types.UserID, error)
 	Put(ctx context.Context, managerID types.UserID) error
+	// Managers returns the managers in the queue order, the longest waiting first.
+	Managers(ctx context.Context) ([]types.UserID, error)
+	// Take takes the certain manager out of the queue.
+	// Returns ErrManagerNotInPool if the manager has already been taken or left.
+	Take(ctx context.Context, managerID types.UserID) error
+	// Remove takes the manager out of the queue. It is no-op if the manager is not in the pool.
+	Remove(ctx context.Context, managerID types.UserID) error
 	Contains(ctx context.Context, managerID types.UserID) (bool, error)
 	Size() int
 }
@@ -0,0 +1,281 @@
+// Package managerpooltest contains the behaviour every managerpool.Pool implementation must have.
+package managerpooltest
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// Suite is embedded into the test suite of the implementation, that sets NewPool up.
+// The pool is created empty before each test and closed after it.
+type Suite struct {
+	testingh.ContextSuite
+	NewPool func() managerpool.Pool
+
+	pool managerpool.Pool
+}
+
+func (s *Suite) SetupTest() {
+	s.ContextSuite.SetupTest()
+	s.Require().NotNil(s.NewPool)
+	s.pool = s.NewPool()
+}
+
+func (s *Suite) TearDownTest() {
+	s.NoError(s.pool.Close())
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *Suite) TestEmpty() {
+	s.Equal(0, s.pool.Size())
+
+	_, err := s.pool.Get(s.Ctx)
+	s.ErrorIs(err, managerpool.ErrNoAvailableManagers)
+
+	contains, err := s.pool.Contains(s.Ctx, types.NewUserID())
+	s.Require().NoError(err)
+	s.False(contains)
+
+	managers, err := s.pool.Managers(s.Ctx)
+	s.Require().NoError(err)
+	s.Empty(managers)
+}
+
+func (s *Suite) TestFIFOLogic() {
+	const managersNum = 10
+	managers := make([]types.UserID, 0, managersNum)
+
+	for i := 0; i < managersNum; i++ {
+		m := types.NewUserID()
+		managers = append(managers, m)
+
+		s.T().Logf("%d: put %s", i, m)
+		err := s.pool.Put(s.Ctx, m)
+		s.Require().NoError(err)
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.True(contains)
+	}
+	s.Len(managers, managersNum)
+	s.Equal(managersNum, s.pool.Size())
+
+	for i, m := range managers {
+		mm, err := s.pool.Get(s.Ctx)
+		s.Require().NoError(err)
+
+		s.T().Logf("%d: got %s", i, m)
+		s.Equal(m.String(), mm.String())
+		s.Equal(len(managers)-i-1, s.pool.Size())
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.False(contains)
+	}
+}
+
+func (s *Suite) TestPut_Idempotency() {
+	m := types.NewUserID()
+	for i := 0; i < 3; i++ {
+		err := s.pool.Put(s.Ctx, m)
+		s.Require().NoError(err)
+		s.Equal(1, s.pool.Size())
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.True(contains)
+	}
+
+	mm, err := s.pool.Get(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(m.String(), mm.String())
+	s.Equal(0, s.pool.Size())
+
+	contains, err := s.pool.Contains(s.Ctx, m)
+	s.Require().NoError(err)
+	s.False(contains)
+}
+
+func (s *Suite) TestRemove() {
+	managers := []types.UserID{types.NewUserID(), types.NewUserID(), types.NewUserID()}
+	for _, m := range managers {
+		s.Require().NoError(s.pool.Put(s.Ctx, m))
+	}
+
+	s.Require().NoError(s.pool.Remove(s.Ctx, managers[1]))
+	s.Equal(2, s.pool.Size())
+
+	contains, err := s.pool.Contains(s.Ctx, managers[1])
+	s.Require().NoError(err)
+	s.False(contains)
+
+	s.Run("remove is idempotent", func() {
+		s.Require().NoError(s.pool.Remove(s.Ctx, managers[1]))
+		s.Require().NoError(s.pool.Remove(s.Ctx, types.NewUserID()))
+		s.Equal(2, s.pool.Size())
+	})
+
+	s.Run("the rest keep the order", func() {
+		for _, m := range []types.UserID{managers[0], managers[2]} {
+			mm, err := s.pool.Get(s.Ctx)
+			s.Require().NoError(err)
+			s.Equal(m.String(), mm.String())
+		}
+
+		_, err := s.pool.Get(s.Ctx)
+		s.ErrorIs(err, managerpool.ErrNoAvailableManagers)
+	})
+
+	s.Run("the manager can come back to the end of the queue", func() {
+		s.Require().NoError(s.pool.Put(s.Ctx, managers[1]))
+		s.Require().NoError(s.pool.Put(s.Ctx, managers[0]))
+
+		mm, err := s.pool.Get(s.Ctx)
+		s.Require().NoError(err)
+		s.Equal(managers[1].String(), mm.String())
+	})
+}
+
+func (s *Suite) TestManagers() {
+	managers := []types.UserID{types.NewUserID(), types.NewUserID(), types.NewUserID()}
+	for _, m := range managers {
+		s.Require().NoError(s.pool.Put(s.Ctx, m))
+	}
+
+	listed, err := s.pool.Managers(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(managers, listed)
+	s.Equal(len(managers), s.pool.Size(), "listing doesn't take the managers")
+}
+
+func (s *Suite) TestTake() {
+	managers := []types.UserID{types.NewUserID(), types.NewUserID(), types.NewUserID()}
+	for _, m := range managers {
+		s.Require().NoError(s.pool.Put(s.Ctx, m))
+	}
+
+	s.Require().NoError(s.pool.Take(s.Ctx, managers[1]))
+	s.Equal(2, s.pool.Size())
+
+	s.Run("the taken manager can't be taken again", func() {
+		err := s.pool.Take(s.Ctx, managers[1])
+		s.ErrorIs(err, managerpool.ErrManagerNotInPool)
+
+		err = s.pool.Take(s.Ctx, types.NewUserID())
+		s.ErrorIs(err, managerpool.ErrManagerNotInPool)
+	})
+
+	s.Run("the rest keep the order", func() {
+		listed, err := s.pool.Managers(s.Ctx)
+		s.Require().NoError(err)
+		s.Equal([]types.UserID{managers[0], managers[2]}, listed)
+	})
+}
+
+func (s *Suite) TestConcurrency() {
+	const (
+		managersNum = 100
+		putInterval = 25 * time.Millisecond
+		putWorkers  = 10
+	)
+
+	managers := make([]types.UserID, managersNum)
+	for i := 0; i < managersNum; i++ {
+		managers[i] = types.NewUserID()
+	}
+	randManager := func() types.UserID { return managers[rand.Int()%len(managers)] } //nolint:gosec
+
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second)
+	defer cancel()
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(putInterval):
+				_ = s.pool.Size()
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(putInterval):
+				_, _ = s.pool.Contains(s.Ctx, randManager())
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(2 * putInterval):
+				if err := s.pool.Remove(s.Ctx, randManager()); err != nil {
+					return err
+				}
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(2 * putInterval):
+				if _, err := s.pool.Get(s.Ctx); err != nil && !errors.Is(err, managerpool.ErrNoAvailableManagers) {
+					return err
+				}
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(2 * putInterval):
+				if _, err := s.pool.Managers(s.Ctx); err != nil {
+					return err
+				}
+				if err := s.pool.Take(s.Ctx, randManager()); err != nil && !errors.Is(err, managerpool.ErrManagerNotInPool) {
+					return err
+				}
+			}
+		}
+	})
+
+	for i := 0; i < putWorkers; i++ {
+		wg.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(putInterval):
+					if err := s.pool.Put(s.Ctx, randManager()); err != nil {
+						return err
+					}
+				}
+			}
+		})
+	}
+
+	s.NoError(wg.Wait())
+}
@@ -0,0 +1,169 @@
+package psqlmanagerpool
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const (
+	serviceName = "manager-pool"
+	sizeTimeout = 3 * time.Second
+)
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	db     *store.Database `option:"mandatory" validate:"required"`
+	logger *zap.Logger
+}
+
+// Service is the manager pool stored in Postgres, so it survives the restarts
+// and is shared between the replicas of the service.
+type Service struct {
+	Options
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(serviceName)
+	}
+
+	return &Service{Options: opts}, nil
+}
+
+// Close does nothing, the database is closed by its owner.
+func (s *Service) Close() error {
+	return nil
+}
+
+// Get takes the manager who has been waiting the longest.
+// The managers being taken by the concurrent calls are skipped.
+func (s *Service) Get(ctx context.Context) (types.UserID, error) {
+	managerID := types.UserIDNil
+
+	err := s.db.RunInTx(ctx, func(ctx context.Context) error {
+		m, err := s.db.PooledManager(ctx).Query().
+			Order(pooledmanager.ByID()).
+			Limit(1).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			Only(ctx)
+		if err != nil {
+			if store.IsNotFound(err) {
+				return managerpool.ErrNoAvailableManagers
+			}
+			return fmt.Errorf("find manager err: %w", err)
+		}
+
+		if err := s.db.PooledManager(ctx).DeleteOneID(m.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("delete manager err: %w", err)
+		}
+
+		managerID = m.ManagerID
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, managerpool.ErrNoAvailableManagers) {
+			return types.UserIDNil, managerpool.ErrNoAvailableManagers
+		}
+		return types.UserIDNil, fmt.Errorf("get manager from pool: %w", err)
+	}
+
+	return managerID, nil
+}
+
+// Put adds the manager to the end of the queue. It is no-op if the manager is already in the pool.
+func (s *Service) Put(ctx context.Context, managerID types.UserID) error {
+	err := s.db.PooledManager(ctx).Create().
+		SetManagerID(managerID).
+		OnConflict(sql.ConflictColumns(pooledmanager.FieldManagerID), sql.DoNothing()).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) { // No rows are returned on conflict.
+		return fmt.Errorf("put manager to pool: %w", err)
+	}
+
+	return nil
+}
+
+// Managers returns the managers in the queue order, the longest waiting first.
+func (s *Service) Managers(ctx context.Context) ([]types.UserID, error) {
+	pooled, err := s.db.PooledManager(ctx).Query().
+		Order(pooledmanager.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list managers in pool: %w", err)
+	}
+
+	managers := make([]types.UserID, 0, len(pooled))
+	for _, m := range pooled {
+		managers = append(managers, m.ManagerID)
+	}
+
+	return managers, nil
+}
+
+// Take takes the certain manager out of the queue.
+// Returns managerpool.ErrManagerNotInPool if the manager has already been taken by the concurrent call.
+func (s *Service) Take(ctx context.Context, managerID types.UserID) error {
+	n, err := s.db.PooledManager(ctx).Delete().
+		Where(pooledmanager.ManagerID(managerID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("take manager from pool: %w", err)
+	}
+
+	if n == 0 {
+		return managerpool.ErrManagerNotInPool
+	}
+
+	return nil
+}
+
+// Remove takes the manager out of the queue. It is no-op if the manager is not in the pool.
+func (s *Service) Remove(ctx context.Context, managerID types.UserID) error {
+	if _, err := s.db.PooledManager(ctx).Delete().
+		Where(pooledmanager.ManagerID(managerID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("remove manager from pool: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Contains(ctx context.Context, managerID types.UserID) (bool, error) {
+	ok, err := s.db.PooledManager(ctx).Query().
+		Where(pooledmanager.ManagerID(managerID)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check manager in pool: %w", err)
+	}
+
+	return ok, nil
+}
+
+// Size returns the number of the managers in the pool or zero if it can't be counted.
+func (s *Service) Size() int {
+	ctx, cancel := context.WithTimeout(context.Background(), sizeTimeout)
+	defer cancel()
+
+	n, err := s.db.PooledManager(ctx).Query().Count(ctx)
+	if err != nil {
+		s.logger.Error("failed to count managers in pool", zap.Error(err))
+		return 0
+	}
+
+	return n
+}
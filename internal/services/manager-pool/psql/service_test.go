@@ -0,0 +1,47 @@
+//go:build integration
+
+package psqlmanagerpool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	managerpooltest "github.com/keepcalmist/chat-service/internal/services/manager-pool/pooltest"
+	psqlmanagerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool/psql"
+	"github.com/keepcalmist/chat-service/internal/store"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+)
+
+type ServiceSuite struct {
+	managerpooltest.Suite
+
+	cleanUp func()
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+
+	st, cleanUp := testingh.PrepareDB(s.SuiteCtx, s.T(), testingh.UniqueDBName("TestPSQLManagerPool"))
+	s.cleanUp = func() { cleanUp(s.SuiteCtx) }
+
+	db := store.NewDatabase(st)
+	s.NewPool = func() managerpool.Pool {
+		db.PooledManager(s.SuiteCtx).Delete().ExecX(s.SuiteCtx)
+
+		pool, err := psqlmanagerpool.New(psqlmanagerpool.NewOptions(db))
+		s.Require().NoError(err)
+		return pool
+	}
+}
+
+func (s *ServiceSuite) TearDownSuite() {
+	s.cleanUp()
+	s.Suite.TearDownSuite()
+}
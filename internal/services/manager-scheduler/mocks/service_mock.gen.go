@@ -0,0 +1,336 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package managerschedulermocks is a generated GoMock package.
+package managerschedulermocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockStrategy is a mock of Strategy interface.
+type MockStrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockStrategyMockRecorder
+}
+
+// MockStrategyMockRecorder is the mock recorder for MockStrategy.
+type MockStrategyMockRecorder struct {
+	mock *MockStrategy
+}
+
+// NewMockStrategy creates a new mock instance.
+func NewMockStrategy(ctrl *gomock.Controller) *MockStrategy {
+	mock := &MockStrategy{ctrl: ctrl}
+	mock.recorder = &MockStrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStrategy) EXPECT() *MockStrategyMockRecorder {
+	return m.recorder
+}
+
+// Pick mocks base method.
+func (m *MockStrategy) Pick(ctx context.Context, p problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pick", ctx, p, candidates)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pick indicates an expected call of Pick.
+func (mr *MockStrategyMockRecorder) Pick(ctx, p, candidates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockStrategy)(nil).Pick), ctx, p, candidates)
+}
+
+// MockmanagerPool is a mock of managerPool interface.
+type MockmanagerPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPoolMockRecorder
+}
+
+// MockmanagerPoolMockRecorder is the mock recorder for MockmanagerPool.
+type MockmanagerPoolMockRecorder struct {
+	mock *MockmanagerPool
+}
+
+// NewMockmanagerPool creates a new mock instance.
+func NewMockmanagerPool(ctrl *gomock.Controller) *MockmanagerPool {
+	mock := &MockmanagerPool{ctrl: ctrl}
+	mock.recorder = &MockmanagerPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPool) EXPECT() *MockmanagerPoolMockRecorder {
+	return m.recorder
+}
+
+// Managers mocks base method.
+func (m *MockmanagerPool) Managers(ctx context.Context) ([]types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Managers", ctx)
+	ret0, _ := ret[0].([]types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Managers indicates an expected call of Managers.
+func (mr *MockmanagerPoolMockRecorder) Managers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Managers", reflect.TypeOf((*MockmanagerPool)(nil).Managers), ctx)
+}
+
+// Put mocks base method.
+func (m *MockmanagerPool) Put(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockmanagerPoolMockRecorder) Put(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockmanagerPool)(nil).Put), ctx, managerID)
+}
+
+// Size mocks base method.
+func (m *MockmanagerPool) Size() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockmanagerPoolMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockmanagerPool)(nil).Size))
+}
+
+// Take mocks base method.
+func (m *MockmanagerPool) Take(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockmanagerPoolMockRecorder) Take(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockmanagerPool)(nil).Take), ctx, managerID)
+}
+
+// MockskillsRepository is a mock of skillsRepository interface.
+type MockskillsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockskillsRepositoryMockRecorder
+}
+
+// MockskillsRepositoryMockRecorder is the mock recorder for MockskillsRepository.
+type MockskillsRepositoryMockRecorder struct {
+	mock *MockskillsRepository
+}
+
+// NewMockskillsRepository creates a new mock instance.
+func NewMockskillsRepository(ctrl *gomock.Controller) *MockskillsRepository {
+	mock := &MockskillsRepository{ctrl: ctrl}
+	mock.recorder = &MockskillsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockskillsRepository) EXPECT() *MockskillsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FilterSkilledManagers mocks base method.
+func (m *MockskillsRepository) FilterSkilledManagers(ctx context.Context, managerIDs []types.UserID, topic types.Topic) ([]types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterSkilledManagers", ctx, managerIDs, topic)
+	ret0, _ := ret[0].([]types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterSkilledManagers indicates an expected call of FilterSkilledManagers.
+func (mr *MockskillsRepositoryMockRecorder) FilterSkilledManagers(ctx, managerIDs, topic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterSkilledManagers", reflect.TypeOf((*MockskillsRepository)(nil).FilterSkilledManagers), ctx, managerIDs, topic)
+}
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceMessageForClient mocks base method.
+func (m *MockmessagesRepository) CreateServiceMessageForClient(ctx context.Context, problemID types.ProblemID, chatID types.ChatID, msgBody string) (types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceMessageForClient", ctx, problemID, chatID, msgBody)
+	ret0, _ := ret[0].(types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceMessageForClient indicates an expected call of CreateServiceMessageForClient.
+func (mr *MockmessagesRepositoryMockRecorder) CreateServiceMessageForClient(ctx, problemID, chatID, msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceMessageForClient", reflect.TypeOf((*MockmessagesRepository)(nil).CreateServiceMessageForClient), ctx, problemID, chatID, msgBody)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetProblemsWithoutManager mocks base method.
+func (m *MockproblemsRepository) GetProblemsWithoutManager(ctx context.Context, offset, limit int) ([]problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblemsWithoutManager", ctx, offset, limit)
+	ret0, _ := ret[0].([]problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblemsWithoutManager indicates an expected call of GetProblemsWithoutManager.
+func (mr *MockproblemsRepositoryMockRecorder) GetProblemsWithoutManager(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemsWithoutManager", reflect.TypeOf((*MockproblemsRepository)(nil).GetProblemsWithoutManager), ctx, offset, limit)
+}
+
+// SetManagerForProblem mocks base method.
+func (m *MockproblemsRepository) SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManagerForProblem", ctx, problemID, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetManagerForProblem indicates an expected call of SetManagerForProblem.
+func (mr *MockproblemsRepositoryMockRecorder) SetManagerForProblem(ctx, problemID, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagerForProblem", reflect.TypeOf((*MockproblemsRepository)(nil).SetManagerForProblem), ctx, problemID, managerID)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
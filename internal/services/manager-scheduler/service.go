@@ -0,0 +1,266 @@
+package managerscheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	managerassignedtoproblemjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=managerschedulermocks
+
+const (
+	serviceName = "manager-scheduler"
+
+	managerAssignedMsgBody = "Manager will answer you soon"
+
+	// takeManagerAttempts limits the choices of the manager when the chosen ones keep being taken concurrently.
+	takeManagerAttempts = 3
+)
+
+var (
+	errManagersContended = errors.New("managers are contended")
+	errNoSkilledManagers = errors.New("no skilled managers")
+)
+
+// Strategy chooses the manager for the problem among the candidates waiting in the pool.
+// The candidates are never empty and listed in the queue order, the longest waiting first.
+type Strategy interface {
+	Pick(ctx context.Context, p problemsrepo.Problem, candidates []types.UserID) (types.UserID, error)
+}
+
+type managerPool interface {
+	Managers(ctx context.Context) ([]types.UserID, error)
+	Take(ctx context.Context, managerID types.UserID) error
+	Put(ctx context.Context, managerID types.UserID) error
+	Size() int
+}
+
+type skillsRepository interface {
+	FilterSkilledManagers(ctx context.Context, managerIDs []types.UserID, topic types.Topic) ([]types.UserID, error)
+}
+
+type messagesRepository interface {
+	CreateServiceMessageForClient(
+		ctx context.Context,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		msgBody string,
+	) (types.MessageID, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetProblemsWithoutManager(ctx context.Context, offset, limit int) ([]problemsrepo.Problem, error)
+	SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	period time.Duration `option:"mandatory" validate:"min=100ms,max=1m"`
+	// skillFallbackAfter is the time the problem waits for the manager skilled in its topic.
+	// After that any manager can take it.
+	skillFallbackAfter time.Duration      `option:"mandatory" validate:"min=1s,max=1h"`
+	managerPool        managerPool        `option:"mandatory" validate:"required"`
+	strategy           Strategy           `option:"mandatory" validate:"required"`
+	skillsRepo         skillsRepository   `option:"mandatory" validate:"required"`
+	msgRepo            messagesRepository `option:"mandatory" validate:"required"`
+	outbox             outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo       problemsRepository `option:"mandatory" validate:"required"`
+	txtor              transactor         `option:"mandatory" validate:"required"`
+	logger             *zap.Logger
+}
+
+// Service periodically assigns the problems without manager to the managers from the pool.
+// The manager for each problem is chosen by the strategy among the managers skilled in the problem topic.
+type Service struct {
+	Options
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(serviceName)
+	}
+
+	return &Service{Options: opts}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Debug("context done")
+			return nil
+		case <-ticker.C:
+			if err := s.assignProblems(ctx); err != nil {
+				s.logger.Error("failed to assign problems", zap.Error(err))
+			}
+		}
+	}
+}
+
+// assignProblems pages through the problems without manager, the oldest first, until the pool is empty.
+// The problems waiting for the skilled managers stay without manager, so they are skipped by the offset
+// and don't block the newer problems behind them.
+func (s *Service) assignProblems(ctx context.Context) error {
+	skipped := 0
+
+	for {
+		managersCount := s.managerPool.Size()
+		if managersCount == 0 {
+			return nil
+		}
+
+		problems, err := s.problemsRepo.GetProblemsWithoutManager(ctx, skipped, managersCount)
+		if err != nil {
+			return fmt.Errorf("get problems without manager: %w", err)
+		}
+
+		for _, p := range problems {
+			assigned, err := s.assignProblem(ctx, p)
+			if err != nil {
+				if errors.Is(err, managerpool.ErrNoAvailableManagers) {
+					return nil
+				}
+				return err
+			}
+			if !assigned {
+				skipped++
+			}
+		}
+
+		if len(problems) < managersCount {
+			return nil
+		}
+	}
+}
+
+// assignProblem assigns the manager from the pool to the problem.
+// Returns false if the problem is left without manager and is still in the queue.
+func (s *Service) assignProblem(ctx context.Context, p problemsrepo.Problem) (bool, error) {
+	managerID, err := s.takeManager(ctx, p)
+	if err != nil {
+		if errors.Is(err, errNoSkilledManagers) {
+			return false, nil
+		}
+		return false, fmt.Errorf("take manager from pool: %w", err)
+	}
+
+	if err := s.assignManager(ctx, p, managerID); err != nil {
+		s.logger.Error("failed to assign manager to problem", zap.Error(err),
+			zap.Stringer("problem_id", p.ID), zap.Stringer("manager_id", managerID))
+
+		if err := s.managerPool.Put(ctx, managerID); err != nil {
+			s.logger.Error("failed to return manager to pool", zap.Error(err),
+				zap.Stringer("manager_id", managerID))
+		}
+		return false, nil
+	}
+
+	s.logger.Info("manager assigned to problem",
+		zap.Stringer("problem_id", p.ID), zap.Stringer("manager_id", managerID))
+
+	return true, nil
+}
+
+// takeManager takes the manager chosen by the strategy out of the pool.
+// The choice is repeated if the manager has been taken concurrently, e.g. by another replica.
+func (s *Service) takeManager(ctx context.Context, p problemsrepo.Problem) (types.UserID, error) {
+	for i := 0; i < takeManagerAttempts; i++ {
+		candidates, err := s.managerPool.Managers(ctx)
+		if err != nil {
+			return types.UserIDNil, fmt.Errorf("list managers: %w", err)
+		}
+		if len(candidates) == 0 {
+			return types.UserIDNil, managerpool.ErrNoAvailableManagers
+		}
+
+		candidates, err = s.skilledManagers(ctx, p, candidates)
+		if err != nil {
+			return types.UserIDNil, err
+		}
+
+		managerID, err := s.strategy.Pick(ctx, p, candidates)
+		if err != nil {
+			return types.UserIDNil, fmt.Errorf("pick manager: %w", err)
+		}
+
+		if err := s.managerPool.Take(ctx, managerID); err != nil {
+			if errors.Is(err, managerpool.ErrManagerNotInPool) {
+				continue
+			}
+			return types.UserIDNil, fmt.Errorf("take manager: %w", err)
+		}
+
+		return managerID, nil
+	}
+
+	return types.UserIDNil, errManagersContended
+}
+
+// skilledManagers leaves the candidates skilled in the problem topic.
+// Returns errNoSkilledManagers if there are no such candidates and the problem can wait more.
+func (s *Service) skilledManagers(
+	ctx context.Context,
+	p problemsrepo.Problem,
+	candidates []types.UserID,
+) ([]types.UserID, error) {
+	if p.Topic == "" || time.Since(p.CreatedAt) >= s.skillFallbackAfter {
+		return candidates, nil
+	}
+
+	skilled, err := s.skillsRepo.FilterSkilledManagers(ctx, candidates, p.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("filter skilled managers: %w", err)
+	}
+	if len(skilled) == 0 {
+		return nil, errNoSkilledManagers
+	}
+
+	return skilled, nil
+}
+
+func (s *Service) assignManager(ctx context.Context, p problemsrepo.Problem, managerID types.UserID) error {
+	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.problemsRepo.SetManagerForProblem(ctx, p.ID, managerID); err != nil {
+			return fmt.Errorf("set manager for problem: %w", err)
+		}
+
+		msgID, err := s.msgRepo.CreateServiceMessageForClient(ctx, p.ID, p.ChatID, managerAssignedMsgBody)
+		if err != nil {
+			return fmt.Errorf("create service message: %w", err)
+		}
+
+		payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID)
+		if err != nil {
+			return fmt.Errorf("marshal job payload: %w", err)
+		}
+
+		if _, err := s.outbox.Put(ctx, managerassignedtoproblemjob.Name, payload, time.Now()); err != nil {
+			return fmt.Errorf("put job to outbox: %w", err)
+		}
+
+		return nil
+	})
+}
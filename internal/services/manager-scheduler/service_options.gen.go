@@ -0,0 +1,128 @@
+// Code generated by options-gen. DO NOT EDIT.
+package managerscheduler
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"go.uber.org/zap"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	period time.Duration,
+	skillFallbackAfter time.Duration,
+	managerPool managerPool,
+	strategy Strategy,
+	skillsRepo skillsRepository,
+	msgRepo messagesRepository,
+	outbox outboxService,
+	problemsRepo problemsRepository,
+	txtor transactor,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.period = period
+	o.skillFallbackAfter = skillFallbackAfter
+	o.managerPool = managerPool
+	o.strategy = strategy
+	o.skillsRepo = skillsRepo
+	o.msgRepo = msgRepo
+	o.outbox = outbox
+	o.problemsRepo = problemsRepo
+	o.txtor = txtor
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithLogger(opt *zap.Logger) OptOptionsSetter {
+	return func(o *Options) {
+		o.logger = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("period", _validate_Options_period(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("skillFallbackAfter", _validate_Options_skillFallbackAfter(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("managerPool", _validate_Options_managerPool(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("strategy", _validate_Options_strategy(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("skillsRepo", _validate_Options_skillsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outbox", _validate_Options_outbox(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_period(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.period, "min=100ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `period` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_skillFallbackAfter(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.skillFallbackAfter, "min=1s,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `skillFallbackAfter` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_managerPool(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.managerPool, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `managerPool` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_strategy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.strategy, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `strategy` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_skillsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.skillsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `skillsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outbox(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outbox, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outbox` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
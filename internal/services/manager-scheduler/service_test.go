@@ -0,0 +1,359 @@
+package managerscheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	managerpool "github.com/keepcalmist/chat-service/internal/services/manager-pool"
+	managerscheduler "github.com/keepcalmist/chat-service/internal/services/manager-scheduler"
+	managerschedulermocks "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/mocks"
+	managerassignedtoproblemjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const (
+	period             = 100 * time.Millisecond
+	skillFallbackAfter = time.Minute
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	managerPool  *managerschedulermocks.MockmanagerPool
+	strategy     *managerschedulermocks.MockStrategy
+	skillsRepo   *managerschedulermocks.MockskillsRepository
+	msgRepo      *managerschedulermocks.MockmessagesRepository
+	outbox       *managerschedulermocks.MockoutboxService
+	problemsRepo *managerschedulermocks.MockproblemsRepository
+	txtor        *managerschedulermocks.Mocktransactor
+	scheduler    *managerscheduler.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.managerPool = managerschedulermocks.NewMockmanagerPool(s.ctrl)
+	s.strategy = managerschedulermocks.NewMockStrategy(s.ctrl)
+	s.skillsRepo = managerschedulermocks.NewMockskillsRepository(s.ctrl)
+	s.msgRepo = managerschedulermocks.NewMockmessagesRepository(s.ctrl)
+	s.outbox = managerschedulermocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = managerschedulermocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = managerschedulermocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.scheduler, err = managerscheduler.New(managerscheduler.NewOptions(
+		period,
+		skillFallbackAfter,
+		s.managerPool,
+		s.strategy,
+		s.skillsRepo,
+		s.msgRepo,
+		s.outbox,
+		s.problemsRepo,
+		s.txtor,
+	))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestNoManagersInPool() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	s.managerPool.EXPECT().Size().DoAndReturn(func() int {
+		cancel()
+		return 0
+	})
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestProblemsAssigned() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	problems := []problemsrepo.Problem{
+		{ID: types.NewProblemID(), ChatID: types.NewChatID()},
+		{ID: types.NewProblemID(), ChatID: types.NewChatID()},
+	}
+	managers := []types.UserID{types.NewUserID(), types.NewUserID()}
+
+	s.managerPool.EXPECT().Size().Return(len(managers))
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, len(managers)).Return(problems, nil)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Times(len(problems)).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	calls := make([]*gomock.Call, 0, 6*len(problems))
+	for i, p := range problems {
+		// The strategy picks the last candidate, so the managers are taken in reverse order.
+		candidates := managers[:len(managers)-i]
+		picked := candidates[len(candidates)-1]
+
+		msgID := types.NewMessageID()
+		payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, picked)
+		s.Require().NoError(err)
+
+		calls = append(calls,
+			s.managerPool.EXPECT().Managers(gomock.Any()).Return(candidates, nil),
+			s.strategy.EXPECT().Pick(gomock.Any(), p, candidates).Return(picked, nil),
+			s.managerPool.EXPECT().Take(gomock.Any(), picked).Return(nil),
+			s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p.ID, picked).Return(nil),
+			s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), p.ID, p.ChatID, gomock.Any()).
+				Return(msgID, nil),
+			s.outbox.EXPECT().Put(gomock.Any(), managerassignedtoproblemjob.Name, payload, gomock.Any()).
+				Return(types.NewJobID(), nil),
+		)
+	}
+	gomock.InOrder(calls...)
+
+	s.managerPool.EXPECT().Size().DoAndReturn(func() int {
+		cancel()
+		return 0
+	}).AnyTimes()
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestPoolExhausted() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	problems := []problemsrepo.Problem{
+		{ID: types.NewProblemID(), ChatID: types.NewChatID()},
+		{ID: types.NewProblemID(), ChatID: types.NewChatID()},
+	}
+
+	s.managerPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 1).Return(problems, nil)
+	s.managerPool.EXPECT().Managers(gomock.Any()).DoAndReturn(func(_ context.Context) ([]types.UserID, error) {
+		cancel()
+		return nil, nil
+	})
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestAssignmentFailed_ManagerReturnedToPool() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	p := problemsrepo.Problem{ID: types.NewProblemID(), ChatID: types.NewChatID()}
+	managerID := types.NewUserID()
+
+	s.managerPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 1).Return([]problemsrepo.Problem{p}, nil)
+	s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{managerID}, nil)
+	s.strategy.EXPECT().Pick(gomock.Any(), p, []types.UserID{managerID}).Return(managerID, nil)
+	s.managerPool.EXPECT().Take(gomock.Any(), managerID).Return(nil)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p.ID, managerID).Return(nil)
+	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), p.ID, p.ChatID, gomock.Any()).
+		Return(types.MessageIDNil, errors.New("unexpected"))
+	s.managerPool.EXPECT().Put(gomock.Any(), managerID).DoAndReturn(func(_ context.Context, _ types.UserID) error {
+		cancel()
+		return nil
+	})
+
+	// The problem is left in the queue, the next page is looked at.
+	s.managerPool.EXPECT().Size().Return(0).AnyTimes()
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestManagerTakenConcurrently_PickedAgain() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	p := problemsrepo.Problem{ID: types.NewProblemID(), ChatID: types.NewChatID()}
+	taken, free := types.NewUserID(), types.NewUserID()
+
+	s.managerPool.EXPECT().Size().Return(2)
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 2).Return([]problemsrepo.Problem{p}, nil)
+	gomock.InOrder(
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{taken, free}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), p, []types.UserID{taken, free}).Return(taken, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), taken).Return(managerpool.ErrManagerNotInPool),
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{free}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), p, []types.UserID{free}).Return(free, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), free).Return(nil),
+	)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.managerPool.EXPECT().Size().DoAndReturn(func() int {
+		cancel()
+		return 0
+	}).AnyTimes()
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestStrategyFailed() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	p := problemsrepo.Problem{ID: types.NewProblemID(), ChatID: types.NewChatID()}
+	managerID := types.NewUserID()
+
+	s.managerPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 1).Return([]problemsrepo.Problem{p}, nil)
+	s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{managerID}, nil)
+	s.strategy.EXPECT().Pick(gomock.Any(), p, []types.UserID{managerID}).
+		DoAndReturn(func(_ context.Context, _ problemsrepo.Problem, _ []types.UserID) (types.UserID, error) {
+			cancel()
+			return types.UserIDNil, errors.New("unexpected")
+		})
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestSkillRouting() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	now := time.Now()
+	waiting := problemsrepo.Problem{
+		ID: types.NewProblemID(), ChatID: types.NewChatID(), Topic: types.TopicLoans, CreatedAt: now,
+	}
+	routed := problemsrepo.Problem{
+		ID: types.NewProblemID(), ChatID: types.NewChatID(), Topic: types.TopicCards, CreatedAt: now,
+	}
+	waitedEnough := problemsrepo.Problem{
+		ID:        types.NewProblemID(),
+		ChatID:    types.NewChatID(),
+		Topic:     types.TopicDeposits,
+		CreatedAt: now.Add(-2 * skillFallbackAfter),
+	}
+	noTopic := problemsrepo.Problem{ID: types.NewProblemID(), ChatID: types.NewChatID(), CreatedAt: now}
+	m1, m2, m3 := types.NewUserID(), types.NewUserID(), types.NewUserID()
+
+	s.managerPool.EXPECT().Size().Return(4)
+	s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 4).
+		Return([]problemsrepo.Problem{waiting, routed, waitedEnough, noTopic}, nil)
+	gomock.InOrder(
+		// Nobody is skilled in loans, the problem waits.
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{m1, m2, m3}, nil),
+		s.skillsRepo.EXPECT().FilterSkilledManagers(gomock.Any(), []types.UserID{m1, m2, m3}, types.TopicLoans).
+			Return(nil, nil),
+
+		// The strategy chooses among the skilled managers only.
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{m1, m2, m3}, nil),
+		s.skillsRepo.EXPECT().FilterSkilledManagers(gomock.Any(), []types.UserID{m1, m2, m3}, types.TopicCards).
+			Return([]types.UserID{m2}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), routed, []types.UserID{m2}).Return(m2, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), m2).Return(nil),
+
+		// The problem has waited too long, any manager can take it.
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{m1, m3}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), waitedEnough, []types.UserID{m1, m3}).Return(m3, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), m3).Return(nil),
+
+		// The problem without topic goes to any manager.
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{m1}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), noTopic, []types.UserID{m1}).Return(m1, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), m1).Return(nil),
+	)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	s.managerPool.EXPECT().Size().DoAndReturn(func() int {
+		cancel()
+		return 0
+	}).AnyTimes()
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) TestSkillRouting_UnmatchedProblemDoesNotBlockQueue() {
+	// Arrange.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	now := time.Now()
+	unmatched := problemsrepo.Problem{
+		ID: types.NewProblemID(), ChatID: types.NewChatID(), Topic: types.TopicLoans, CreatedAt: now.Add(-time.Second),
+	}
+	matchable := problemsrepo.Problem{
+		ID: types.NewProblemID(), ChatID: types.NewChatID(), Topic: types.TopicCards, CreatedAt: now,
+	}
+	managerID := types.NewUserID()
+
+	gomock.InOrder(
+		// Nobody is skilled in loans, the problem stays at the head of the queue.
+		s.managerPool.EXPECT().Size().Return(1),
+		s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 0, 1).
+			Return([]problemsrepo.Problem{unmatched}, nil),
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{managerID}, nil),
+		s.skillsRepo.EXPECT().FilterSkilledManagers(gomock.Any(), []types.UserID{managerID}, types.TopicLoans).
+			Return(nil, nil),
+
+		// The next problem is looked at in the same tick.
+		s.managerPool.EXPECT().Size().Return(1),
+		s.problemsRepo.EXPECT().GetProblemsWithoutManager(gomock.Any(), 1, 1).
+			Return([]problemsrepo.Problem{matchable}, nil),
+		s.managerPool.EXPECT().Managers(gomock.Any()).Return([]types.UserID{managerID}, nil),
+		s.skillsRepo.EXPECT().FilterSkilledManagers(gomock.Any(), []types.UserID{managerID}, types.TopicCards).
+			Return([]types.UserID{managerID}, nil),
+		s.strategy.EXPECT().Pick(gomock.Any(), matchable, []types.UserID{managerID}).Return(managerID, nil),
+		s.managerPool.EXPECT().Take(gomock.Any(), managerID).Return(nil),
+		s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Return(nil),
+
+		s.managerPool.EXPECT().Size().DoAndReturn(func() int {
+			cancel()
+			return 0
+		}).AnyTimes(),
+	)
+
+	// Action & assert.
+	s.runScheduler(ctx)
+}
+
+func (s *ServiceSuite) runScheduler(ctx context.Context) {
+	s.T().Helper()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.scheduler.Run(ctx) }()
+
+	select {
+	case err := <-errCh:
+		s.NoError(err)
+	case <-time.After(10 * period):
+		s.Fail("scheduler did not stop")
+	}
+}
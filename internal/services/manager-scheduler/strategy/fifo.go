@@ -0,0 +1,22 @@
+// Package schedulerstrategy contains the strategies choosing the manager for the problem among the pool.
+// The candidates are always given in the queue order, so the strategies prefer the longest waiting
+// manager when they find several equally suitable ones.
+package schedulerstrategy
+
+import (
+	"context"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// FIFO picks the manager who has been waiting the longest.
+type FIFO struct{}
+
+func NewFIFO() FIFO {
+	return FIFO{}
+}
+
+func (FIFO) Pick(_ context.Context, _ problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	return candidates[0], nil
+}
@@ -0,0 +1,25 @@
+package schedulerstrategy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	schedulerstrategy "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestFIFO_Pick(t *testing.T) {
+	candidates := []types.UserID{types.NewUserID(), types.NewUserID(), types.NewUserID()}
+
+	picked, err := schedulerstrategy.NewFIFO().Pick(context.Background(), newProblem(), candidates)
+	require.NoError(t, err)
+	assert.Equal(t, candidates[0], picked)
+}
+
+func newProblem() problemsrepo.Problem {
+	return problemsrepo.Problem{ID: types.NewProblemID(), ChatID: types.NewChatID()}
+}
@@ -0,0 +1,40 @@
+package schedulerstrategy
+
+import (
+	"context"
+	"fmt"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/least_loaded_mock.gen.go -package=schedulerstrategymocks
+
+type managerLoadRepository interface {
+	GetManagerOpenProblemsCount(ctx context.Context, managerID types.UserID) (int, error)
+}
+
+// LeastLoaded picks the manager having the fewest open problems.
+type LeastLoaded struct {
+	problemsRepo managerLoadRepository
+}
+
+func NewLeastLoaded(problemsRepo managerLoadRepository) *LeastLoaded {
+	return &LeastLoaded{problemsRepo: problemsRepo}
+}
+
+func (s *LeastLoaded) Pick(ctx context.Context, _ problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	picked, minLoad := types.UserIDNil, 0
+	for _, managerID := range candidates {
+		load, err := s.problemsRepo.GetManagerOpenProblemsCount(ctx, managerID)
+		if err != nil {
+			return types.UserIDNil, fmt.Errorf("get manager open problems count: %w", err)
+		}
+
+		if picked.IsZero() || load < minLoad {
+			picked, minLoad = managerID, load
+		}
+	}
+
+	return picked, nil
+}
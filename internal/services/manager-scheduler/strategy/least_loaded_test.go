@@ -0,0 +1,67 @@
+package schedulerstrategy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	schedulerstrategy "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy"
+	schedulerstrategymocks "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestLeastLoaded_Pick(t *testing.T) {
+	m1, m2, m3, m4 := types.NewUserID(), types.NewUserID(), types.NewUserID(), types.NewUserID()
+
+	cases := []struct {
+		name     string
+		loads    map[types.UserID]int
+		expected types.UserID
+	}{
+		{
+			name:     "the least loaded manager",
+			loads:    map[types.UserID]int{m1: 3, m2: 1, m3: 0, m4: 2},
+			expected: m3,
+		},
+		{
+			name:     "the longest waiting among equally loaded",
+			loads:    map[types.UserID]int{m1: 2, m2: 1, m3: 1, m4: 1},
+			expected: m2,
+		},
+		{
+			name:     "nobody is loaded",
+			loads:    map[types.UserID]int{m1: 0, m2: 0, m3: 0, m4: 0},
+			expected: m1,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := schedulerstrategymocks.NewMockmanagerLoadRepository(ctrl)
+			for managerID, load := range tt.loads {
+				repo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), managerID).Return(load, nil)
+			}
+
+			picked, err := schedulerstrategy.NewLeastLoaded(repo).
+				Pick(context.Background(), newProblem(), []types.UserID{m1, m2, m3, m4})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, picked)
+		})
+	}
+}
+
+func TestLeastLoaded_Pick_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := schedulerstrategymocks.NewMockmanagerLoadRepository(ctrl)
+	repo.EXPECT().GetManagerOpenProblemsCount(gomock.Any(), gomock.Any()).Return(0, errors.New("unexpected"))
+
+	_, err := schedulerstrategy.NewLeastLoaded(repo).
+		Pick(context.Background(), newProblem(), []types.UserID{types.NewUserID()})
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: least_loaded.go
+
+// Package schedulerstrategymocks is a generated GoMock package.
+package schedulerstrategymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockmanagerLoadRepository is a mock of managerLoadRepository interface.
+type MockmanagerLoadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerLoadRepositoryMockRecorder
+}
+
+// MockmanagerLoadRepositoryMockRecorder is the mock recorder for MockmanagerLoadRepository.
+type MockmanagerLoadRepositoryMockRecorder struct {
+	mock *MockmanagerLoadRepository
+}
+
+// NewMockmanagerLoadRepository creates a new mock instance.
+func NewMockmanagerLoadRepository(ctrl *gomock.Controller) *MockmanagerLoadRepository {
+	mock := &MockmanagerLoadRepository{ctrl: ctrl}
+	mock.recorder = &MockmanagerLoadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerLoadRepository) EXPECT() *MockmanagerLoadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagerOpenProblemsCount mocks base method.
+func (m *MockmanagerLoadRepository) GetManagerOpenProblemsCount(ctx context.Context, managerID types.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerOpenProblemsCount", ctx, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerOpenProblemsCount indicates an expected call of GetManagerOpenProblemsCount.
+func (mr *MockmanagerLoadRepositoryMockRecorder) GetManagerOpenProblemsCount(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerOpenProblemsCount", reflect.TypeOf((*MockmanagerLoadRepository)(nil).GetManagerOpenProblemsCount), ctx, managerID)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: round_robin.go
+
+// Package schedulerstrategymocks is a generated GoMock package.
+package schedulerstrategymocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockassignmentsRepository is a mock of assignmentsRepository interface.
+type MockassignmentsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockassignmentsRepositoryMockRecorder
+}
+
+// MockassignmentsRepositoryMockRecorder is the mock recorder for MockassignmentsRepository.
+type MockassignmentsRepositoryMockRecorder struct {
+	mock *MockassignmentsRepository
+}
+
+// NewMockassignmentsRepository creates a new mock instance.
+func NewMockassignmentsRepository(ctrl *gomock.Controller) *MockassignmentsRepository {
+	mock := &MockassignmentsRepository{ctrl: ctrl}
+	mock.recorder = &MockassignmentsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockassignmentsRepository) EXPECT() *MockassignmentsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagersLastAssignedAt mocks base method.
+func (m *MockassignmentsRepository) GetManagersLastAssignedAt(ctx context.Context, managerIDs []types.UserID) (map[types.UserID]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagersLastAssignedAt", ctx, managerIDs)
+	ret0, _ := ret[0].(map[types.UserID]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagersLastAssignedAt indicates an expected call of GetManagersLastAssignedAt.
+func (mr *MockassignmentsRepositoryMockRecorder) GetManagersLastAssignedAt(ctx, managerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagersLastAssignedAt", reflect.TypeOf((*MockassignmentsRepository)(nil).GetManagersLastAssignedAt), ctx, managerIDs)
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sticky.go
+
+// Package schedulerstrategymocks is a generated GoMock package.
+package schedulerstrategymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockchatHistoryRepository is a mock of chatHistoryRepository interface.
+type MockchatHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockchatHistoryRepositoryMockRecorder
+}
+
+// MockchatHistoryRepositoryMockRecorder is the mock recorder for MockchatHistoryRepository.
+type MockchatHistoryRepositoryMockRecorder struct {
+	mock *MockchatHistoryRepository
+}
+
+// NewMockchatHistoryRepository creates a new mock instance.
+func NewMockchatHistoryRepository(ctrl *gomock.Controller) *MockchatHistoryRepository {
+	mock := &MockchatHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockchatHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchatHistoryRepository) EXPECT() *MockchatHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetChatLastManagerID mocks base method.
+func (m *MockchatHistoryRepository) GetChatLastManagerID(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatLastManagerID", ctx, chatID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatLastManagerID indicates an expected call of GetChatLastManagerID.
+func (mr *MockchatHistoryRepositoryMockRecorder) GetChatLastManagerID(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatLastManagerID", reflect.TypeOf((*MockchatHistoryRepository)(nil).GetChatLastManagerID), ctx, chatID)
+}
+
+// Mockstrategy is a mock of strategy interface.
+type Mockstrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockstrategyMockRecorder
+}
+
+// MockstrategyMockRecorder is the mock recorder for Mockstrategy.
+type MockstrategyMockRecorder struct {
+	mock *Mockstrategy
+}
+
+// NewMockstrategy creates a new mock instance.
+func NewMockstrategy(ctrl *gomock.Controller) *Mockstrategy {
+	mock := &Mockstrategy{ctrl: ctrl}
+	mock.recorder = &MockstrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockstrategy) EXPECT() *MockstrategyMockRecorder {
+	return m.recorder
+}
+
+// Pick mocks base method.
+func (m *Mockstrategy) Pick(ctx context.Context, p problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pick", ctx, p, candidates)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pick indicates an expected call of Pick.
+func (mr *MockstrategyMockRecorder) Pick(ctx, p, candidates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*Mockstrategy)(nil).Pick), ctx, p, candidates)
+}
@@ -0,0 +1,42 @@
+package schedulerstrategy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/round_robin_mock.gen.go -package=schedulerstrategymocks
+
+type assignmentsRepository interface {
+	GetManagersLastAssignedAt(ctx context.Context, managerIDs []types.UserID) (map[types.UserID]time.Time, error)
+}
+
+// RoundRobin picks the manager whose last assignment is the oldest.
+// The managers who have never been assigned a problem go first.
+type RoundRobin struct {
+	problemsRepo assignmentsRepository
+}
+
+func NewRoundRobin(problemsRepo assignmentsRepository) *RoundRobin {
+	return &RoundRobin{problemsRepo: problemsRepo}
+}
+
+func (s *RoundRobin) Pick(ctx context.Context, _ problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	lastAssignedAt, err := s.problemsRepo.GetManagersLastAssignedAt(ctx, candidates)
+	if err != nil {
+		return types.UserIDNil, fmt.Errorf("get managers last assignment: %w", err)
+	}
+
+	picked := candidates[0]
+	for _, managerID := range candidates[1:] {
+		if lastAssignedAt[managerID].Before(lastAssignedAt[picked]) {
+			picked = managerID
+		}
+	}
+
+	return picked, nil
+}
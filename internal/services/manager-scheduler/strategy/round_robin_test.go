@@ -0,0 +1,72 @@
+package schedulerstrategy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	schedulerstrategy "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy"
+	schedulerstrategymocks "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestRoundRobin_Pick(t *testing.T) {
+	m1, m2, m3 := types.NewUserID(), types.NewUserID(), types.NewUserID()
+	candidates := []types.UserID{m1, m2, m3}
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name           string
+		lastAssignedAt map[types.UserID]time.Time
+		expected       types.UserID
+	}{
+		{
+			name:           "the oldest assignment",
+			lastAssignedAt: map[types.UserID]time.Time{m1: now, m2: now.Add(-time.Hour), m3: now.Add(-time.Minute)},
+			expected:       m2,
+		},
+		{
+			name:           "never assigned manager goes first",
+			lastAssignedAt: map[types.UserID]time.Time{m1: now.Add(-time.Hour), m2: now},
+			expected:       m3,
+		},
+		{
+			name:           "the longest waiting among never assigned",
+			lastAssignedAt: map[types.UserID]time.Time{m1: now},
+			expected:       m2,
+		},
+		{
+			name:           "the longest waiting among assigned at the same time",
+			lastAssignedAt: map[types.UserID]time.Time{m1: now, m2: now, m3: now},
+			expected:       m1,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := schedulerstrategymocks.NewMockassignmentsRepository(ctrl)
+			repo.EXPECT().GetManagersLastAssignedAt(gomock.Any(), candidates).Return(tt.lastAssignedAt, nil)
+
+			picked, err := schedulerstrategy.NewRoundRobin(repo).Pick(context.Background(), newProblem(), candidates)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, picked)
+		})
+	}
+}
+
+func TestRoundRobin_Pick_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := schedulerstrategymocks.NewMockassignmentsRepository(ctrl)
+	repo.EXPECT().GetManagersLastAssignedAt(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected"))
+
+	_, err := schedulerstrategy.NewRoundRobin(repo).
+		Pick(context.Background(), newProblem(), []types.UserID{types.NewUserID()})
+	require.Error(t, err)
+}
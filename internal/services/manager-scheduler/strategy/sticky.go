@@ -0,0 +1,49 @@
+package schedulerstrategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/sticky_mock.gen.go -package=schedulerstrategymocks
+
+type chatHistoryRepository interface {
+	GetChatLastManagerID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type strategy interface {
+	Pick(ctx context.Context, p problemsrepo.Problem, candidates []types.UserID) (types.UserID, error)
+}
+
+// Sticky picks the manager who handled the previous problem in the same chat, so the client
+// talks to the familiar manager. The choice is up to the fallback strategy if the manager is busy
+// or the chat is new.
+type Sticky struct {
+	problemsRepo chatHistoryRepository
+	fallback     strategy
+}
+
+func NewSticky(problemsRepo chatHistoryRepository, fallback strategy) *Sticky {
+	return &Sticky{problemsRepo: problemsRepo, fallback: fallback}
+}
+
+func (s *Sticky) Pick(ctx context.Context, p problemsrepo.Problem, candidates []types.UserID) (types.UserID, error) {
+	lastManagerID, err := s.problemsRepo.GetChatLastManagerID(ctx, p.ChatID)
+	if err != nil && !errors.Is(err, problemsrepo.ErrProblemNotFound) {
+		return types.UserIDNil, fmt.Errorf("get chat last manager: %w", err)
+	}
+
+	if err == nil {
+		for _, managerID := range candidates {
+			if managerID == lastManagerID {
+				return managerID, nil
+			}
+		}
+	}
+
+	return s.fallback.Pick(ctx, p, candidates)
+}
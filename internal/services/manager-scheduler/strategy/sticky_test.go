@@ -0,0 +1,73 @@
+package schedulerstrategy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	schedulerstrategy "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy"
+	schedulerstrategymocks "github.com/keepcalmist/chat-service/internal/services/manager-scheduler/strategy/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestSticky_Pick(t *testing.T) {
+	m1, m2, m3 := types.NewUserID(), types.NewUserID(), types.NewUserID()
+	candidates := []types.UserID{m1, m2, m3}
+
+	cases := []struct {
+		name          string
+		lastManagerID types.UserID
+		lastErr       error
+		expected      types.UserID
+	}{
+		{
+			name:          "the previous manager is in the pool",
+			lastManagerID: m3,
+			expected:      m3,
+		},
+		{
+			name:          "the previous manager is busy",
+			lastManagerID: types.NewUserID(),
+			expected:      m2,
+		},
+		{
+			name:     "new chat",
+			lastErr:  problemsrepo.ErrProblemNotFound,
+			expected: m2,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			p := newProblem()
+
+			repo := schedulerstrategymocks.NewMockchatHistoryRepository(ctrl)
+			repo.EXPECT().GetChatLastManagerID(gomock.Any(), p.ChatID).Return(tt.lastManagerID, tt.lastErr)
+
+			fallback := schedulerstrategymocks.NewMockstrategy(ctrl)
+			fallback.EXPECT().Pick(gomock.Any(), p, candidates).Return(m2, nil).MaxTimes(1)
+
+			picked, err := schedulerstrategy.NewSticky(repo, fallback).Pick(context.Background(), p, candidates)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, picked)
+		})
+	}
+}
+
+func TestSticky_Pick_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := schedulerstrategymocks.NewMockchatHistoryRepository(ctrl)
+	repo.EXPECT().GetChatLastManagerID(gomock.Any(), gomock.Any()).Return(types.UserIDNil, errors.New("unexpected"))
+	fallback := schedulerstrategymocks.NewMockstrategy(ctrl)
+
+	_, err := schedulerstrategy.NewSticky(repo, fallback).
+		Pick(context.Background(), newProblem(), []types.UserID{types.NewUserID()})
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package outbox
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/keepcalmist/chat-service/internal/types"
@@ -10,3 +11,41 @@ import (
 func (s *Service) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
 	return s.r.CreateJob(ctx, name, payload, availableAt)
 }
+
+// PutOrdered puts the job that runs only after the previously put jobs with the same ordering key.
+// The failing job blocks its successors until it is handled or moved to the DLQ.
+func (s *Service) PutOrdered(
+	ctx context.Context,
+	name, payload, orderingKey string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	return s.r.CreateOrderedJob(ctx, name, payload, orderingKey, availableAt)
+}
+
+// PutUnique puts the job unless there is a pending job with the same dedup key,
+// ErrJobDuplicated is returned in this case. The key can be used again once the job is handled
+// or moved to the DLQ. Unlike the failed insert, the duplicate doesn't break the transaction in ctx.
+func (s *Service) PutUnique(
+	ctx context.Context,
+	name, payload, dedupKey string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	jobID, created, err := s.r.CreateUniqueJob(ctx, name, payload, dedupKey, availableAt)
+	if err != nil {
+		return types.JobIDNil, err
+	}
+	if !created {
+		return types.JobIDNil, fmt.Errorf("%w: %s", ErrJobDuplicated, jobID)
+	}
+	return jobID, nil
+}
+
+// PutUniqueOrGet is like PutUnique, but returns the ID of the existing pending job instead of the error.
+func (s *Service) PutUniqueOrGet(
+	ctx context.Context,
+	name, payload, dedupKey string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	jobID, _, err := s.r.CreateUniqueJob(ctx, name, payload, dedupKey, availableAt)
+	return jobID, err
+}
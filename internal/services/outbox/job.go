@@ -2,6 +2,8 @@ package outbox
 
 import (
 	"context"
+	"math"
+	"math/rand"
 	"time"
 )
 
@@ -10,6 +12,13 @@ const (
 	defaultMaxAttempts      = 30
 )
 
+var defaultRetryPolicy = ExponentialBackoff{
+	InitialInterval: time.Second,
+	Multiplier:      2,
+	MaxInterval:     5 * time.Minute,
+	Jitter:          0.2,
+}
+
 type Job interface {
 	Name() string
 
@@ -24,6 +33,41 @@ type Job interface {
 	// An attempt is counted if the task was not completed due to an unknown error.
 	// When MaxAttempts() is exceeded, the task moves to the dlq (dead letter queue) table.
 	MaxAttempts() int
+
+	// RetryPolicy defines when the failed task is repeated.
+	RetryPolicy() RetryPolicy
+}
+
+type RetryPolicy interface {
+	// Delay returns the time to wait before the next attempt after the failed one.
+	// Attempts are numbered from 1.
+	Delay(attempt int) time.Duration
+}
+
+// ExponentialBackoff multiplies the delay by Multiplier after every failed attempt,
+// starting from InitialInterval and never exceeding MaxInterval.
+// The delay is randomly reduced by up to Jitter (from 0 to 1) of itself,
+// so the jobs failed together are not repeated together.
+type ExponentialBackoff struct {
+	InitialInterval time.Duration
+	Multiplier      float64
+	MaxInterval     time.Duration
+	Jitter          float64
+}
+
+func (b ExponentialBackoff) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(b.InitialInterval) * math.Pow(b.Multiplier, float64(attempt-1))
+	if delay > float64(b.MaxInterval) {
+		delay = float64(b.MaxInterval)
+	}
+
+	delay -= delay * b.Jitter * rand.Float64() //nolint:gosec // Jitter doesn't need crypto random.
+
+	return time.Duration(delay)
 }
 
 // DefaultJob is useful for embedding into other jobs.
@@ -36,3 +80,7 @@ func (j DefaultJob) ExecutionTimeout() time.Duration {
 func (j DefaultJob) MaxAttempts() int {
 	return defaultMaxAttempts
 }
+
+func (j DefaultJob) RetryPolicy() RetryPolicy {
+	return defaultRetryPolicy
+}
@@ -0,0 +1,60 @@
+package outbox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+func TestExponentialBackoff_Delay(t *testing.T) {
+	b := outbox.ExponentialBackoff{
+		InitialInterval: time.Second,
+		Multiplier:      2,
+		MaxInterval:     10 * time.Second,
+	}
+
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 4, want: 8 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 100, want: 10 * time.Second},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.want, b.Delay(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestExponentialBackoff_Jitter(t *testing.T) {
+	b := outbox.ExponentialBackoff{
+		InitialInterval: time.Second,
+		Multiplier:      2,
+		MaxInterval:     time.Minute,
+		Jitter:          0.5,
+	}
+
+	delays := make(map[time.Duration]struct{})
+	for i := 0; i < 100; i++ {
+		d := b.Delay(3)
+		assert.GreaterOrEqual(t, d, 2*time.Second)
+		assert.LessOrEqual(t, d, 4*time.Second)
+		delays[d] = struct{}{}
+	}
+	assert.Greater(t, len(delays), 1)
+}
+
+func TestDefaultJob_RetryPolicy(t *testing.T) {
+	p := outbox.DefaultJob{}.RetryPolicy()
+
+	assert.LessOrEqual(t, p.Delay(1), time.Second)
+	assert.LessOrEqual(t, p.Delay(outbox.DefaultJob{}.MaxAttempts()), 5*time.Minute)
+}
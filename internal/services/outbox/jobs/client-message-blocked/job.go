@@ -0,0 +1,109 @@
+package clientmessageblockedjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=clientmessageblockedjobmocks
+
+const Name = "client-message-blocked"
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo          messageRepository `option:"mandatory"  validate:"required"`
+	eventStream      eventStream       `option:"mandatory"  validate:"required"`
+	executionTimeout time.Duration     `option:"default=0"`
+	maxAttempts      int               `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
+	logger           *zap.Logger
+}
+
+// Job notifies the client that the message was blocked by AFC.
+type Job struct {
+	Options
+	defaultJob outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(Name)
+	}
+
+	return &Job{
+		Options:    opts,
+		defaultJob: outbox.DefaultJob{},
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	msgID := types.MessageID{}
+	err := msgID.Scan(payload)
+	if err != nil {
+		return fmt.Errorf("failed to scan payload in <%s> job: %w", Name, err)
+	}
+
+	msg, err := j.msgRepo.GetMessageByID(ctx, msgID)
+	if err != nil {
+		return fmt.Errorf("failed to get message by id in <%s> job: %w", Name, err)
+	}
+
+	err = j.eventStream.Publish(ctx, msg.AuthorID, eventstream.NewMessageBlockedEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ID,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to publish message blocked event in <%s> job: %w", Name, err)
+	}
+
+	j.logger.Info("client notified about blocked message",
+		zap.Stringer("client_id", msg.AuthorID), zap.Stringer("message_id", msg.ID))
+
+	return nil
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	if j.executionTimeout != time.Duration(0) {
+		return j.executionTimeout
+	}
+	return j.defaultJob.ExecutionTimeout()
+}
+
+func (j *Job) MaxAttempts() int {
+	if j.maxAttempts != 0 {
+		return j.maxAttempts
+	}
+	return j.defaultJob.MaxAttempts()
+}
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
@@ -0,0 +1,77 @@
+// Code generated by options-gen. DO NOT EDIT.
+package clientmessageblockedjob
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"go.uber.org/zap"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	msgRepo messageRepository,
+	eventStream eventStream,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.msgRepo = msgRepo
+	o.eventStream = eventStream
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithExecutionTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.executionTimeout = opt
+	}
+}
+
+func WithMaxAttempts(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxAttempts = opt
+	}
+}
+
+func WithRetryPolicy(opt outbox.RetryPolicy) OptOptionsSetter {
+	return func(o *Options) {
+		o.retryPolicy = opt
+	}
+}
+
+func WithLogger(opt *zap.Logger) OptOptionsSetter {
+	return func(o *Options) {
+		o.logger = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_eventStream(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package clientmessageblockedjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	clientmessageblockedjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessageblockedjobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-blocked/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessageblockedjobmocks.NewMockmessageRepository(ctrl)
+	eventStream := clientmessageblockedjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessageblockedjob.New(clientmessageblockedjob.NewOptions(msgRepo, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+	reqID := types.NewRequestID()
+
+	msg := messagesrepo.Message{
+		ID:                 msgID,
+		InitialRequestID:   reqID,
+		ChatID:             types.NewChatID(),
+		AuthorID:           clientID,
+		Body:               "Hello!",
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		IsBlocked:          true,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			blockedEvent, ok := ev.(*eventstream.MessageBlockedEvent)
+			require.True(t, ok)
+			assert.Equal(t, reqID, blockedEvent.RequestID)
+			assert.Equal(t, msgID, blockedEvent.MessageID)
+			return nil
+		})
+
+	// Action & assert.
+	payload, err := clientmessageblockedjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_GetMessageError(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessageblockedjobmocks.NewMockmessageRepository(ctrl)
+	eventStream := clientmessageblockedjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessageblockedjob.New(clientmessageblockedjob.NewOptions(msgRepo, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(nil, errors.New("unexpected"))
+
+	// Action & assert.
+	payload, err := clientmessageblockedjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package clientmessagesentjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=clientmessagesentjobmocks
+
+const Name = "client-message-sent"
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type problemsRepository interface {
+	GetAssignedManagerID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo          messageRepository  `option:"mandatory"  validate:"required"`
+	problemsRepo     problemsRepository `option:"mandatory"  validate:"required"`
+	eventStream      eventStream        `option:"mandatory"  validate:"required"`
+	executionTimeout time.Duration      `option:"default=0"`
+	maxAttempts      int                `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
+	logger           *zap.Logger
+}
+
+// Job notifies the client that the message passed AFC
+// and delivers it to the manager of the chat, if there is one.
+type Job struct {
+	Options
+	defaultJob outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(Name)
+	}
+
+	return &Job{
+		Options:    opts,
+		defaultJob: outbox.DefaultJob{},
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	msgID := types.MessageID{}
+	err := msgID.Scan(payload)
+	if err != nil {
+		return fmt.Errorf("failed to scan payload in <%s> job: %w", Name, err)
+	}
+
+	msg, err := j.msgRepo.GetMessageByID(ctx, msgID)
+	if err != nil {
+		return fmt.Errorf("failed to get message by id in <%s> job: %w", Name, err)
+	}
+
+	// The manager is resolved before the first publish, so the retries can't notify the client twice.
+	managerID, err := j.problemsRepo.GetAssignedManagerID(ctx, msg.ChatID)
+	if err != nil {
+		if !errors.Is(err, problemsrepo.ErrProblemNotFound) {
+			return fmt.Errorf("failed to get assigned manager in <%s> job: %w", Name, err)
+		}
+		managerID = types.UserIDNil
+	}
+
+	err = j.eventStream.Publish(ctx, msg.AuthorID, eventstream.NewMessageSentEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ID,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to publish message sent event in <%s> job: %w", Name, err)
+	}
+
+	if managerID.IsZero() {
+		// The manager will get the message with the chat history once assigned.
+		j.logger.Debug("no manager assigned to chat", zap.Stringer("chat_id", msg.ChatID))
+		return nil
+	}
+
+	// The client is already notified, the job is not retried for the sake of the manager notification.
+	// The manager gets the message with the chat history anyway.
+	err = j.eventStream.Publish(ctx, managerID, eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		msg.ID,
+		msg.AuthorID,
+		msg.CreatedAt,
+		msg.Body,
+		msg.IsService,
+	))
+	if err != nil {
+		j.logger.Error("failed to publish new message event", zap.Error(err),
+			zap.Stringer("message_id", msg.ID), zap.Stringer("manager_id", managerID))
+		return nil
+	}
+
+	j.logger.Info("manager notified about new message",
+		zap.Stringer("manager_id", managerID), zap.Stringer("message_id", msg.ID))
+
+	return nil
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	if j.executionTimeout != time.Duration(0) {
+		return j.executionTimeout
+	}
+	return j.defaultJob.ExecutionTimeout()
+}
+
+func (j *Job) MaxAttempts() int {
+	if j.maxAttempts != 0 {
+		return j.maxAttempts
+	}
+	return j.defaultJob.MaxAttempts()
+}
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
@@ -0,0 +1,205 @@
+package clientmessagesentjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	clientmessagesentjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-sent"
+	clientmessagesentjobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/client-message-sent/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessagesentjobmocks.NewMockmessageRepository(ctrl)
+	problemsRepo := clientmessagesentjobmocks.NewMockproblemsRepository(ctrl)
+	eventStream := clientmessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(msgRepo, problemsRepo, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgID := types.NewMessageID()
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	const body = "Hello!"
+
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		InitialRequestID:    reqID,
+		ChatID:              chatID,
+		AuthorID:            clientID,
+		Body:                body,
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	problemsRepo.EXPECT().GetAssignedManagerID(gomock.Any(), chatID).Return(managerID, nil)
+
+	gomock.InOrder(
+		eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+				require.NoError(t, ev.Validate())
+
+				sentEvent, ok := ev.(*eventstream.MessageSentEvent)
+				require.True(t, ok)
+				assert.Equal(t, reqID, sentEvent.RequestID)
+				assert.Equal(t, msgID, sentEvent.MessageID)
+				return nil
+			}),
+		eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+				require.NoError(t, ev.Validate())
+
+				newMsgEvent, ok := ev.(*eventstream.NewMessageEvent)
+				require.True(t, ok)
+				assert.Equal(t, reqID, newMsgEvent.RequestID)
+				assert.Equal(t, chatID, newMsgEvent.ChatID)
+				assert.Equal(t, msgID, newMsgEvent.MessageID)
+				assert.Equal(t, clientID, newMsgEvent.AuthorID)
+				assert.Equal(t, body, newMsgEvent.MessageBody)
+				assert.False(t, newMsgEvent.IsService)
+				return nil
+			}),
+	)
+
+	// Action & assert.
+	payload, err := clientmessagesentjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_NoManagerAssigned(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessagesentjobmocks.NewMockmessageRepository(ctrl)
+	problemsRepo := clientmessagesentjobmocks.NewMockproblemsRepository(ctrl)
+	eventStream := clientmessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(msgRepo, problemsRepo, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		InitialRequestID:    types.NewRequestID(),
+		ChatID:              chatID,
+		AuthorID:            clientID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	problemsRepo.EXPECT().GetAssignedManagerID(gomock.Any(), chatID).Return(types.UserIDNil, problemsrepo.ErrProblemNotFound)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(nil)
+
+	// Action & assert.
+	payload, err := clientmessagesentjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ManagerPublishErrorAfterClientNotified(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessagesentjobmocks.NewMockmessageRepository(ctrl)
+	problemsRepo := clientmessagesentjobmocks.NewMockproblemsRepository(ctrl)
+	eventStream := clientmessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(msgRepo, problemsRepo, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:                  msgID,
+		InitialRequestID:    types.NewRequestID(),
+		ChatID:              chatID,
+		AuthorID:            clientID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}, nil)
+	problemsRepo.EXPECT().GetAssignedManagerID(gomock.Any(), chatID).Return(managerID, nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).Return(errors.New("stream is closed"))
+
+	// Action.
+	payload, err := clientmessagesentjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	// The job must not be retried, otherwise the client gets the message sent event again.
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_GetManagerErrorBeforeClientNotified(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := clientmessagesentjobmocks.NewMockmessageRepository(ctrl)
+	problemsRepo := clientmessagesentjobmocks.NewMockproblemsRepository(ctrl)
+	eventStream := clientmessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(msgRepo, problemsRepo, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:       msgID,
+		ChatID:   chatID,
+		AuthorID: types.NewUserID(),
+	}, nil)
+
+	errExpected := errors.New("db is down")
+	problemsRepo.EXPECT().GetAssignedManagerID(gomock.Any(), chatID).Return(types.UserIDNil, errExpected)
+
+	// Action.
+	payload, err := clientmessagesentjob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	// Nothing is published yet, so the job can be safely retried.
+	require.ErrorIs(t, err, errExpected)
+}
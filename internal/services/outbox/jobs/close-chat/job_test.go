@@ -0,0 +1,160 @@
+package closechatjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	closechatjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat"
+	closechatjobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := closechatjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := closechatjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := closechatjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := closechatjobmocks.NewMockeventStream(ctrl)
+	job, err := closechatjob.New(closechatjob.NewOptions(msgRepo, chatsRepo, managerLoad, eventStream))
+	require.NoError(t, err)
+
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	msgReqID := types.NewRequestID()
+	resolveReqID := types.NewRequestID()
+	const body = "Your question has been marked as resolved."
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:                 msgID,
+		InitialRequestID:   msgReqID,
+		ChatID:             chatID,
+		Body:               body,
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		IsService:          true,
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			newMsgEvent, ok := ev.(*eventstream.NewMessageEvent)
+			require.True(t, ok)
+			assert.Equal(t, msgReqID, newMsgEvent.RequestID)
+			assert.Equal(t, chatID, newMsgEvent.ChatID)
+			assert.Equal(t, msgID, newMsgEvent.MessageID)
+			assert.Equal(t, body, newMsgEvent.MessageBody)
+			assert.True(t, newMsgEvent.IsService)
+			return nil
+		})
+
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			chatClosedEvent, ok := ev.(*eventstream.ChatClosedEvent)
+			require.True(t, ok)
+			assert.Equal(t, resolveReqID, chatClosedEvent.RequestID)
+			assert.Equal(t, chatID, chatClosedEvent.ChatID)
+			assert.True(t, chatClosedEvent.CanTakeMoreProblems)
+			return nil
+		})
+
+	// Action & assert.
+	payload, err := closechatjob.MarshalPayload(resolveReqID, msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_PublishError(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := closechatjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := closechatjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := closechatjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := closechatjobmocks.NewMockeventStream(ctrl)
+	job, err := closechatjob.New(closechatjob.NewOptions(msgRepo, chatsRepo, managerLoad, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{ID: msgID, ChatID: chatID}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(types.NewUserID(), nil)
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(false, nil)
+
+	errExpected := errors.New("stream is closed")
+	eventStream.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(errExpected)
+
+	// Action.
+	payload, err := closechatjob.MarshalPayload(types.NewRequestID(), msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(context.Background(), payload)
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
+
+func TestJob_Handle_ManagerPublishErrorAfterClientNotified(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := closechatjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := closechatjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := closechatjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := closechatjobmocks.NewMockeventStream(ctrl)
+	job, err := closechatjob.New(closechatjob.NewOptions(msgRepo, chatsRepo, managerLoad, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:               msgID,
+		InitialRequestID: types.NewRequestID(),
+		ChatID:           chatID,
+		Body:             "Your question has been marked as resolved.",
+		CreatedAt:        time.Now(),
+		IsService:        true,
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).Return(errors.New("stream is closed"))
+
+	// Action.
+	payload, err := closechatjob.MarshalPayload(types.NewRequestID(), msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(context.Background(), payload)
+
+	// Assert.
+	// The job must not be retried, otherwise the client gets the resolved message again.
+	require.NoError(t, err)
+}
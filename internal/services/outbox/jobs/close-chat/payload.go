@@ -0,0 +1,45 @@
+package closechatjob
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/internal/validator"
+)
+
+type payload struct {
+	RequestID types.RequestID `json:"requestId" validate:"required"`
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+	ManagerID types.UserID    `json:"managerId" validate:"required"`
+}
+
+// MarshalPayload builds the job payload from the resolve problem request
+// and the service message created for the client.
+func MarshalPayload(requestID types.RequestID, messageID types.MessageID, managerID types.UserID) (string, error) {
+	p := payload{
+		RequestID: requestID,
+		MessageID: messageID,
+		ManagerID: managerID,
+	}
+	if err := validator.Validator.Struct(p); err != nil {
+		return "", fmt.Errorf("validate payload: %v", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal payload: %v", err)
+	}
+	return string(data), nil
+}
+
+func unmarshalPayload(data string) (payload, error) {
+	var p payload
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return payload{}, fmt.Errorf("unmarshal payload: %v", err)
+	}
+	if err := validator.Validator.Struct(p); err != nil {
+		return payload{}, fmt.Errorf("validate payload: %v", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,37 @@
+package closechatjob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	closechatjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		p, err := closechatjob.MarshalPayload(types.NewRequestID(), types.NewMessageID(), types.NewUserID())
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+	})
+
+	t.Run("no request", func(t *testing.T) {
+		p, err := closechatjob.MarshalPayload(types.RequestIDNil, types.NewMessageID(), types.NewUserID())
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("no message", func(t *testing.T) {
+		p, err := closechatjob.MarshalPayload(types.NewRequestID(), types.MessageIDNil, types.NewUserID())
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("no manager", func(t *testing.T) {
+		p, err := closechatjob.MarshalPayload(types.NewRequestID(), types.NewMessageID(), types.UserIDNil)
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+}
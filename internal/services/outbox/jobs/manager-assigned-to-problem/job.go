@@ -0,0 +1,149 @@
+package managerassignedtoproblemjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=managerassignedtoproblemjobmocks
+
+const Name = "manager-assigned-to-problem"
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type chatsRepository interface {
+	GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type managerLoadService interface {
+	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo          messageRepository  `option:"mandatory"  validate:"required"`
+	chatsRepo        chatsRepository    `option:"mandatory"  validate:"required"`
+	managerLoad      managerLoadService `option:"mandatory"  validate:"required"`
+	eventStream      eventStream        `option:"mandatory"  validate:"required"`
+	executionTimeout time.Duration      `option:"default=0"`
+	maxAttempts      int                `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
+	logger           *zap.Logger
+}
+
+// Job notifies the client about the manager assigned to the problem
+// and the manager about the new chat.
+type Job struct {
+	Options
+	defaultJob outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(Name)
+	}
+
+	return &Job{
+		Options:    opts,
+		defaultJob: outbox.DefaultJob{},
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	p, err := unmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload in <%s> job: %w", Name, err)
+	}
+
+	msg, err := j.msgRepo.GetMessageByID(ctx, p.MessageID)
+	if err != nil {
+		return fmt.Errorf("failed to get message by id in <%s> job: %w", Name, err)
+	}
+
+	clientID, err := j.chatsRepo.GetClientID(ctx, msg.ChatID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat client in <%s> job: %w", Name, err)
+	}
+
+	canTakeMoreProblems, err := j.managerLoad.CanManagerTakeProblem(ctx, p.ManagerID)
+	if err != nil {
+		return fmt.Errorf("failed to check manager load in <%s> job: %w", Name, err)
+	}
+
+	err = j.eventStream.Publish(ctx, clientID, eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		msg.ID,
+		msg.AuthorID,
+		msg.CreatedAt,
+		msg.Body,
+		msg.IsService,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to publish new message event in <%s> job: %w", Name, err)
+	}
+
+	// The client already got the service message, so the failure is not retried.
+	// The manager sees the new chat in getChats anyway.
+	err = j.eventStream.Publish(ctx, p.ManagerID, eventstream.NewNewChatEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		clientID,
+		canTakeMoreProblems,
+	))
+	if err != nil {
+		j.logger.Error("failed to publish new chat event", zap.Error(err),
+			zap.Stringer("manager_id", p.ManagerID), zap.Stringer("chat_id", msg.ChatID))
+		return nil
+	}
+
+	j.logger.Info("manager notified about new chat",
+		zap.Stringer("manager_id", p.ManagerID), zap.Stringer("chat_id", msg.ChatID))
+
+	return nil
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	if j.executionTimeout != time.Duration(0) {
+		return j.executionTimeout
+	}
+	return j.defaultJob.ExecutionTimeout()
+}
+
+func (j *Job) MaxAttempts() int {
+	if j.maxAttempts != 0 {
+		return j.maxAttempts
+	}
+	return j.defaultJob.MaxAttempts()
+}
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
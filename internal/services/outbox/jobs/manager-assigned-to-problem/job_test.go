@@ -0,0 +1,181 @@
+package managerassignedtoproblemjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	managerassignedtoproblemjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	managerassignedtoproblemjobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managerassignedtoproblemjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := managerassignedtoproblemjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := managerassignedtoproblemjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := managerassignedtoproblemjobmocks.NewMockeventStream(ctrl)
+	job, err := managerassignedtoproblemjob.New(managerassignedtoproblemjob.NewOptions(
+		msgRepo, chatsRepo, managerLoad, eventStream))
+	require.NoError(t, err)
+
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	reqID := types.NewRequestID()
+	const body = "Manager will answer you soon"
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:                 msgID,
+		InitialRequestID:   reqID,
+		ChatID:             chatID,
+		Body:               body,
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		IsService:          true,
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			newMsgEvent, ok := ev.(*eventstream.NewMessageEvent)
+			require.True(t, ok)
+			assert.Equal(t, reqID, newMsgEvent.RequestID)
+			assert.Equal(t, chatID, newMsgEvent.ChatID)
+			assert.Equal(t, msgID, newMsgEvent.MessageID)
+			assert.Equal(t, body, newMsgEvent.MessageBody)
+			assert.True(t, newMsgEvent.IsService)
+			return nil
+		})
+
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			newChatEvent, ok := ev.(*eventstream.NewChatEvent)
+			require.True(t, ok)
+			assert.Equal(t, reqID, newChatEvent.RequestID)
+			assert.Equal(t, chatID, newChatEvent.ChatID)
+			assert.Equal(t, clientID, newChatEvent.ClientID)
+			assert.True(t, newChatEvent.CanTakeMoreProblems)
+			return nil
+		})
+
+	// Action & assert.
+	payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_InvalidPayload(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	job, err := managerassignedtoproblemjob.New(managerassignedtoproblemjob.NewOptions(
+		managerassignedtoproblemjobmocks.NewMockmessageRepository(ctrl),
+		managerassignedtoproblemjobmocks.NewMockchatsRepository(ctrl),
+		managerassignedtoproblemjobmocks.NewMockmanagerLoadService(ctrl),
+		managerassignedtoproblemjobmocks.NewMockeventStream(ctrl),
+	))
+	require.NoError(t, err)
+
+	// Action.
+	err = job.Handle(context.Background(), types.NewMessageID().String())
+
+	// Assert.
+	require.Error(t, err)
+}
+
+func TestJob_Handle_LoadError(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managerassignedtoproblemjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := managerassignedtoproblemjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := managerassignedtoproblemjobmocks.NewMockmanagerLoadService(ctrl)
+	job, err := managerassignedtoproblemjob.New(managerassignedtoproblemjob.NewOptions(
+		msgRepo, chatsRepo, managerLoad, managerassignedtoproblemjobmocks.NewMockeventStream(ctrl)))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{ID: msgID, ChatID: chatID}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(types.NewUserID(), nil)
+
+	errExpected := errors.New("db is down")
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(false, errExpected)
+
+	// Action.
+	payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(context.Background(), payload)
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
+
+func TestJob_Handle_ManagerPublishErrorAfterClientNotified(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managerassignedtoproblemjobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := managerassignedtoproblemjobmocks.NewMockchatsRepository(ctrl)
+	managerLoad := managerassignedtoproblemjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := managerassignedtoproblemjobmocks.NewMockeventStream(ctrl)
+	job, err := managerassignedtoproblemjob.New(managerassignedtoproblemjob.NewOptions(
+		msgRepo, chatsRepo, managerLoad, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:               msgID,
+		InitialRequestID: types.NewRequestID(),
+		ChatID:           chatID,
+		Body:             "Manager will answer you soon",
+		CreatedAt:        time.Now(),
+		IsService:        true,
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	managerLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).Return(errors.New("stream is closed"))
+
+	// Action.
+	payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID)
+	require.NoError(t, err)
+
+	err = job.Handle(context.Background(), payload)
+
+	// Assert.
+	// The job must not be retried, otherwise the client gets the service message again.
+	require.NoError(t, err)
+}
@@ -0,0 +1,31 @@
+package managerassignedtoproblemjob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	managerassignedtoproblemjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		p, err := managerassignedtoproblemjob.MarshalPayload(types.NewMessageID(), types.NewUserID())
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+	})
+
+	t.Run("no message", func(t *testing.T) {
+		p, err := managerassignedtoproblemjob.MarshalPayload(types.MessageIDNil, types.NewUserID())
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("no manager", func(t *testing.T) {
+		p, err := managerassignedtoproblemjob.MarshalPayload(types.NewMessageID(), types.UserIDNil)
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+}
@@ -0,0 +1,89 @@
+package prunejobattemptsjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=prunejobattemptsjobmocks
+
+const Name = "prune-job-attempts"
+
+type jobAttemptsRepository interface {
+	DeleteJobAttemptsBefore(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	jobAttemptsRepo jobAttemptsRepository `option:"mandatory" validate:"required"`
+	// retention is how long the history of the job attempts is kept.
+	retention        time.Duration `option:"mandatory" validate:"min=1h"`
+	executionTimeout time.Duration `option:"default=0"`
+	maxAttempts      int           `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
+	logger           *zap.Logger
+}
+
+// Job deletes the job attempts older than the retention.
+// It is meant to be registered as the periodic one, so only one replica prunes at a time.
+type Job struct {
+	Options
+	defaultJob outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(Name)
+	}
+
+	return &Job{
+		Options:    opts,
+		defaultJob: outbox.DefaultJob{},
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, _ string) error {
+	deleted, err := j.jobAttemptsRepo.DeleteJobAttemptsBefore(ctx, time.Now().Add(-j.retention))
+	if err != nil {
+		return fmt.Errorf("failed to delete job attempts in <%s> job: %w", Name, err)
+	}
+
+	if deleted > 0 {
+		j.logger.Info("job attempts pruned", zap.Int("deleted", deleted))
+	}
+	return nil
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	if j.executionTimeout != time.Duration(0) {
+		return j.executionTimeout
+	}
+	return j.defaultJob.ExecutionTimeout()
+}
+
+func (j *Job) MaxAttempts() int {
+	if j.maxAttempts != 0 {
+		return j.maxAttempts
+	}
+	return j.defaultJob.MaxAttempts()
+}
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
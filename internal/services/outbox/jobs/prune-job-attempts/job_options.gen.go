@@ -0,0 +1,77 @@
+// Code generated by options-gen. DO NOT EDIT.
+package prunejobattemptsjob
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"go.uber.org/zap"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	jobAttemptsRepo jobAttemptsRepository,
+	retention time.Duration,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.jobAttemptsRepo = jobAttemptsRepo
+	o.retention = retention
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithExecutionTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.executionTimeout = opt
+	}
+}
+
+func WithMaxAttempts(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxAttempts = opt
+	}
+}
+
+func WithRetryPolicy(opt outbox.RetryPolicy) OptOptionsSetter {
+	return func(o *Options) {
+		o.retryPolicy = opt
+	}
+}
+
+func WithLogger(opt *zap.Logger) OptOptionsSetter {
+	return func(o *Options) {
+		o.logger = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("jobAttemptsRepo", _validate_Options_jobAttemptsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retention", _validate_Options_retention(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_jobAttemptsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.jobAttemptsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `jobAttemptsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retention(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retention, "min=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retention` did not pass the test: %w", err)
+	}
+	return nil
+}
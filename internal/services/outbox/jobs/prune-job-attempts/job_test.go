@@ -0,0 +1,66 @@
+package prunejobattemptsjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prunejobattemptsjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/prune-job-attempts"
+	prunejobattemptsjobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/prune-job-attempts/mocks"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const retention = 24 * time.Hour
+
+	repo := prunejobattemptsjobmocks.NewMockjobAttemptsRepository(ctrl)
+	job, err := prunejobattemptsjob.New(prunejobattemptsjob.NewOptions(repo, retention))
+	require.NoError(t, err)
+
+	start := time.Now()
+	repo.EXPECT().DeleteJobAttemptsBefore(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, cutoff time.Time) (int, error) {
+			assert.WithinRange(t, cutoff, start.Add(-retention), time.Now().Add(-retention))
+			return 3, nil
+		})
+
+	// Action & assert.
+	err = job.Handle(context.Background(), `{"scheduledAt":"2023-10-01T00:00:00Z"}`)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_RepoError(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := prunejobattemptsjobmocks.NewMockjobAttemptsRepository(ctrl)
+	job, err := prunejobattemptsjob.New(prunejobattemptsjob.NewOptions(repo, time.Hour))
+	require.NoError(t, err)
+
+	errExpected := errors.New("unexpected")
+	repo.EXPECT().DeleteJobAttemptsBefore(gomock.Any(), gomock.Any()).Return(0, errExpected)
+
+	// Action.
+	err = job.Handle(context.Background(), "")
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
+
+func TestNew_InvalidRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := prunejobattemptsjob.New(prunejobattemptsjob.NewOptions(
+		prunejobattemptsjobmocks.NewMockjobAttemptsRepository(ctrl), time.Minute))
+	require.Error(t, err)
+}
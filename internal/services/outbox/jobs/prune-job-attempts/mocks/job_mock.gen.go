@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+
+// Package prunejobattemptsjobmocks is a generated GoMock package.
+package prunejobattemptsjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockjobAttemptsRepository is a mock of jobAttemptsRepository interface.
+type MockjobAttemptsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobAttemptsRepositoryMockRecorder
+}
+
+// MockjobAttemptsRepositoryMockRecorder is the mock recorder for MockjobAttemptsRepository.
+type MockjobAttemptsRepositoryMockRecorder struct {
+	mock *MockjobAttemptsRepository
+}
+
+// NewMockjobAttemptsRepository creates a new mock instance.
+func NewMockjobAttemptsRepository(ctrl *gomock.Controller) *MockjobAttemptsRepository {
+	mock := &MockjobAttemptsRepository{ctrl: ctrl}
+	mock.recorder = &MockjobAttemptsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobAttemptsRepository) EXPECT() *MockjobAttemptsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteJobAttemptsBefore mocks base method.
+func (m *MockjobAttemptsRepository) DeleteJobAttemptsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobAttemptsBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobAttemptsBefore indicates an expected call of DeleteJobAttemptsBefore.
+func (mr *MockjobAttemptsRepositoryMockRecorder) DeleteJobAttemptsBefore(ctx, cutoff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobAttemptsBefore", reflect.TypeOf((*MockjobAttemptsRepository)(nil).DeleteJobAttemptsBefore), ctx, cutoff)
+}
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
 	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
 	"github.com/keepcalmist/chat-service/internal/services/outbox"
 	"github.com/keepcalmist/chat-service/internal/types"
@@ -25,12 +26,18 @@ type messageRepository interface {
 	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 }
 
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
 	msgRepo          messageRepository `option:"mandatory"  validate:"required"`
 	producer         messageProducer   `option:"mandatory"  validate:"required"`
+	eventStream      eventStream       `option:"mandatory"  validate:"required"`
 	executionTimeout time.Duration     `option:"default=0"`
 	maxAttempts      int               `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
 	logger           *zap.Logger
 }
 
@@ -59,11 +66,11 @@ func (j *Job) Name() string {
 }
 
 func (j *Job) Handle(ctx context.Context, payload string) error {
-	msgID := types.MessageID{}
-	err := msgID.Scan(payload)
+	p, err := UnmarshalPayload(payload)
 	if err != nil {
-		return fmt.Errorf("failed to scan payload in <%s> job: %w", Name, err)
+		return fmt.Errorf("failed to unmarshal payload in <%s> job: %w", Name, err)
 	}
+	msgID := p.MessageID
 
 	j.logger.Info("handling message", zap.String("message_id", msgID.String()))
 
@@ -88,9 +95,29 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 
 	j.logger.Info("message produced", zap.Stringer("message", msgToProduce))
 
+	// The message is already produced, the job is not retried for the sake of the notification.
+	// The client gets the message with the chat history anyway.
+	if err := j.eventStream.Publish(ctx, msg.AuthorID, newMessageEvent(msg)); err != nil {
+		j.logger.Error("failed to publish new message event", zap.Error(err),
+			zap.Stringer("message_id", msg.ID), zap.Stringer("client_id", msg.AuthorID))
+	}
+
 	return nil
 }
 
+func newMessageEvent(msg *messagesrepo.Message) *eventstream.NewMessageEvent {
+	return eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		msg.ID,
+		msg.AuthorID,
+		msg.CreatedAt,
+		msg.Body,
+		msg.IsService,
+	)
+}
+
 func (j *Job) ExecutionTimeout() time.Duration {
 	if j.executionTimeout != time.Duration(0) {
 		return j.executionTimeout
@@ -104,3 +131,10 @@ func (j *Job) MaxAttempts() int {
 	}
 	return j.defaultJob.MaxAttempts()
 }
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
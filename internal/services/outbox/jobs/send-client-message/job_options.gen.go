@@ -7,6 +7,7 @@ import (
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +16,7 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	msgRepo messageRepository,
 	producer messageProducer,
+	eventStream eventStream,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -23,6 +25,7 @@ func NewOptions(
 
 	o.msgRepo = msgRepo
 	o.producer = producer
+	o.eventStream = eventStream
 
 	for _, opt := range options {
 		opt(&o)
@@ -42,6 +45,12 @@ func WithMaxAttempts(opt int) OptOptionsSetter {
 	}
 }
 
+func WithRetryPolicy(opt outbox.RetryPolicy) OptOptionsSetter {
+	return func(o *Options) {
+		o.retryPolicy = opt
+	}
+}
+
 func WithLogger(opt *zap.Logger) OptOptionsSetter {
 	return func(o *Options) {
 		o.logger = opt
@@ -52,6 +61,7 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("producer", _validate_Options_producer(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
 	return errs.AsError()
 }
 
@@ -68,3 +78,10 @@ func _validate_Options_producer(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_eventStream(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
+	}
+	return nil
+}
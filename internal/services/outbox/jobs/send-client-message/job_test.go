@@ -2,13 +2,16 @@ package sendclientmessagejob_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
 	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
 	sendclientmessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-client-message"
 	sendclientmessagejobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-client-message/mocks"
@@ -24,16 +27,19 @@ func TestJob_Handle(t *testing.T) {
 
 	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
 	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
-	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(msgRepo, msgProducer))
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(msgRepo, msgProducer, eventStream))
 	require.NoError(t, err)
 
 	clientID := types.NewUserID()
 	msgID := types.NewMessageID()
+	reqID := types.NewRequestID()
 	chatID := types.NewChatID()
 	const body = "Hello!"
 
 	msg := messagesrepo.Message{
 		ID:                  msgID,
+		InitialRequestID:    reqID,
 		ChatID:              chatID,
 		AuthorID:            clientID,
 		Body:                body,
@@ -52,6 +58,21 @@ func TestJob_Handle(t *testing.T) {
 		FromClient: true,
 	}).Return(nil)
 
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			newMsgEvent, ok := ev.(*eventstream.NewMessageEvent)
+			require.True(t, ok)
+			assert.Equal(t, reqID, newMsgEvent.RequestID)
+			assert.Equal(t, chatID, newMsgEvent.ChatID)
+			assert.Equal(t, msgID, newMsgEvent.MessageID)
+			assert.Equal(t, clientID, newMsgEvent.AuthorID)
+			assert.Equal(t, body, newMsgEvent.MessageBody)
+			assert.False(t, newMsgEvent.IsService)
+			return nil
+		})
+
 	// Action & assert.
 	payload, err := sendclientmessagejob.MarshalPayload(msgID)
 	require.NoError(t, err)
@@ -59,3 +80,74 @@ func TestJob_Handle(t *testing.T) {
 	err = job.Handle(ctx, payload)
 	require.NoError(t, err)
 }
+
+func TestJob_Handle_PublishErrorAfterProduce(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(msgRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	clientID := types.NewUserID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:                 msgID,
+		InitialRequestID:   types.NewRequestID(),
+		ChatID:             types.NewChatID(),
+		AuthorID:           clientID,
+		Body:               "Hello!",
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+	}, nil)
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(errors.New("stream is closed"))
+
+	// Action.
+	payload, err := sendclientmessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	// The job must not be retried, otherwise the message is produced again.
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ProduceError(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(msgRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:       msgID,
+		ChatID:   types.NewChatID(),
+		AuthorID: types.NewUserID(),
+	}, nil)
+
+	errExpected := errors.New("kafka is down")
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(errExpected)
+
+	// Action.
+	payload, err := sendclientmessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
@@ -3,15 +3,44 @@ package sendclientmessagejob
 import (
 	"errors"
 
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
 var ErrInvalidMessageID = errors.New("invalid message id")
 
+type Payload struct {
+	MessageID types.MessageID `json:"messageId"`
+}
+
+var payloadCodec = outbox.PayloadCodec[Payload]{
+	Version: 1,
+	// Before the versioning the payload was the bare message ID.
+	Legacy: func(payload string) (Payload, error) {
+		var msgID types.MessageID
+		if err := msgID.Scan(payload); err != nil {
+			return Payload{}, err
+		}
+		return Payload{MessageID: msgID}, nil
+	},
+}
+
 func MarshalPayload(messageID types.MessageID) (string, error) {
 	if messageID == types.MessageIDNil {
 		return "", ErrInvalidMessageID
 	}
 
-	return messageID.String(), nil
+	return payloadCodec.Marshal(Payload{MessageID: messageID})
+}
+
+func UnmarshalPayload(payload string) (Payload, error) {
+	p, err := payloadCodec.Unmarshal(payload)
+	if err != nil {
+		return Payload{}, err
+	}
+
+	if p.MessageID == types.MessageIDNil {
+		return Payload{}, ErrInvalidMessageID
+	}
+	return p, nil
 }
@@ -23,3 +23,30 @@ func TestMarshalPayload_Smoke(t *testing.T) {
 		assert.Empty(t, p)
 	})
 }
+
+func TestUnmarshalPayload(t *testing.T) {
+	msgID := types.NewMessageID()
+
+	t.Run("versioned", func(t *testing.T) {
+		payload, err := sendclientmessagejob.MarshalPayload(msgID)
+		require.NoError(t, err)
+
+		p, err := sendclientmessagejob.UnmarshalPayload(payload)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, p.MessageID)
+	})
+
+	t.Run("legacy bare message id", func(t *testing.T) {
+		p, err := sendclientmessagejob.UnmarshalPayload(msgID.String())
+		require.NoError(t, err)
+		assert.Equal(t, msgID, p.MessageID)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := sendclientmessagejob.UnmarshalPayload("not a message id")
+		require.Error(t, err)
+
+		_, err = sendclientmessagejob.UnmarshalPayload(`{"version":1,"data":{}}`)
+		require.ErrorIs(t, err, sendclientmessagejob.ErrInvalidMessageID)
+	})
+}
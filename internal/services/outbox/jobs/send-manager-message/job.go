@@ -0,0 +1,148 @@
+package sendmanagermessagejob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=sendmanagermessagejobmocks
+
+const Name = "send-manager-message"
+
+type messageProducer interface {
+	ProduceMessage(ctx context.Context, message msgproducer.Message) error
+}
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type chatsRepository interface {
+	GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo          messageRepository `option:"mandatory"  validate:"required"`
+	chatsRepo        chatsRepository   `option:"mandatory"  validate:"required"`
+	producer         messageProducer   `option:"mandatory"  validate:"required"`
+	eventStream      eventStream       `option:"mandatory"  validate:"required"`
+	executionTimeout time.Duration     `option:"default=0"`
+	maxAttempts      int               `option:"default=0"`
+	retryPolicy      outbox.RetryPolicy
+	logger           *zap.Logger
+}
+
+// Job publishes the manager message to the client through the message producer.
+type Job struct {
+	Options
+	defaultJob outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.logger == nil {
+		opts.logger = zap.L().Named(Name)
+	}
+
+	return &Job{
+		Options:    opts,
+		defaultJob: outbox.DefaultJob{},
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	msgID := types.MessageID{}
+	err := msgID.Scan(payload)
+	if err != nil {
+		return fmt.Errorf("failed to scan payload in <%s> job: %w", Name, err)
+	}
+
+	msg, err := j.msgRepo.GetMessageByID(ctx, msgID)
+	if err != nil {
+		return fmt.Errorf("failed to get message by id in <%s> job: %w", Name, err)
+	}
+
+	// The client is resolved before producing, so the retries can't produce the message twice.
+	clientID, err := j.chatsRepo.GetClientID(ctx, msg.ChatID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat client in <%s> job: %w", Name, err)
+	}
+
+	msgToProduce := msgproducer.Message{
+		ID:         msg.ID,
+		ChatID:     msg.ChatID,
+		Body:       msg.Body,
+		FromClient: false,
+	}
+
+	err = j.producer.ProduceMessage(ctx, msgToProduce)
+	if err != nil {
+		return fmt.Errorf("failed to produce message in <%s> job: %w", Name, err)
+	}
+
+	j.logger.Info("message produced", zap.Stringer("message", msgToProduce))
+
+	// The message is already produced, the job is not retried for the sake of the notification.
+	// The client gets the message with the chat history anyway.
+	if err := j.eventStream.Publish(ctx, clientID, newMessageEvent(msg)); err != nil {
+		j.logger.Error("failed to publish new message event", zap.Error(err),
+			zap.Stringer("message_id", msg.ID), zap.Stringer("client_id", clientID))
+	}
+
+	return nil
+}
+
+func newMessageEvent(msg *messagesrepo.Message) *eventstream.NewMessageEvent {
+	return eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		msg.ID,
+		msg.AuthorID,
+		msg.CreatedAt,
+		msg.Body,
+		msg.IsService,
+	)
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	if j.executionTimeout != time.Duration(0) {
+		return j.executionTimeout
+	}
+	return j.defaultJob.ExecutionTimeout()
+}
+
+func (j *Job) MaxAttempts() int {
+	if j.maxAttempts != 0 {
+		return j.maxAttempts
+	}
+	return j.defaultJob.MaxAttempts()
+}
+
+func (j *Job) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return j.defaultJob.RetryPolicy()
+}
@@ -0,0 +1,196 @@
+package sendmanagermessagejob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	msgproducer "github.com/keepcalmist/chat-service/internal/services/msg-producer"
+	sendmanagermessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-manager-message"
+	sendmanagermessagejobmocks "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-manager-message/mocks"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgRepo, chatsRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	const body = "Hello, how can I help you?"
+
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		InitialRequestID:    reqID,
+		ChatID:              chatID,
+		AuthorID:            managerID,
+		Body:                body,
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		IsBlocked:           false,
+		IsService:           false,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:         msgID,
+		ChatID:     chatID,
+		Body:       body,
+		FromClient: false,
+	}).Return(nil)
+
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.UserID, ev eventstream.Event) error {
+			require.NoError(t, ev.Validate())
+
+			newMsgEvent, ok := ev.(*eventstream.NewMessageEvent)
+			require.True(t, ok)
+			assert.Equal(t, reqID, newMsgEvent.RequestID)
+			assert.Equal(t, chatID, newMsgEvent.ChatID)
+			assert.Equal(t, msgID, newMsgEvent.MessageID)
+			assert.Equal(t, managerID, newMsgEvent.AuthorID)
+			assert.Equal(t, body, newMsgEvent.MessageBody)
+			assert.False(t, newMsgEvent.IsService)
+			return nil
+		})
+
+	// Action & assert.
+	payload, err := sendmanagermessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ProduceError(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgRepo, chatsRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:     msgID,
+		ChatID: chatID,
+		Body:   "Hello!",
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(types.NewUserID(), nil)
+
+	errExpected := errors.New("kafka is down")
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(errExpected)
+
+	// Action.
+	payload, err := sendmanagermessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
+
+func TestJob_Handle_PublishErrorAfterProduce(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgRepo, chatsRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:               msgID,
+		InitialRequestID: types.NewRequestID(),
+		ChatID:           chatID,
+		AuthorID:         types.NewUserID(),
+		Body:             "Hello!",
+		CreatedAt:        time.Now(),
+	}, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Any()).Return(errors.New("stream is closed"))
+
+	// Action.
+	payload, err := sendmanagermessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	// The job must not be retried, otherwise the message is produced again.
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_GetClientErrorBeforeProduce(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgRepo, chatsRepo, msgProducer, eventStream))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:     msgID,
+		ChatID: chatID,
+		Body:   "Hello!",
+	}, nil)
+
+	errExpected := errors.New("db is down")
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(types.UserIDNil, errExpected)
+
+	// Action.
+	payload, err := sendmanagermessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+
+	// Assert.
+	require.ErrorIs(t, err, errExpected)
+}
@@ -0,0 +1,123 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+)
+
+const (
+	metricsNamespace = "chat_service"
+	metricsSubsystem = "outbox"
+
+	collectTimeout = 3 * time.Second
+)
+
+type metrics struct {
+	reservationsInFlight prometheus.Gauge
+	handleDuration       *prometheus.HistogramVec
+	attempts             *prometheus.HistogramVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		reservationsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "reservations_in_flight",
+			Help:      "Number of jobs reserved by the workers and not processed yet.",
+		}),
+		handleDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "job_handle_duration_seconds",
+			Help:      "Duration of the job handling attempts.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"name"}),
+		attempts: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "job_attempts",
+			Help:      "Number of attempts spent on the job before it was handled or moved to the DLQ.",
+			Buckets:   []float64{1, 2, 3, 5, 10, 20, 30},
+		}, []string{"name"}),
+	}
+}
+
+func (m *metrics) register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{m.reservationsInFlight, m.handleDuration, m.attempts} {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	queueDepthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "queue_depth"),
+		"Number of jobs in the queue, including the reserved and postponed ones.",
+		[]string{"name"}, nil,
+	)
+	oldestPendingJobAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "oldest_pending_job_age_seconds"),
+		"How long the oldest available job waits to be handled.",
+		nil, nil,
+	)
+	failedJobsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "failed_jobs"),
+		"Number of jobs in the DLQ.",
+		nil, nil,
+	)
+)
+
+// queueCollector gathers the queue state from the database on every scrape.
+type queueCollector struct {
+	r      jobsRepository
+	logger *zap.Logger
+}
+
+func (c *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+	ch <- oldestPendingJobAgeDesc
+	ch <- failedJobsDesc
+}
+
+func (c *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	depths, err := c.r.GetQueueDepths(ctx)
+	if err != nil {
+		c.logger.Error("failed to collect queue depths", zap.Error(err))
+		ch <- prometheus.NewInvalidMetric(queueDepthDesc, err)
+	}
+	for _, d := range depths {
+		ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(d.Count), d.Name)
+	}
+
+	availableAt, err := c.r.OldestPendingJobAvailableAt(ctx)
+	if err != nil && !errors.Is(err, jobsrepo.ErrNoJobs) {
+		c.logger.Error("failed to collect oldest pending job age", zap.Error(err))
+		ch <- prometheus.NewInvalidMetric(oldestPendingJobAgeDesc, err)
+	} else {
+		var age time.Duration
+		if err == nil {
+			age = time.Since(availableAt)
+		}
+		ch <- prometheus.MustNewConstMetric(oldestPendingJobAgeDesc, prometheus.GaugeValue, age.Seconds())
+	}
+
+	failed, err := c.r.CountFailedJobs(ctx)
+	if err != nil {
+		c.logger.Error("failed to collect failed jobs count", zap.Error(err))
+		ch <- prometheus.NewInvalidMetric(failedJobsDesc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(failedJobsDesc, prometheus.GaugeValue, float64(failed))
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidPayload        = errors.New("invalid payload")
+	ErrUnknownPayloadVersion = errors.New("unknown payload version")
+)
+
+// PayloadUpgrade converts the payload data of some version to the next one.
+type PayloadUpgrade func(data json.RawMessage) (json.RawMessage, error)
+
+// PayloadCodec encodes the job payload T to JSON along with the version of its schema:
+//
+//	{"version":2,"data":{...}}
+//
+// The payloads of older versions are upgraded step by step before decoding,
+// so the jobs put before the deployment are still handled after it.
+type PayloadCodec[T any] struct {
+	// Version is the current version of the payload schema, starting from 1.
+	Version int
+
+	// Upgrades[v] converts the data of version v to version v+1.
+	Upgrades map[int]PayloadUpgrade
+
+	// Legacy decodes the payloads put before the job migrated to the codec. Optional.
+	Legacy func(payload string) (T, error)
+}
+
+type versionedPayload struct {
+	Version int             `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (c PayloadCodec[T]) Marshal(v T) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal payload data: %v", err)
+	}
+
+	payload, err := json.Marshal(versionedPayload{Version: c.Version, Data: data})
+	if err != nil {
+		return "", fmt.Errorf("marshal payload: %v", err)
+	}
+	return string(payload), nil
+}
+
+func (c PayloadCodec[T]) Unmarshal(payload string) (T, error) {
+	var v T
+
+	var p versionedPayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil || p.Version == 0 {
+		if c.Legacy != nil {
+			return c.Legacy(payload)
+		}
+		return v, fmt.Errorf("%w: no version", ErrInvalidPayload)
+	}
+
+	if p.Version < 1 || p.Version > c.Version {
+		return v, fmt.Errorf("%w: %d", ErrUnknownPayloadVersion, p.Version)
+	}
+
+	data := p.Data
+	for version := p.Version; version < c.Version; version++ {
+		upgrade, ok := c.Upgrades[version]
+		if !ok {
+			return v, fmt.Errorf("%w: no upgrade from %d", ErrUnknownPayloadVersion, version)
+		}
+
+		var err error
+		if data, err = upgrade(data); err != nil {
+			return v, fmt.Errorf("upgrade payload from version %d: %v", version, err)
+		}
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	return v, nil
+}
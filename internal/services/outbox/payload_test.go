@@ -0,0 +1,89 @@
+package outbox_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+type payloadV3 struct {
+	ChatID  string `json:"chatId"`
+	Retries int    `json:"retries"`
+}
+
+var codecV3 = outbox.PayloadCodec[payloadV3]{
+	Version: 3,
+	Upgrades: map[int]outbox.PayloadUpgrade{
+		// v1 is the bare chat ID string.
+		1: func(data json.RawMessage) (json.RawMessage, error) {
+			var chatID string
+			if err := json.Unmarshal(data, &chatID); err != nil {
+				return nil, err
+			}
+			return json.Marshal(map[string]string{"chatId": chatID})
+		},
+		// v3 adds the retries.
+		2: func(data json.RawMessage) (json.RawMessage, error) {
+			var p map[string]any
+			if err := json.Unmarshal(data, &p); err != nil {
+				return nil, err
+			}
+			p["retries"] = 1
+			return json.Marshal(p)
+		},
+	},
+	Legacy: func(payload string) (payloadV3, error) {
+		return payloadV3{ChatID: payload}, nil
+	},
+}
+
+func TestPayloadCodec_MarshalUnmarshal(t *testing.T) {
+	p := payloadV3{ChatID: "chat", Retries: 5}
+
+	payload, err := codecV3.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"version":3,"data":{"chatId":"chat","retries":5}}`, payload)
+
+	actual, err := codecV3.Unmarshal(payload)
+	require.NoError(t, err)
+	assert.Equal(t, p, actual)
+}
+
+func TestPayloadCodec_Upgrades(t *testing.T) {
+	for version, payload := range []string{
+		`{"version":1,"data":"chat"}`,
+		`{"version":2,"data":{"chatId":"chat"}}`,
+	} {
+		t.Run("from v"+strconv.Itoa(version+1), func(t *testing.T) {
+			actual, err := codecV3.Unmarshal(payload)
+			require.NoError(t, err)
+			assert.Equal(t, payloadV3{ChatID: "chat", Retries: 1}, actual)
+		})
+	}
+}
+
+func TestPayloadCodec_Legacy(t *testing.T) {
+	actual, err := codecV3.Unmarshal("chat")
+	require.NoError(t, err)
+	assert.Equal(t, payloadV3{ChatID: "chat"}, actual)
+
+	_, err = outbox.PayloadCodec[payloadV3]{Version: 1}.Unmarshal("chat")
+	assert.ErrorIs(t, err, outbox.ErrInvalidPayload)
+}
+
+func TestPayloadCodec_UnknownVersion(t *testing.T) {
+	_, err := codecV3.Unmarshal(`{"version":4,"data":{}}`)
+	require.ErrorIs(t, err, outbox.ErrUnknownPayloadVersion)
+
+	_, err = codecV3.Unmarshal(`{"version":-1,"data":{}}`)
+	require.ErrorIs(t, err, outbox.ErrUnknownPayloadVersion)
+
+	withoutUpgrade := outbox.PayloadCodec[payloadV3]{Version: 2}
+	_, err = withoutUpgrade.Unmarshal(`{"version":1,"data":"chat"}`)
+	require.ErrorIs(t, err, outbox.ErrUnknownPayloadVersion)
+}
@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+)
+
+// periodicCheckInterval is how often the service makes sure that every periodic job has the next run scheduled.
+const periodicCheckInterval = time.Minute
+
+var (
+	ErrUnknownCatchUpPolicy = errors.New("unknown catch-up policy")
+	ErrInvalidSchedule      = errors.New("invalid schedule")
+)
+
+// Schedule returns the next run of the periodic job after the given moment.
+// It must be deterministic, so that all replicas agree on the runs.
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+type interval time.Duration
+
+// Every returns the schedule running each d. The runs are aligned to the multiples of d.
+// The non-positive d is rejected by RegisterPeriodicJob.
+func Every(d time.Duration) Schedule {
+	return interval(d)
+}
+
+func (i interval) Next(t time.Time) time.Time {
+	d := time.Duration(i)
+	return t.Truncate(d).Add(d)
+}
+
+// Cron parses the standard five-field cron expression, e.g. "0 3 * * *".
+// The descriptors like "@daily" are supported too.
+func Cron(expr string) (Schedule, error) {
+	s, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("parse cron expression %q: %v", expr, err)
+	}
+	return s, nil
+}
+
+// CatchUpPolicy defines what to do with the runs missed during the downtime.
+// The run is missed if the next one is already due when it starts.
+type CatchUpPolicy string
+
+const (
+	// CatchUpSkip drops the missed runs and waits for the next scheduled one.
+	CatchUpSkip CatchUpPolicy = "skip"
+	// CatchUpOnce runs the job once for all the missed runs.
+	CatchUpOnce CatchUpPolicy = "once"
+	// CatchUpAll runs the job for every missed run one by one.
+	CatchUpAll CatchUpPolicy = "all"
+)
+
+func ParseCatchUpPolicy(s string) (CatchUpPolicy, error) {
+	switch p := CatchUpPolicy(s); p {
+	case CatchUpSkip, CatchUpOnce, CatchUpAll:
+		return p, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownCatchUpPolicy, s)
+}
+
+type periodicJob struct {
+	schedule Schedule
+	catchUp  CatchUpPolicy
+}
+
+type periodicPayload struct {
+	ScheduledAt time.Time `json:"scheduledAt"`
+}
+
+// RegisterPeriodicJob registers the job running on the schedule.
+// Every run is a normal job, the next one is created when the previous one starts,
+// so exactly one replica of the service handles it. The runs never overlap.
+func (s *Service) RegisterPeriodicJob(job Job, schedule Schedule, catchUp CatchUpPolicy) error {
+	if _, err := ParseCatchUpPolicy(string(catchUp)); err != nil {
+		return err
+	}
+	// Otherwise every run is missed and the next one is due at once.
+	if now := time.Now(); !schedule.Next(now).After(now) {
+		return fmt.Errorf("%w: no runs after %s", ErrInvalidSchedule, now.Format(time.RFC3339))
+	}
+
+	if err := s.RegisterJob(job); err != nil {
+		return err
+	}
+	s.periodic[job.Name()] = periodicJob{schedule: schedule, catchUp: catchUp}
+
+	return nil
+}
+
+func (s *Service) MustRegisterPeriodicJob(job Job, schedule Schedule, catchUp CatchUpPolicy) {
+	if err := s.RegisterPeriodicJob(job, schedule, catchUp); err != nil {
+		panic(err)
+	}
+}
+
+// schedulePeriodicJobs creates the first runs of the periodic jobs and restores the broken chains of runs,
+// e.g. after the job row was deleted manually.
+func (s *Service) schedulePeriodicJobs(ctx context.Context) {
+	if len(s.periodic) == 0 {
+		return
+	}
+
+	for {
+		for name, p := range s.periodic {
+			if err := s.schedulePeriodicRun(ctx, name, p.schedule.Next(time.Now()), jobsrepo.Job{}); err != nil {
+				s.logger.Error("failed to schedule periodic job", zap.Error(err), zap.String("job_name", name))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(periodicCheckInterval):
+		}
+	}
+}
+
+// startPeriodicRun schedules the next run and decides whether the current one should be handled.
+func (s *Service) startPeriodicRun(ctx context.Context, reservedJob jobsrepo.Job, p periodicJob) (bool, error) {
+	var payload periodicPayload
+	if err := json.Unmarshal([]byte(reservedJob.Payload), &payload); err != nil {
+		return false, fmt.Errorf("unmarshal periodic payload: %v", err)
+	}
+
+	now := time.Now()
+	// Only the first attempt can be missed. The retries of the started run are delayed by the backoff
+	// and must not be dropped for being late.
+	missed := reservedJob.Attempts <= 1 && !p.schedule.Next(payload.ScheduledAt).After(now)
+
+	next := p.schedule.Next(now)
+	if p.catchUp == CatchUpAll {
+		next = p.schedule.Next(payload.ScheduledAt)
+	}
+
+	if err := s.schedulePeriodicRun(ctx, reservedJob.Name, next, reservedJob); err != nil {
+		return false, err
+	}
+
+	return !missed || p.catchUp != CatchUpSkip, nil
+}
+
+// schedulePeriodicRun creates the run at the given time if there are no runs besides the current one.
+func (s *Service) schedulePeriodicRun(ctx context.Context, name string, at time.Time, current jobsrepo.Job) error {
+	payload, err := json.Marshal(periodicPayload{ScheduledAt: at.UTC()})
+	if err != nil {
+		return fmt.Errorf("marshal periodic payload: %v", err)
+	}
+
+	created, err := s.r.CreateJobIfNotScheduled(ctx, name, string(payload), at, current.ID)
+	if err != nil {
+		return fmt.Errorf("create periodic run: %v", err)
+	}
+
+	if created {
+		s.logger.Info("periodic job scheduled", zap.String("job_name", name), zap.Time("at", at))
+	}
+	return nil
+}
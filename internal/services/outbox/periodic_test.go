@@ -0,0 +1,69 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+func TestEvery(t *testing.T) {
+	s := outbox.Every(10 * time.Minute)
+
+	base := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, base.Add(10*time.Minute), s.Next(base))
+	assert.Equal(t, base.Add(10*time.Minute), s.Next(base.Add(time.Second)))
+	assert.Equal(t, base.Add(20*time.Minute), s.Next(base.Add(10*time.Minute)))
+}
+
+func TestCron(t *testing.T) {
+	s, err := outbox.Cron("CRON_TZ=UTC 30 3 * * *")
+	require.NoError(t, err)
+
+	base := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2023, 10, 2, 3, 30, 0, 0, time.UTC), s.Next(base))
+
+	_, err = outbox.Cron("every day")
+	assert.Error(t, err)
+}
+
+func TestParseCatchUpPolicy(t *testing.T) {
+	for _, p := range []outbox.CatchUpPolicy{outbox.CatchUpSkip, outbox.CatchUpOnce, outbox.CatchUpAll} {
+		actual, err := outbox.ParseCatchUpPolicy(string(p))
+		require.NoError(t, err)
+		assert.Equal(t, p, actual)
+	}
+
+	_, err := outbox.ParseCatchUpPolicy("twice")
+	assert.ErrorIs(t, err, outbox.ErrUnknownCatchUpPolicy)
+}
+
+func TestRegisterPeriodicJob_InvalidSchedule(t *testing.T) {
+	svc, err := outbox.New(outbox.NewOptions(1, 1, time.Second, time.Second, nil, nil))
+	require.NoError(t, err)
+
+	for _, d := range []time.Duration{0, -time.Minute} {
+		err := svc.RegisterPeriodicJob(periodicJob{}, outbox.Every(d), outbox.CatchUpSkip)
+		assert.ErrorIs(t, err, outbox.ErrInvalidSchedule, d)
+	}
+
+	// The rejected job isn't registered, so it can be registered with the valid schedule.
+	err = svc.RegisterPeriodicJob(periodicJob{}, outbox.Every(time.Minute), outbox.CatchUpSkip)
+	assert.NoError(t, err)
+}
+
+type periodicJob struct {
+	outbox.DefaultJob
+}
+
+func (periodicJob) Name() string {
+	return "TestRegisterPeriodicJob_InvalidSchedule"
+}
+
+func (periodicJob) Handle(context.Context, string) error {
+	return nil
+}
@@ -4,24 +4,52 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
 	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
 	"github.com/keepcalmist/chat-service/internal/types"
 )
 
-const serviceName = "outbox"
+const (
+	serviceName  = "outbox"
+	minSleepTime = 10 * time.Millisecond
 
-var ErrJobAlreadyRegistered = errors.New("job already registered")
+	// cleanupTimeout limits the bookkeeping which must be done even if the service context is done,
+	// e.g. the release of the interrupted jobs.
+	cleanupTimeout = 5 * time.Second
+)
+
+var (
+	ErrJobAlreadyRegistered = errors.New("job already registered")
+	ErrJobsStuck            = errors.New("jobs are stuck")
+	ErrJobDuplicated        = errors.New("pending job with the same dedup key exists")
+)
 
 type jobsRepository interface {
 	CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
-	FindAndReserveJob(ctx context.Context, until time.Time) (jobsrepo.Job, error)
-	CreateFailedJob(ctx context.Context, name, payload, reason string) error
+	CreateOrderedJob(ctx context.Context, name, payload, orderingKey string, availableAt time.Time) (types.JobID, error)
+	CreateUniqueJob(ctx context.Context, name, payload, dedupKey string, availableAt time.Time) (types.JobID, bool, error)
+	CreateJobIfNotScheduled(ctx context.Context, name, payload string, availableAt time.Time, except types.JobID) (bool, error)
+	FindAndReserveJobs(ctx context.Context, until time.Time, limit int) ([]jobsrepo.Job, error)
+	RescheduleJob(ctx context.Context, jobID types.JobID, availableAt time.Time, lastError string) error
+	ReleaseJob(ctx context.Context, jobID types.JobID) error
+	CreateFailedJob(ctx context.Context, jobID types.JobID, name, payload, reason string, requeues int) error
+	CreateJobAttempt(ctx context.Context, attempt jobsrepo.JobAttempt) error
 	DeleteJob(ctx context.Context, jobID types.JobID) error
+	NextJobAvailableAt(ctx context.Context) (time.Time, error)
+	GetQueueDepths(ctx context.Context) ([]jobsrepo.QueueDepth, error)
+	OldestPendingJobAvailableAt(ctx context.Context) (time.Time, error)
+	CountFailedJobs(ctx context.Context) (int, error)
+}
+
+// jobsListener notifies about the new jobs, so idle workers don't wait for the next poll.
+type jobsListener interface {
+	Listen(ctx context.Context, notify func()) error
 }
 
 type transactor interface {
@@ -30,17 +58,29 @@ type transactor interface {
 
 //go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
 type Options struct {
+	maxJobAge    time.Duration `default:"5m" validate:"omitempty,min=1s"`
+	drainTimeout time.Duration `default:"30s" validate:"omitempty,max=10m"`
+
 	workers    int            `option:"mandatory" validate:"min=1,max=32"`
+	batchSize  int            `option:"mandatory" validate:"min=1,max=100"`
 	idleTime   time.Duration  `option:"mandatory" validate:"min=100ms,max=10s"`
 	reserveFor time.Duration  `option:"mandatory" validate:"min=1s,max=10m"`
 	r          jobsRepository `option:"mandatory"`
 	t          transactor     `option:"mandatory"`
+	listener   jobsListener
+	registerer prometheus.Registerer
 	logger     *zap.Logger
 }
 
 type Service struct {
 	Options
-	jobs map[string]Job
+	jobs     map[string]Job
+	periodic map[string]periodicJob
+	metrics  *metrics
+	instance string
+
+	wakeUpMu sync.Mutex
+	wakeUp   chan struct{}
 }
 
 func New(opts Options) (*Service, error) {
@@ -52,12 +92,49 @@ func New(opts Options) (*Service, error) {
 		opts.logger = zap.L().Named(serviceName)
 	}
 
+	if opts.registerer == nil {
+		opts.registerer = prometheus.NewRegistry()
+	}
+
+	m := newMetrics()
+	if err := m.register(opts.registerer); err != nil {
+		return nil, fmt.Errorf("register metrics: %v", err)
+	}
+	if err := opts.registerer.Register(&queueCollector{r: opts.r, logger: opts.logger}); err != nil {
+		return nil, fmt.Errorf("register queue collector: %v", err)
+	}
+
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+
 	return &Service{
-		Options: opts,
-		jobs:    make(map[string]Job),
+		Options:  opts,
+		jobs:     make(map[string]Job),
+		periodic: make(map[string]periodicJob),
+		metrics:  m,
+		instance: instance,
+		wakeUp:   make(chan struct{}),
 	}, nil
 }
 
+// CheckHealth returns ErrJobsStuck if the oldest pending job waits for longer than maxJobAge.
+func (s *Service) CheckHealth(ctx context.Context) error {
+	availableAt, err := s.r.OldestPendingJobAvailableAt(ctx)
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrNoJobs) {
+			return nil
+		}
+		return fmt.Errorf("get oldest pending job: %v", err)
+	}
+
+	if age := time.Since(availableAt); age > s.maxJobAge {
+		return fmt.Errorf("%w: the oldest job waits for %s", ErrJobsStuck, age.Round(time.Second))
+	}
+	return nil
+}
+
 func (s *Service) RegisterJob(job Job) error {
 	if _, ok := s.jobs[job.Name()]; ok {
 		return fmt.Errorf("%w: %s", ErrJobAlreadyRegistered, job.Name())
@@ -72,91 +149,303 @@ func (s *Service) MustRegisterJob(job Job) {
 	}
 }
 
+// Run handles the jobs until the context is done. Then it stops reserving new jobs and drains:
+// the in-flight jobs are given drainTimeout to finish, the unfinished ones are interrupted
+// and their reservations are released, so another instance picks them up at once.
 func (s *Service) Run(ctx context.Context) error {
 	wg := new(sync.WaitGroup)
-	for i := 0; i < s.workers; i++ {
+	if s.listener != nil {
 		wg.Add(1)
-
-		s.logger.Info("starting worker", zap.Int("worker_id", i))
 		go func() {
-			s.startWorker(ctx)
 			defer wg.Done()
+			s.listen(ctx)
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.schedulePeriodicJobs(ctx)
+	}()
+
+	// The workers outlive the ctx for the drain.
+	workCtx, interrupt := context.WithCancel(detached{ctx})
+	defer interrupt()
+
+	// Every reserved job is handed to the idle worker at once, so its reservation doesn't expire in the queue.
+	jobs := make(chan jobsrepo.Job)
+	idleWorkers := make(chan struct{}, s.workers)
+
+	workersWg := new(sync.WaitGroup)
+	for i := 0; i < s.workers; i++ {
+		workersWg.Add(1)
+		idleWorkers <- struct{}{}
+
+		worker := fmt.Sprintf("%s/%d", s.instance, i)
+		s.logger.Info("starting worker", zap.String("worker", worker))
+		go func() {
+			defer workersWg.Done()
+			s.startWorker(workCtx, worker, jobs, idleWorkers)
 		}()
 	}
+
+	s.reserveJobs(ctx, jobs, idleWorkers)
+	close(jobs)
+	s.drain(workersWg, interrupt)
 	wg.Wait()
 
 	return nil
 }
 
-func (s *Service) startWorker(ctx context.Context) {
+// drain waits for the workers to finish the in-flight jobs and interrupts them after drainTimeout.
+func (s *Service) drain(workers *sync.WaitGroup, interrupt context.CancelFunc) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		workers.Wait()
+	}()
+
+	s.logger.Info("draining in-flight jobs", zap.Duration("drain_timeout", s.drainTimeout))
+	select {
+	case <-done:
+		s.logger.Info("in-flight jobs drained")
+		return
+	case <-time.After(s.drainTimeout):
+	}
+
+	s.logger.Warn("drain timeout exceeded, interrupting in-flight jobs")
+	interrupt()
+	<-done
+}
+
+// reserveJobs reserves the batches of jobs for the idle workers until the context is done.
+func (s *Service) reserveJobs(ctx context.Context, jobs chan<- jobsrepo.Job, idleWorkers chan struct{}) {
 	for {
 		select {
 		case <-ctx.Done():
 			s.logger.Debug("context done")
 			return
-		default:
-			reservedJob, err := s.r.FindAndReserveJob(ctx, time.Now().Add(s.reserveFor))
-			if err != nil {
-				if errors.Is(err, jobsrepo.ErrNoJobs) {
-					s.logger.Info("sleeping", zap.Duration("idle_time", s.idleTime))
-					select {
-					case <-ctx.Done():
-						s.logger.Error("context done", zap.Error(ctx.Err()))
-						return
-					case <-time.After(s.idleTime):
-					}
-					continue
-				}
-				s.logger.Error("failed to find and reserve job", zap.Error(err))
-				continue
+		case <-idleWorkers:
+		}
+
+		limit := 1
+	acquire:
+		for limit < s.batchSize {
+			select {
+			case <-idleWorkers:
+				limit++
+			default:
+				break acquire
 			}
+		}
 
-			s.logger.Info("job found, start processing...", zap.String("job_id", reservedJob.ID.String()))
+		reserved := s.reserveBatch(ctx, limit)
+		s.metrics.reservationsInFlight.Add(float64(len(reserved)))
+		for _, j := range reserved {
+			jobs <- j
+		}
 
-			j, ok := s.jobs[reservedJob.Name]
-			if !ok {
-				err = s.CreateFailedAndDeleteMainJob(ctx, reservedJob)
-				if err != nil {
-					s.logger.Error("failed to create failed job", zap.Error(err),
-						zap.String("job_id", reservedJob.ID.String()))
-					continue
-				}
+		// Return the workers left without the job.
+		for i := len(reserved); i < limit; i++ {
+			idleWorkers <- struct{}{}
+		}
+	}
+}
 
-				continue
-			}
+// reserveBatch reserves up to limit jobs. Sleeps if there are no jobs.
+func (s *Service) reserveBatch(ctx context.Context, limit int) []jobsrepo.Job {
+	// Taken before the search so that the job created meanwhile isn't missed.
+	wakeUp := s.wakeUpChan()
 
-			if reservedJob.Attempts > j.MaxAttempts() {
-				err = s.CreateFailedAndDeleteMainJob(ctx, reservedJob)
-				if err != nil {
-					s.logger.Error("failed to create failed job", zap.Error(err),
-						zap.String("job_id", reservedJob.ID.String()))
-					continue
-				}
-				continue
+	reserved, err := s.r.FindAndReserveJobs(ctx, time.Now().Add(s.reserveFor), limit)
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrNoJobs) {
+			sleep := s.sleepTime(ctx)
+			s.logger.Info("sleeping", zap.Duration("idle_time", sleep))
+			select {
+			case <-ctx.Done():
+				s.logger.Error("context done", zap.Error(ctx.Err()))
+			case <-time.After(sleep):
+			case <-wakeUp:
 			}
+			return nil
+		}
+		s.logger.Error("failed to find and reserve jobs", zap.Error(err))
+		return nil
+	}
+
+	s.logger.Info("jobs reserved", zap.Int("count", len(reserved)))
+	return reserved
+}
+
+func (s *Service) startWorker(ctx context.Context, worker string, jobs <-chan jobsrepo.Job, idleWorkers chan<- struct{}) {
+	for reservedJob := range jobs {
+		s.processJob(ctx, worker, reservedJob)
+		if ctx.Err() != nil {
+			s.releaseJob(reservedJob)
+		}
+		s.metrics.reservationsInFlight.Dec()
+		idleWorkers <- struct{}{} // Never blocks, the capacity equals to the number of workers.
+	}
+}
+
+func (s *Service) processJob(ctx context.Context, worker string, reservedJob jobsrepo.Job) {
+	s.logger.Info("job found, start processing...", zap.String("job_id", reservedJob.ID.String()))
+
+	j, ok := s.jobs[reservedJob.Name]
+	if !ok {
+		err := s.CreateFailedAndDeleteMainJob(ctx, reservedJob, "job is not registered")
+		if err != nil {
+			s.logger.Error("failed to create failed job", zap.Error(err),
+				zap.String("job_id", reservedJob.ID.String()))
+		}
+		return
+	}
+
+	if p, ok := s.periodic[reservedJob.Name]; ok {
+		run, err := s.startPeriodicRun(ctx, reservedJob, p)
+		if err != nil {
+			// The job will be repeated when the reservation expires.
+			s.logger.Error("failed to start periodic run", zap.Error(err),
+				zap.String("job_id", reservedJob.ID.String()))
+			return
+		}
 
-			err = s.handleJob(ctx, reservedJob, j)
-			if err != nil {
-				s.logger.Error("failed to handle job", zap.Error(err), zap.String("job_id", reservedJob.ID.String()))
-				if j.MaxAttempts() < reservedJob.Attempts {
-					err = s.CreateFailedAndDeleteMainJob(ctx, reservedJob)
-					if err != nil {
-						s.logger.Error("failed to create failed job", zap.Error(err),
-							zap.String("job_id", reservedJob.ID.String()))
-						continue
-					}
-
-					continue
-				}
+		if !run {
+			if err := s.r.DeleteJob(ctx, reservedJob.ID); err != nil {
+				s.logger.Error("failed to delete missed periodic run", zap.Error(err),
+					zap.String("job_id", reservedJob.ID.String()))
+				return
 			}
+			s.logger.Info("missed periodic run skipped", zap.String("job_id", reservedJob.ID.String()))
+			return
+		}
+	}
+
+	// The previous attempt could be interrupted without the rescheduling, e.g. by the restart.
+	if reservedJob.Attempts > j.MaxAttempts() {
+		reason := reservedJob.LastError
+		if reason == "" {
+			reason = "max attempts exceeded"
+		}
+
+		err := s.CreateFailedAndDeleteMainJob(ctx, reservedJob, reason)
+		if err != nil {
+			s.logger.Error("failed to create failed job", zap.Error(err),
+				zap.String("job_id", reservedJob.ID.String()))
+		}
+		return
+	}
+
+	err := s.handleJob(ctx, worker, reservedJob, j)
+	if err == nil {
+		return
+	}
+
+	if ctx.Err() != nil {
+		// Interrupted by the drain, the job is released by the worker.
+		s.logger.Warn("job interrupted", zap.String("job_id", reservedJob.ID.String()))
+		return
+	}
+
+	s.logger.Error("failed to handle job", zap.Error(err), zap.String("job_id", reservedJob.ID.String()))
+	if reservedJob.Attempts >= j.MaxAttempts() {
+		err = s.CreateFailedAndDeleteMainJob(ctx, reservedJob, err.Error())
+		if err != nil {
+			s.logger.Error("failed to create failed job", zap.Error(err),
+				zap.String("job_id", reservedJob.ID.String()))
+		}
+		return
+	}
+
+	delay := j.RetryPolicy().Delay(reservedJob.Attempts)
+	err = s.r.RescheduleJob(ctx, reservedJob.ID, time.Now().Add(delay), err.Error())
+	if err != nil {
+		s.logger.Error("failed to reschedule job", zap.Error(err),
+			zap.String("job_id", reservedJob.ID.String()))
+		return
+	}
+
+	s.logger.Info("job rescheduled",
+		zap.String("job_id", reservedJob.ID.String()), zap.Duration("delay", delay))
+}
+
+// releaseJob releases the reservation of the job interrupted by the drain.
+// If the job was handled or rescheduled before the interruption, the release changes nothing.
+func (s *Service) releaseJob(reservedJob jobsrepo.Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	if err := s.r.ReleaseJob(ctx, reservedJob.ID); err != nil {
+		s.logger.Error("failed to release interrupted job", zap.Error(err),
+			zap.String("job_id", reservedJob.ID.String()))
+		return
+	}
+	s.logger.Info("interrupted job released", zap.String("job_id", reservedJob.ID.String()))
+}
+
+// sleepTime returns how long the idle worker waits for the next poll.
+// It is shortened if a job becomes available earlier, e.g. the postponed one.
+func (s *Service) sleepTime(ctx context.Context) time.Duration {
+	availableAt, err := s.r.NextJobAvailableAt(ctx)
+	if err != nil {
+		if !errors.Is(err, jobsrepo.ErrNoJobs) {
+			s.logger.Error("failed to get next job available at", zap.Error(err))
+		}
+		return s.idleTime
+	}
+
+	sleep := time.Until(availableAt)
+	if sleep < minSleepTime {
+		// The job is available, but the concurrent reservation of it hasn't been committed yet.
+		return minSleepTime
+	}
+	if sleep > s.idleTime {
+		return s.idleTime
+	}
+	return sleep
+}
+
+// listen wakes up the idle workers on the new jobs until the context is done.
+// The workers keep polling while the listener is reconnecting.
+func (s *Service) listen(ctx context.Context) {
+	for {
+		err := s.listener.Listen(ctx, s.wakeUpWorkers)
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("jobs listener stopped", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.idleTime):
 		}
 	}
 }
 
-func (s *Service) handleJob(ctx context.Context, reservedJob jobsrepo.Job, j Job) error {
+func (s *Service) wakeUpChan() <-chan struct{} {
+	s.wakeUpMu.Lock()
+	defer s.wakeUpMu.Unlock()
+
+	return s.wakeUp
+}
+
+func (s *Service) wakeUpWorkers() {
+	s.wakeUpMu.Lock()
+	defer s.wakeUpMu.Unlock()
+
+	close(s.wakeUp)
+	s.wakeUp = make(chan struct{})
+}
+
+func (s *Service) handleJob(ctx context.Context, worker string, reservedJob jobsrepo.Job, j Job) (err error) {
 	ctxWithCancel, cancel := context.WithTimeout(ctx, j.ExecutionTimeout())
 	defer cancel()
 
+	start := time.Now()
+	defer func() { s.recordAttempt(ctx, worker, reservedJob, start, err) }()
+
 	errChan := make(chan error, 1)
 	go func() {
 		errChan <- j.Handle(ctxWithCancel, reservedJob.Payload)
@@ -164,12 +453,13 @@ func (s *Service) handleJob(ctx context.Context, reservedJob jobsrepo.Job, j Job
 
 	select {
 	case <-ctxWithCancel.Done():
-		s.logger.Error("context done", zap.Error(ctxWithCancel.Err()))
-		return ctxWithCancel.Err()
-	case err := <-errChan:
-		if err != nil {
-			return err
-		}
+		err = ctxWithCancel.Err()
+		s.logger.Error("context done", zap.Error(err))
+	case err = <-errChan:
+	}
+	s.metrics.handleDuration.WithLabelValues(reservedJob.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return err
 	}
 
 	if err := s.r.DeleteJob(ctx, reservedJob.ID); err != nil {
@@ -177,14 +467,56 @@ func (s *Service) handleJob(ctx context.Context, reservedJob jobsrepo.Job, j Job
 		return err
 	}
 
+	s.metrics.attempts.WithLabelValues(reservedJob.Name).Observe(float64(reservedJob.Attempts))
 	s.logger.Info("job successfully handled", zap.String("job_id", reservedJob.ID.String()))
 
 	return nil
 }
 
-func (s *Service) CreateFailedAndDeleteMainJob(ctx context.Context, job jobsrepo.Job) error {
+// recordAttempt adds the attempt to the job history. The failure to record doesn't affect the job.
+func (s *Service) recordAttempt(
+	ctx context.Context,
+	worker string,
+	reservedJob jobsrepo.Job,
+	startedAt time.Time,
+	handleErr error,
+) {
+	attempt := jobsrepo.JobAttempt{
+		JobID:      reservedJob.ID,
+		JobName:    reservedJob.Name,
+		Attempt:    reservedJob.Attempts,
+		Worker:     worker,
+		StartedAt:  startedAt,
+		FinishedAt: time.Now(),
+		Outcome:    jobsrepo.JobAttemptSucceeded,
+	}
+
+	switch {
+	case handleErr == nil:
+	case ctx.Err() != nil:
+		attempt.Outcome = jobsrepo.JobAttemptInterrupted
+	case errors.Is(handleErr, context.DeadlineExceeded):
+		attempt.Outcome = jobsrepo.JobAttemptTimedOut
+	default:
+		attempt.Outcome = jobsrepo.JobAttemptFailed
+	}
+	if handleErr != nil {
+		attempt.Error = handleErr.Error()
+	}
+
+	// The attempt interrupted by the drain is recorded too.
+	ctx, cancel := context.WithTimeout(detached{ctx}, cleanupTimeout)
+	defer cancel()
+
+	if err := s.r.CreateJobAttempt(ctx, attempt); err != nil {
+		s.logger.Error("failed to record job attempt", zap.Error(err),
+			zap.String("job_id", reservedJob.ID.String()))
+	}
+}
+
+func (s *Service) CreateFailedAndDeleteMainJob(ctx context.Context, job jobsrepo.Job, reason string) error {
 	err := s.t.RunInTx(ctx, func(ctx context.Context) error {
-		if err := s.r.CreateFailedJob(ctx, job.Name, job.Payload, "max attempts exceeded"); err != nil {
+		if err := s.r.CreateFailedJob(ctx, job.ID, job.Name, job.Payload, reason, job.Requeues); err != nil {
 			return fmt.Errorf("failed to create failed job: %w", err)
 		}
 
@@ -198,7 +530,18 @@ func (s *Service) CreateFailedAndDeleteMainJob(ctx context.Context, job jobsrepo
 		return fmt.Errorf("failed to run transaction: %w", err)
 	}
 
+	s.metrics.attempts.WithLabelValues(job.Name).Observe(float64(job.Attempts))
 	s.logger.Info("job failed and deleted", zap.String("job_id", job.ID.String()))
 
 	return nil
 }
+
+// detached keeps the values of the parent context, but not its cancellation.
+type detached struct {
+	parent context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detached) Done() <-chan struct{}       { return nil }
+func (detached) Err() error                  { return nil }
+func (d detached) Value(key any) any         { return d.parent.Value(key) }
@@ -7,6 +7,7 @@ import (
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -14,6 +15,7 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	workers int,
+	batchSize int,
 	idleTime time.Duration,
 	reserveFor time.Duration,
 	r jobsRepository,
@@ -23,8 +25,11 @@ func NewOptions(
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
+	o.maxJobAge, _ = time.ParseDuration("5m")
+	o.drainTimeout, _ = time.ParseDuration("30s")
 
 	o.workers = workers
+	o.batchSize = batchSize
 	o.idleTime = idleTime
 	o.reserveFor = reserveFor
 	o.r = r
@@ -36,6 +41,30 @@ func NewOptions(
 	return o
 }
 
+func WithMaxJobAge(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxJobAge = opt
+	}
+}
+
+func WithDrainTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.drainTimeout = opt
+	}
+}
+
+func WithListener(opt jobsListener) OptOptionsSetter {
+	return func(o *Options) {
+		o.listener = opt
+	}
+}
+
+func WithRegisterer(opt prometheus.Registerer) OptOptionsSetter {
+	return func(o *Options) {
+		o.registerer = opt
+	}
+}
+
 func WithLogger(opt *zap.Logger) OptOptionsSetter {
 	return func(o *Options) {
 		o.logger = opt
@@ -44,12 +73,29 @@ func WithLogger(opt *zap.Logger) OptOptionsSetter {
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("maxJobAge", _validate_Options_maxJobAge(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("drainTimeout", _validate_Options_drainTimeout(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("workers", _validate_Options_workers(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("batchSize", _validate_Options_batchSize(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("idleTime", _validate_Options_idleTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("reserveFor", _validate_Options_reserveFor(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_maxJobAge(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxJobAge, "omitempty,min=1s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxJobAge` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_drainTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.drainTimeout, "omitempty,max=10m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `drainTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_workers(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.workers, "min=1,max=32"); err != nil {
 		return fmt461e464ebed9.Errorf("field `workers` did not pass the test: %w", err)
@@ -57,6 +103,13 @@ func _validate_Options_workers(o *Options) error {
 	return nil
 }
 
+func _validate_Options_batchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.batchSize, "min=1,max=100"); err != nil {
+		return fmt461e464ebed9.Errorf("field `batchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_idleTime(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.idleTime, "min=100ms,max=10s"); err != nil {
 		return fmt461e464ebed9.Errorf("field `idleTime` did not pass the test: %w", err)
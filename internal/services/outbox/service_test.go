@@ -11,16 +11,20 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 
 	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
 	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/store"
 	"github.com/keepcalmist/chat-service/internal/testingh"
 )
 
 var (
 	workers    = 10
+	batchSize  = 5
 	idleTime   = 250 * time.Millisecond
 	reserveFor = time.Second
 )
@@ -48,6 +52,7 @@ func (s *OutboxServiceSuite) SetupSuite() {
 
 	s.outboxSvc, err = outbox.New(outbox.NewOptions(
 		workers,
+		batchSize,
 		idleTime,
 		reserveFor,
 		jobsRepo,
@@ -66,6 +71,7 @@ func (s *OutboxServiceSuite) SetupTest() {
 
 	s.Database.Job(s.Ctx).Delete().ExecX(s.Ctx)
 	s.Database.FailedJob(s.Ctx).Delete().ExecX(s.Ctx)
+	s.Database.JobAttempt(s.Ctx).Delete().ExecX(s.Ctx)
 }
 
 func (s *OutboxServiceSuite) TearDownTest() {
@@ -107,6 +113,47 @@ func (s *OutboxServiceSuite) TestPutJob() {
 	s.NotEmpty(j.CreatedAt)
 }
 
+func (s *OutboxServiceSuite) TestPutUniqueJob() {
+	// Arrange.
+	const jobName = "TestPutUniqueJob"
+	const dedupKey = "TestPutUniqueJob-key"
+
+	jobID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "{}", dedupKey, time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	_, dupErr := s.outboxSvc.PutUnique(s.Ctx, jobName, "{}", dedupKey, time.Now())
+	existingID, err := s.outboxSvc.PutUniqueOrGet(s.Ctx, jobName, "{}", dedupKey, time.Now())
+
+	// Assert.
+	s.ErrorIs(dupErr, outbox.ErrJobDuplicated)
+	s.Require().NoError(err)
+	s.Equal(jobID, existingID)
+	s.Equal(1, s.Store.Job.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestUniqueJobCanBePutAgainAfterCompletion() {
+	// Arrange.
+	const jobName = "TestUniqueJobCanBePutAgainAfterCompletion"
+	const dedupKey = "TestUniqueJobCanBePutAgainAfterCompletion-key"
+
+	job := newJobMock(jobName, nop, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	firstID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "{}", dedupKey, time.Now())
+	s.Require().NoError(err)
+
+	s.runOutboxFor(2 * idleTime)
+	s.Require().Equal(1, job.ExecutedTimes())
+
+	// Action.
+	secondID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "{}", dedupKey, time.Now())
+
+	// Assert.
+	s.Require().NoError(err)
+	s.NotEqual(firstID, secondID)
+}
+
 func (s *OutboxServiceSuite) TestAllJobsProcessed() {
 	// Arrange.
 	const jobName = "TestAllJobsProcessed"
@@ -169,7 +216,7 @@ func (s *OutboxServiceSuite) TestDLQ_AfterMaxAttemptsExceeding() {
 			case <-time.After(50 * time.Millisecond):
 			}
 		}
-		return errors.New("unknown")
+		return fmt.Errorf("unknown %d", executedTimes)
 	}, time.Millisecond, maxAttempts)
 	s.outboxSvc.MustRegisterJob(job)
 
@@ -188,12 +235,42 @@ func (s *OutboxServiceSuite) TestDLQ_AfterMaxAttemptsExceeding() {
 	s.NotEmpty(j.ID)
 	s.Equal(jobName, j.Name)
 	s.Equal(jobPayload, j.Payload)
-	s.NotEmpty(j.Reason)
+	// The last error is the reason. The tiny ExecutionTimeout() can win the race with the handler.
+	s.Contains([]string{fmt.Sprintf("unknown %d", maxAttempts), context.DeadlineExceeded.Error()}, j.Reason)
 	s.NotEmpty(j.CreatedAt)
 
 	s.Equal(maxAttempts, job.ExecutedTimes())
 }
 
+func (s *OutboxServiceSuite) TestFailedJobRescheduledWithBackoff() {
+	// Arrange.
+	const jobName = "TestFailedJobRescheduledWithBackoff"
+	const retryDelay = time.Hour
+
+	job := newJobMock(jobName, func(context.Context, string) error {
+		return errors.New("kafka is down")
+	}, time.Second, 3)
+	job.retryPolicy = outbox.ExponentialBackoff{InitialInterval: retryDelay, Multiplier: 2, MaxInterval: retryDelay}
+	s.outboxSvc.MustRegisterJob(job)
+
+	jobID, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	s.runOutboxFor(2 * idleTime)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j, err := s.Store.Job.Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Equal(1, j.Attempts)
+	s.Equal("kafka is down", j.LastError)
+	s.WithinDuration(time.Now().Add(retryDelay), j.AvailableAt, time.Minute)
+	s.True(j.ReservedUntil.Before(time.Now()))
+}
+
 func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	// Arrange.
 	const jobName = "TestIfNoJobsThenWorkersSleepForIdleTime"
@@ -227,6 +304,119 @@ func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	s.NoError(<-errCh)
 }
 
+func (s *OutboxServiceSuite) TestListenerWakesUpIdleWorkers() {
+	// Arrange.
+	const jobName = "TestListenerWakesUpIdleWorkers"
+	const longIdleTime = 10 * time.Second
+
+	job := newJobMock(jobName, nop, time.Second, 1)
+	svc := s.newOutboxWithListener(longIdleTime, job)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	time.Sleep(idleTime) // Workers fell asleep for the long idle time.
+
+	// Action.
+	_, err := svc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	_, err = svc.Put(s.Ctx, jobName, "{}", time.Now().Add(idleTime)) // Postponed job.
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Eventually(func() bool {
+		return job.ExecutedTimes() == 2
+	}, longIdleTime/2, 10*time.Millisecond)
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+
+	cancel()
+	s.NoError(<-errCh)
+}
+
+func (s *OutboxServiceSuite) TestHealthAndMetrics() {
+	// Arrange.
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	registry := prometheus.NewRegistry()
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		batchSize,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithMaxJobAge(time.Minute),
+		outbox.WithRegisterer(registry),
+	))
+	s.Require().NoError(err)
+
+	s.Require().NoError(svc.CheckHealth(s.Ctx)) // No jobs.
+
+	_, err = svc.Put(s.Ctx, "TestHealthAndMetrics", "{}", time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	_, err = svc.Put(s.Ctx, "TestHealthAndMetrics", "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	healthErr := svc.CheckHealth(s.Ctx)
+	families, err := registry.Gather()
+
+	// Assert.
+	s.ErrorIs(healthErr, outbox.ErrJobsStuck)
+	s.Require().NoError(err)
+
+	metrics := make(map[string]*dto.MetricFamily, len(families))
+	for _, f := range families {
+		metrics[f.GetName()] = f
+	}
+
+	depth := metrics["chat_service_outbox_queue_depth"]
+	s.Require().NotNil(depth)
+	s.Require().Len(depth.GetMetric(), 1)
+	s.Equal(2., depth.GetMetric()[0].GetGauge().GetValue())
+
+	age := metrics["chat_service_outbox_oldest_pending_job_age_seconds"]
+	s.Require().NotNil(age)
+	s.InDelta(time.Hour.Seconds(), age.GetMetric()[0].GetGauge().GetValue(), time.Minute.Seconds())
+
+	failed := metrics["chat_service_outbox_failed_jobs"]
+	s.Require().NotNil(failed)
+	s.Equal(0., failed.GetMetric()[0].GetGauge().GetValue())
+
+	s.Contains(metrics, "chat_service_outbox_reservations_in_flight")
+}
+
+func (s *OutboxServiceSuite) newOutboxWithListener(idle time.Duration, jobs ...outbox.Job) *outbox.Service {
+	s.T().Helper()
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	listener, err := store.NewPSQLListener(s.Store, jobsrepo.JobsChannel)
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		batchSize,
+		idle,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithListener(listener),
+	))
+	s.Require().NoError(err)
+
+	for _, j := range jobs {
+		svc.MustRegisterJob(j)
+	}
+	return svc
+}
+
 func (s *OutboxServiceSuite) runOutboxFor(timeout time.Duration) {
 	s.T().Helper()
 
@@ -249,6 +439,12 @@ func (s *OutboxServiceSuite) runOutbox() (context.CancelFunc, <-chan error) {
 	return cancel, errCh
 }
 
+var fastRetryPolicy = outbox.ExponentialBackoff{
+	InitialInterval: 10 * time.Millisecond,
+	Multiplier:      2,
+	MaxInterval:     100 * time.Millisecond,
+}
+
 var nop = func(ctx context.Context, s string) error {
 	time.Sleep(10 * time.Millisecond) // Prevent PSQL DDoS.
 	return nil
@@ -259,6 +455,7 @@ type jobMock struct {
 	handler       func(ctx context.Context, s string) error
 	timeout       time.Duration
 	maxAttempts   int
+	retryPolicy   outbox.RetryPolicy
 	executedTimes int32
 }
 
@@ -294,6 +491,13 @@ func (j *jobMock) MaxAttempts() int {
 	return j.maxAttempts
 }
 
+func (j *jobMock) RetryPolicy() outbox.RetryPolicy {
+	if j.retryPolicy != nil {
+		return j.retryPolicy
+	}
+	return fastRetryPolicy
+}
+
 // ExecutedTimes returns global (for all different jobs of this type
 // processed at different times) execution counter.
 func (j *jobMock) ExecutedTimes() int {
@@ -0,0 +1,94 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+)
+
+func (s *OutboxServiceSuite) TestJobAttemptsRecorded() {
+	// Arrange.
+	const jobName = "TestJobAttemptsRecorded"
+
+	var executedTimes int
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		executedTimes++
+		switch executedTimes {
+		case 1:
+			return errors.New("kafka is down")
+		case 2:
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	}, 100*time.Millisecond, 3)
+	s.outboxSvc.MustRegisterJob(job)
+
+	jobID, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+
+	attempts := s.Store.JobAttempt.Query().
+		Where(jobattempt.JobID(jobID)).
+		Order(jobattempt.ByAttempt()).
+		AllX(s.Ctx)
+	s.Require().Len(attempts, 3)
+
+	expected := []struct {
+		outcome jobattempt.Outcome
+		err     string
+	}{
+		{outcome: jobattempt.OutcomeFailed, err: "kafka is down"},
+		{outcome: jobattempt.OutcomeTimedOut, err: context.DeadlineExceeded.Error()},
+		{outcome: jobattempt.OutcomeSucceeded},
+	}
+	for i, a := range attempts {
+		s.Equal(jobName, a.JobName)
+		s.Equal(i+1, a.Attempt)
+		s.NotEmpty(a.Worker)
+		s.False(a.FinishedAt.Before(a.StartedAt))
+		s.Equal(expected[i].outcome, a.Outcome)
+		s.Equal(expected[i].err, a.Error)
+	}
+}
+
+func (s *OutboxServiceSuite) TestJobAttemptsOfFailedJob() {
+	// Arrange.
+	const jobName = "TestJobAttemptsOfFailedJob"
+	const maxAttempts = 2
+
+	job := newJobMock(jobName, func(context.Context, string) error {
+		return errors.New("kafka is down")
+	}, time.Second, maxAttempts)
+	s.outboxSvc.MustRegisterJob(job)
+
+	_, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	fJob := s.Store.FailedJob.Query().OnlyX(s.Ctx)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	attempts, err := jobsRepo.GetJobAttempts(s.Ctx, fJob.JobID)
+	s.Require().NoError(err)
+	s.Require().Len(attempts, maxAttempts)
+	for _, a := range attempts {
+		s.Equal(jobsrepo.JobAttemptFailed, a.Outcome)
+		s.Equal("kafka is down", a.Error)
+	}
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+func (s *OutboxServiceSuite) TestDrainFinishesInFlightJobs() {
+	// Arrange.
+	const jobName = "TestDrainFinishesInFlightJobs"
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(300 * time.Millisecond):
+			return nil
+		}
+	}, time.Second, 1)
+
+	svc := s.newDrainingOutbox(time.Minute, time.Second, job)
+	_, err := svc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	<-started
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestDrainReleasesUnfinishedJobs() {
+	// Arrange.
+	const jobName = "TestDrainReleasesUnfinishedJobs"
+	const drainTimeout = 100 * time.Millisecond
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, time.Minute, 1)
+
+	svc := s.newDrainingOutbox(10*time.Minute, drainTimeout, job)
+	jobID, err := svc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	<-started
+	stoppedAt := time.Now()
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	s.Less(time.Since(stoppedAt), drainTimeout+time.Second)
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j, err := s.Store.Job.Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Equal(1, j.Attempts)
+	s.True(j.ReservedUntil.Before(time.Now())) // Another instance picks it up at once.
+}
+
+func (s *OutboxServiceSuite) newDrainingOutbox(
+	reserveFor, drainTimeout time.Duration,
+	jobs ...outbox.Job,
+) *outbox.Service {
+	s.T().Helper()
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		batchSize,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithDrainTimeout(drainTimeout),
+	))
+	s.Require().NoError(err)
+
+	for _, j := range jobs {
+		svc.MustRegisterJob(j)
+	}
+	return svc
+}
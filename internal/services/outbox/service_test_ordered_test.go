@@ -0,0 +1,162 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+)
+
+func (s *OutboxServiceSuite) TestOrderedJobsRunInCreationOrder() {
+	// Arrange.
+	const (
+		jobName    = "TestOrderedJobsRunInCreationOrder"
+		keys       = 5
+		jobsPerKey = 10
+		blockedKey = "chat-0"
+	)
+
+	var (
+		mu      sync.Mutex
+		handled = make(map[string][]int, keys)
+		others  int
+	)
+
+	job := newJobMock(jobName, func(_ context.Context, payload string) error {
+		key, seqStr, _ := strings.Cut(payload, "/")
+		seq, err := strconv.Atoi(seqStr)
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(time.Duration(rand.Intn(10)) * time.Millisecond) //nolint:gosec // Just shuffling of workers.
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		// The head of the blocked key fails until the jobs of other keys are done,
+		// so they must not wait for it.
+		if key == blockedKey && seq == 0 && others < (keys-1)*jobsPerKey {
+			return errors.New("head job failed")
+		}
+
+		handled[key] = append(handled[key], seq)
+		if key != blockedKey {
+			others++
+		}
+		return nil
+	}, time.Second, 30)
+	job.retryPolicy = outbox.ExponentialBackoff{
+		InitialInterval: 100 * time.Millisecond,
+		Multiplier:      1,
+		MaxInterval:     100 * time.Millisecond,
+	}
+	s.outboxSvc.MustRegisterJob(job)
+
+	expected := make([]int, jobsPerKey)
+	for seq := 0; seq < jobsPerKey; seq++ {
+		expected[seq] = seq
+
+		for k := 0; k < keys; k++ {
+			key := fmt.Sprintf("chat-%d", k)
+			_, err := s.outboxSvc.PutOrdered(s.Ctx, jobName, fmt.Sprintf("%s/%d", key, seq), key, time.Now())
+			s.Require().NoError(err)
+		}
+	}
+
+	// Action.
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	// Assert.
+	s.Eventually(func() bool {
+		return s.Store.Job.Query().CountX(s.Ctx) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cancel()
+	s.NoError(<-errCh)
+
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	s.Len(handled, keys)
+	for key, seqs := range handled {
+		s.Equal(expected, seqs, key)
+	}
+}
+
+func (s *OutboxServiceSuite) TestOrderedJobsBehindBackingOffHeadDontWakeWorkers() {
+	// Arrange.
+	const (
+		jobName     = "TestOrderedJobsBehindBackingOffHeadDontWakeWorkers"
+		orderingKey = "chat-1"
+		runFor      = 2 * time.Second
+	)
+
+	job := newJobMock(jobName, func(_ context.Context, _ string) error {
+		return errors.New("head job failed")
+	}, time.Second, 30)
+	job.retryPolicy = outbox.ExponentialBackoff{
+		InitialInterval: time.Minute,
+		Multiplier:      1,
+		MaxInterval:     time.Minute,
+	}
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	repo := &reservationsCountingRepo{Repo: jobsRepo}
+	svc, err := outbox.New(outbox.NewOptions(workers, batchSize, idleTime, reserveFor, repo, s.Database))
+	s.Require().NoError(err)
+	svc.MustRegisterJob(job)
+
+	for seq := 0; seq < 3; seq++ {
+		_, err := svc.PutOrdered(s.Ctx, jobName, strconv.Itoa(seq), orderingKey, time.Now())
+		s.Require().NoError(err)
+	}
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	time.Sleep(runFor)
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+
+	// The idle workers poll once per idleTime, the jobs behind the head must not wake them up earlier.
+	maxPolls := int(runFor/idleTime+2) * workers
+	s.LessOrEqual(int(repo.reservations.Load()), maxPolls)
+}
+
+// reservationsCountingRepo counts the attempts to reserve the jobs.
+type reservationsCountingRepo struct {
+	*jobsrepo.Repo
+	reservations atomic.Int32
+}
+
+func (r *reservationsCountingRepo) FindAndReserveJobs(
+	ctx context.Context,
+	until time.Time,
+	limit int,
+) ([]jobsrepo.Job, error) {
+	r.reservations.Add(1)
+	return r.Repo.FindAndReserveJobs(ctx, until, limit)
+}
@@ -0,0 +1,174 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	jobsrepo "github.com/keepcalmist/chat-service/internal/repositories/jobs"
+	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	"github.com/keepcalmist/chat-service/internal/store/job"
+)
+
+func (s *OutboxServiceSuite) TestPeriodicJobRunsOnceAcrossReplicas() {
+	// Arrange.
+	const jobName = "TestPeriodicJobRunsOnceAcrossReplicas"
+	const period = 200 * time.Millisecond
+
+	var running, overlaps int32
+	pJob := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		if atomic.AddInt32(&running, 1) > 1 {
+			atomic.AddInt32(&overlaps, 1)
+		}
+		defer atomic.AddInt32(&running, -1)
+
+		return nop(ctx, "")
+	}, time.Second, 1)
+
+	replicas := make([]*outbox.Service, 2)
+	for i := range replicas {
+		replicas[i] = s.newOutbox()
+		replicas[i].MustRegisterPeriodicJob(pJob, outbox.Every(period), outbox.CatchUpOnce)
+	}
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	wg := new(sync.WaitGroup)
+	for _, r := range replicas {
+		wg.Add(1)
+		go func(r *outbox.Service) {
+			defer wg.Done()
+			s.NoError(r.Run(ctx))
+		}(r)
+	}
+
+	time.Sleep(5*period + period/2)
+	cancel()
+	wg.Wait()
+
+	// Assert.
+	s.InDelta(5, pJob.ExecutedTimes(), 1)
+	s.Zero(atomic.LoadInt32(&overlaps))
+	s.Equal(1, s.Store.Job.Query().Where(job.Name(jobName)).CountX(s.Ctx)) // The next run.
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestPeriodicJobCatchUp() {
+	const period = 10 * time.Minute
+	schedule := outbox.Every(period)
+
+	missedSince := schedule.Next(time.Now().Add(-time.Hour))
+	missedRuns := 0
+	for t := missedSince; !t.After(time.Now()); t = schedule.Next(t) {
+		missedRuns++
+	}
+
+	for _, tt := range []struct {
+		policy   outbox.CatchUpPolicy
+		expected int
+	}{
+		{policy: outbox.CatchUpSkip, expected: 0},
+		{policy: outbox.CatchUpOnce, expected: 1},
+		{policy: outbox.CatchUpAll, expected: missedRuns},
+	} {
+		s.Run(string(tt.policy), func() {
+			// Arrange.
+			s.Database.Job(s.Ctx).Delete().ExecX(s.Ctx)
+
+			jobName := "TestPeriodicJobCatchUp_" + string(tt.policy)
+			pJob := newJobMock(jobName, nop, time.Second, 1)
+
+			svc := s.newOutbox()
+			svc.MustRegisterPeriodicJob(pJob, schedule, tt.policy)
+
+			// The run left since the last downtime.
+			s.Database.Job(s.Ctx).Create().
+				SetName(jobName).
+				SetPayload(fmt.Sprintf(`{"scheduledAt":%q}`, missedSince.UTC().Format(time.RFC3339Nano))).
+				SetOrderingKey(jobName).
+				SetAvailableAt(missedSince).
+				ExecX(s.Ctx)
+
+			// Action.
+			ctx, cancel := context.WithCancel(s.Ctx)
+			defer cancel()
+
+			errCh := make(chan error)
+			go func() { errCh <- svc.Run(ctx) }()
+
+			time.Sleep(time.Second)
+			cancel()
+			s.NoError(<-errCh)
+
+			// Assert.
+			s.Equal(tt.expected, pJob.ExecutedTimes())
+
+			next := s.Store.Job.Query().Where(job.Name(jobName)).OnlyX(s.Ctx)
+			s.Equal(schedule.Next(time.Now()).Unix(), next.AvailableAt.Unix())
+		})
+	}
+}
+
+func (s *OutboxServiceSuite) TestPeriodicJobRetryIsNotSkipped() {
+	// Arrange.
+	const period = 10 * time.Minute
+	schedule := outbox.Every(period)
+
+	jobName := "TestPeriodicJobRetryIsNotSkipped"
+	pJob := newJobMock(jobName, nop, time.Second, 5)
+
+	svc := s.newOutbox()
+	svc.MustRegisterPeriodicJob(pJob, schedule, outbox.CatchUpSkip)
+
+	// The run failed in its slot and its retry is backed off past the next one.
+	scheduledAt := schedule.Next(time.Now().Add(-time.Hour))
+	s.Database.Job(s.Ctx).Create().
+		SetName(jobName).
+		SetPayload(fmt.Sprintf(`{"scheduledAt":%q}`, scheduledAt.UTC().Format(time.RFC3339Nano))).
+		SetOrderingKey(jobName).
+		SetAttempts(1).
+		SetAvailableAt(time.Now()).
+		ExecX(s.Ctx)
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	time.Sleep(time.Second)
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, pJob.ExecutedTimes())
+
+	next := s.Store.Job.Query().Where(job.Name(jobName)).OnlyX(s.Ctx)
+	s.Equal(schedule.Next(time.Now()).Unix(), next.AvailableAt.Unix())
+}
+
+func (s *OutboxServiceSuite) newOutbox() *outbox.Service {
+	s.T().Helper()
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		batchSize,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+	))
+	s.Require().NoError(err)
+
+	return svc
+}
@@ -0,0 +1,49 @@
+package topicdetector
+
+import (
+	"strings"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// Service infers the topic of the problem from the client message by the keyword rules.
+type Service struct {
+	keywords map[types.Topic][]string
+}
+
+// New creates the service with the keywords of each topic. The keywords are case-insensitive.
+func New(keywords map[types.Topic][]string) *Service {
+	lowered := make(map[types.Topic][]string, len(keywords))
+	for topic, words := range keywords {
+		for _, w := range words {
+			if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+				lowered[topic] = append(lowered[topic], w)
+			}
+		}
+	}
+
+	return &Service{keywords: lowered}
+}
+
+// Detect returns the topic having the most keywords in the message or empty topic if nothing matches.
+// The tie is resolved in the order of types.Topics.
+func (s *Service) Detect(msgBody string) types.Topic {
+	msgBody = strings.ToLower(msgBody)
+
+	var detected types.Topic
+	maxMatches := 0
+	for _, topic := range types.Topics {
+		matches := 0
+		for _, w := range s.keywords[topic] {
+			if strings.Contains(msgBody, w) {
+				matches++
+			}
+		}
+
+		if matches > maxMatches {
+			detected, maxMatches = topic, matches
+		}
+	}
+
+	return detected
+}
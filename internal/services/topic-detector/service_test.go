@@ -0,0 +1,38 @@
+package topicdetector_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	topicdetector "github.com/keepcalmist/chat-service/internal/services/topic-detector"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+func TestService_Detect(t *testing.T) {
+	detector := topicdetector.New(map[types.Topic][]string{
+		types.TopicCards:    {"card", "карт"},
+		types.TopicLoans:    {"loan", "credit", "кредит"},
+		types.TopicDeposits: {" Deposit ", "вклад", ""},
+	})
+
+	cases := []struct {
+		name     string
+		msgBody  string
+		expected types.Topic
+	}{
+		{name: "no keywords", msgBody: "Hello, I need help", expected: ""},
+		{name: "case-insensitive", msgBody: "My CARD is blocked", expected: types.TopicCards},
+		{name: "keyword inside word", msgBody: "Как погасить кредитный договор досрочно?", expected: types.TopicLoans},
+		{name: "trimmed keyword", msgBody: "deposits rates", expected: types.TopicDeposits},
+		{name: "most keywords win", msgBody: "Can I pay the loan from a deposit by credit card?", expected: types.TopicLoans},
+		{name: "tie is resolved by topics order", msgBody: "deposit or loan?", expected: types.TopicLoans},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, detector.Detect(tt.msgBody))
+		})
+	}
+}
@@ -19,7 +19,11 @@ import (
 	"github.com/keepcalmist/chat-service/internal/store/chat"
 	"github.com/keepcalmist/chat-service/internal/store/failedjob"
 	"github.com/keepcalmist/chat-service/internal/store/job"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
 	"github.com/keepcalmist/chat-service/internal/store/message"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
 	"github.com/keepcalmist/chat-service/internal/store/problem"
 )
 
@@ -34,8 +38,16 @@ type Client struct {
 	FailedJob *FailedJobClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
+	// JobAttempt is the client for interacting with the JobAttempt builders.
+	JobAttempt *JobAttemptClient
+	// ManagerCapacity is the client for interacting with the ManagerCapacity builders.
+	ManagerCapacity *ManagerCapacityClient
+	// ManagerSkill is the client for interacting with the ManagerSkill builders.
+	ManagerSkill *ManagerSkillClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PooledManager is the client for interacting with the PooledManager builders.
+	PooledManager *PooledManagerClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
 }
@@ -54,7 +66,11 @@ func (c *Client) init() {
 	c.Chat = NewChatClient(c.config)
 	c.FailedJob = NewFailedJobClient(c.config)
 	c.Job = NewJobClient(c.config)
+	c.JobAttempt = NewJobAttemptClient(c.config)
+	c.ManagerCapacity = NewManagerCapacityClient(c.config)
+	c.ManagerSkill = NewManagerSkillClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.PooledManager = NewPooledManagerClient(c.config)
 	c.Problem = NewProblemClient(c.config)
 }
 
@@ -139,13 +155,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Chat:            NewChatClient(cfg),
+		FailedJob:       NewFailedJobClient(cfg),
+		Job:             NewJobClient(cfg),
+		JobAttempt:      NewJobAttemptClient(cfg),
+		ManagerCapacity: NewManagerCapacityClient(cfg),
+		ManagerSkill:    NewManagerSkillClient(cfg),
+		Message:         NewMessageClient(cfg),
+		PooledManager:   NewPooledManagerClient(cfg),
+		Problem:         NewProblemClient(cfg),
 	}, nil
 }
 
@@ -163,13 +183,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Chat:            NewChatClient(cfg),
+		FailedJob:       NewFailedJobClient(cfg),
+		Job:             NewJobClient(cfg),
+		JobAttempt:      NewJobAttemptClient(cfg),
+		ManagerCapacity: NewManagerCapacityClient(cfg),
+		ManagerSkill:    NewManagerSkillClient(cfg),
+		Message:         NewMessageClient(cfg),
+		PooledManager:   NewPooledManagerClient(cfg),
+		Problem:         NewProblemClient(cfg),
 	}, nil
 }
 
@@ -198,21 +222,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Chat.Use(hooks...)
-	c.FailedJob.Use(hooks...)
-	c.Job.Use(hooks...)
-	c.Message.Use(hooks...)
-	c.Problem.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Chat, c.FailedJob, c.Job, c.JobAttempt, c.ManagerCapacity, c.ManagerSkill,
+		c.Message, c.PooledManager, c.Problem,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Chat.Intercept(interceptors...)
-	c.FailedJob.Intercept(interceptors...)
-	c.Job.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
-	c.Problem.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Chat, c.FailedJob, c.Job, c.JobAttempt, c.ManagerCapacity, c.ManagerSkill,
+		c.Message, c.PooledManager, c.Problem,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -224,8 +250,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FailedJob.mutate(ctx, m)
 	case *JobMutation:
 		return c.Job.mutate(ctx, m)
+	case *JobAttemptMutation:
+		return c.JobAttempt.mutate(ctx, m)
+	case *ManagerCapacityMutation:
+		return c.ManagerCapacity.mutate(ctx, m)
+	case *ManagerSkillMutation:
+		return c.ManagerSkill.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *PooledManagerMutation:
+		return c.PooledManager.mutate(ctx, m)
 	case *ProblemMutation:
 		return c.Problem.mutate(ctx, m)
 	default:
@@ -664,6 +698,405 @@ func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
 	}
 }
 
+// JobAttemptClient is a client for the JobAttempt schema.
+type JobAttemptClient struct {
+	config
+}
+
+// NewJobAttemptClient returns a client for the JobAttempt from the given config.
+func NewJobAttemptClient(c config) *JobAttemptClient {
+	return &JobAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobattempt.Hooks(f(g(h())))`.
+func (c *JobAttemptClient) Use(hooks ...Hook) {
+	c.hooks.JobAttempt = append(c.hooks.JobAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobattempt.Intercept(f(g(h())))`.
+func (c *JobAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobAttempt = append(c.inters.JobAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a JobAttempt entity.
+func (c *JobAttemptClient) Create() *JobAttemptCreate {
+	mutation := newJobAttemptMutation(c.config, OpCreate)
+	return &JobAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobAttempt entities.
+func (c *JobAttemptClient) CreateBulk(builders ...*JobAttemptCreate) *JobAttemptCreateBulk {
+	return &JobAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobAttemptClient) MapCreateBulk(slice any, setFunc func(*JobAttemptCreate, int)) *JobAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobAttemptCreateBulk{err: fmt.Errorf("calling to JobAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobAttempt.
+func (c *JobAttemptClient) Update() *JobAttemptUpdate {
+	mutation := newJobAttemptMutation(c.config, OpUpdate)
+	return &JobAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobAttemptClient) UpdateOne(ja *JobAttempt) *JobAttemptUpdateOne {
+	mutation := newJobAttemptMutation(c.config, OpUpdateOne, withJobAttempt(ja))
+	return &JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobAttemptClient) UpdateOneID(id types.JobAttemptID) *JobAttemptUpdateOne {
+	mutation := newJobAttemptMutation(c.config, OpUpdateOne, withJobAttemptID(id))
+	return &JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobAttempt.
+func (c *JobAttemptClient) Delete() *JobAttemptDelete {
+	mutation := newJobAttemptMutation(c.config, OpDelete)
+	return &JobAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobAttemptClient) DeleteOne(ja *JobAttempt) *JobAttemptDeleteOne {
+	return c.DeleteOneID(ja.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobAttemptClient) DeleteOneID(id types.JobAttemptID) *JobAttemptDeleteOne {
+	builder := c.Delete().Where(jobattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for JobAttempt.
+func (c *JobAttemptClient) Query() *JobAttemptQuery {
+	return &JobAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobAttempt entity by its id.
+func (c *JobAttemptClient) Get(ctx context.Context, id types.JobAttemptID) (*JobAttempt, error) {
+	return c.Query().Where(jobattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobAttemptClient) GetX(ctx context.Context, id types.JobAttemptID) *JobAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobAttemptClient) Hooks() []Hook {
+	return c.hooks.JobAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobAttemptClient) Interceptors() []Interceptor {
+	return c.inters.JobAttempt
+}
+
+func (c *JobAttemptClient) mutate(ctx context.Context, m *JobAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown JobAttempt mutation op: %q", m.Op())
+	}
+}
+
+// ManagerCapacityClient is a client for the ManagerCapacity schema.
+type ManagerCapacityClient struct {
+	config
+}
+
+// NewManagerCapacityClient returns a client for the ManagerCapacity from the given config.
+func NewManagerCapacityClient(c config) *ManagerCapacityClient {
+	return &ManagerCapacityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `managercapacity.Hooks(f(g(h())))`.
+func (c *ManagerCapacityClient) Use(hooks ...Hook) {
+	c.hooks.ManagerCapacity = append(c.hooks.ManagerCapacity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `managercapacity.Intercept(f(g(h())))`.
+func (c *ManagerCapacityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ManagerCapacity = append(c.inters.ManagerCapacity, interceptors...)
+}
+
+// Create returns a builder for creating a ManagerCapacity entity.
+func (c *ManagerCapacityClient) Create() *ManagerCapacityCreate {
+	mutation := newManagerCapacityMutation(c.config, OpCreate)
+	return &ManagerCapacityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ManagerCapacity entities.
+func (c *ManagerCapacityClient) CreateBulk(builders ...*ManagerCapacityCreate) *ManagerCapacityCreateBulk {
+	return &ManagerCapacityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ManagerCapacityClient) MapCreateBulk(slice any, setFunc func(*ManagerCapacityCreate, int)) *ManagerCapacityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ManagerCapacityCreateBulk{err: fmt.Errorf("calling to ManagerCapacityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ManagerCapacityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ManagerCapacityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ManagerCapacity.
+func (c *ManagerCapacityClient) Update() *ManagerCapacityUpdate {
+	mutation := newManagerCapacityMutation(c.config, OpUpdate)
+	return &ManagerCapacityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ManagerCapacityClient) UpdateOne(mc *ManagerCapacity) *ManagerCapacityUpdateOne {
+	mutation := newManagerCapacityMutation(c.config, OpUpdateOne, withManagerCapacity(mc))
+	return &ManagerCapacityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ManagerCapacityClient) UpdateOneID(id int) *ManagerCapacityUpdateOne {
+	mutation := newManagerCapacityMutation(c.config, OpUpdateOne, withManagerCapacityID(id))
+	return &ManagerCapacityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ManagerCapacity.
+func (c *ManagerCapacityClient) Delete() *ManagerCapacityDelete {
+	mutation := newManagerCapacityMutation(c.config, OpDelete)
+	return &ManagerCapacityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ManagerCapacityClient) DeleteOne(mc *ManagerCapacity) *ManagerCapacityDeleteOne {
+	return c.DeleteOneID(mc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ManagerCapacityClient) DeleteOneID(id int) *ManagerCapacityDeleteOne {
+	builder := c.Delete().Where(managercapacity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ManagerCapacityDeleteOne{builder}
+}
+
+// Query returns a query builder for ManagerCapacity.
+func (c *ManagerCapacityClient) Query() *ManagerCapacityQuery {
+	return &ManagerCapacityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeManagerCapacity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ManagerCapacity entity by its id.
+func (c *ManagerCapacityClient) Get(ctx context.Context, id int) (*ManagerCapacity, error) {
+	return c.Query().Where(managercapacity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ManagerCapacityClient) GetX(ctx context.Context, id int) *ManagerCapacity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ManagerCapacityClient) Hooks() []Hook {
+	return c.hooks.ManagerCapacity
+}
+
+// Interceptors returns the client interceptors.
+func (c *ManagerCapacityClient) Interceptors() []Interceptor {
+	return c.inters.ManagerCapacity
+}
+
+func (c *ManagerCapacityClient) mutate(ctx context.Context, m *ManagerCapacityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ManagerCapacityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ManagerCapacityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ManagerCapacityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ManagerCapacityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown ManagerCapacity mutation op: %q", m.Op())
+	}
+}
+
+// ManagerSkillClient is a client for the ManagerSkill schema.
+type ManagerSkillClient struct {
+	config
+}
+
+// NewManagerSkillClient returns a client for the ManagerSkill from the given config.
+func NewManagerSkillClient(c config) *ManagerSkillClient {
+	return &ManagerSkillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `managerskill.Hooks(f(g(h())))`.
+func (c *ManagerSkillClient) Use(hooks ...Hook) {
+	c.hooks.ManagerSkill = append(c.hooks.ManagerSkill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `managerskill.Intercept(f(g(h())))`.
+func (c *ManagerSkillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ManagerSkill = append(c.inters.ManagerSkill, interceptors...)
+}
+
+// Create returns a builder for creating a ManagerSkill entity.
+func (c *ManagerSkillClient) Create() *ManagerSkillCreate {
+	mutation := newManagerSkillMutation(c.config, OpCreate)
+	return &ManagerSkillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ManagerSkill entities.
+func (c *ManagerSkillClient) CreateBulk(builders ...*ManagerSkillCreate) *ManagerSkillCreateBulk {
+	return &ManagerSkillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ManagerSkillClient) MapCreateBulk(slice any, setFunc func(*ManagerSkillCreate, int)) *ManagerSkillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ManagerSkillCreateBulk{err: fmt.Errorf("calling to ManagerSkillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ManagerSkillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ManagerSkillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ManagerSkill.
+func (c *ManagerSkillClient) Update() *ManagerSkillUpdate {
+	mutation := newManagerSkillMutation(c.config, OpUpdate)
+	return &ManagerSkillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ManagerSkillClient) UpdateOne(ms *ManagerSkill) *ManagerSkillUpdateOne {
+	mutation := newManagerSkillMutation(c.config, OpUpdateOne, withManagerSkill(ms))
+	return &ManagerSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ManagerSkillClient) UpdateOneID(id int) *ManagerSkillUpdateOne {
+	mutation := newManagerSkillMutation(c.config, OpUpdateOne, withManagerSkillID(id))
+	return &ManagerSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ManagerSkill.
+func (c *ManagerSkillClient) Delete() *ManagerSkillDelete {
+	mutation := newManagerSkillMutation(c.config, OpDelete)
+	return &ManagerSkillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ManagerSkillClient) DeleteOne(ms *ManagerSkill) *ManagerSkillDeleteOne {
+	return c.DeleteOneID(ms.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ManagerSkillClient) DeleteOneID(id int) *ManagerSkillDeleteOne {
+	builder := c.Delete().Where(managerskill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ManagerSkillDeleteOne{builder}
+}
+
+// Query returns a query builder for ManagerSkill.
+func (c *ManagerSkillClient) Query() *ManagerSkillQuery {
+	return &ManagerSkillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeManagerSkill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ManagerSkill entity by its id.
+func (c *ManagerSkillClient) Get(ctx context.Context, id int) (*ManagerSkill, error) {
+	return c.Query().Where(managerskill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ManagerSkillClient) GetX(ctx context.Context, id int) *ManagerSkill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ManagerSkillClient) Hooks() []Hook {
+	return c.hooks.ManagerSkill
+}
+
+// Interceptors returns the client interceptors.
+func (c *ManagerSkillClient) Interceptors() []Interceptor {
+	return c.inters.ManagerSkill
+}
+
+func (c *ManagerSkillClient) mutate(ctx context.Context, m *ManagerSkillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ManagerSkillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ManagerSkillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ManagerSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ManagerSkillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown ManagerSkill mutation op: %q", m.Op())
+	}
+}
+
 // MessageClient is a client for the Message schema.
 type MessageClient struct {
 	config
@@ -829,6 +1262,139 @@ func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value,
 	}
 }
 
+// PooledManagerClient is a client for the PooledManager schema.
+type PooledManagerClient struct {
+	config
+}
+
+// NewPooledManagerClient returns a client for the PooledManager from the given config.
+func NewPooledManagerClient(c config) *PooledManagerClient {
+	return &PooledManagerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pooledmanager.Hooks(f(g(h())))`.
+func (c *PooledManagerClient) Use(hooks ...Hook) {
+	c.hooks.PooledManager = append(c.hooks.PooledManager, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pooledmanager.Intercept(f(g(h())))`.
+func (c *PooledManagerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PooledManager = append(c.inters.PooledManager, interceptors...)
+}
+
+// Create returns a builder for creating a PooledManager entity.
+func (c *PooledManagerClient) Create() *PooledManagerCreate {
+	mutation := newPooledManagerMutation(c.config, OpCreate)
+	return &PooledManagerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PooledManager entities.
+func (c *PooledManagerClient) CreateBulk(builders ...*PooledManagerCreate) *PooledManagerCreateBulk {
+	return &PooledManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PooledManagerClient) MapCreateBulk(slice any, setFunc func(*PooledManagerCreate, int)) *PooledManagerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PooledManagerCreateBulk{err: fmt.Errorf("calling to PooledManagerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PooledManagerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PooledManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PooledManager.
+func (c *PooledManagerClient) Update() *PooledManagerUpdate {
+	mutation := newPooledManagerMutation(c.config, OpUpdate)
+	return &PooledManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PooledManagerClient) UpdateOne(pm *PooledManager) *PooledManagerUpdateOne {
+	mutation := newPooledManagerMutation(c.config, OpUpdateOne, withPooledManager(pm))
+	return &PooledManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PooledManagerClient) UpdateOneID(id int) *PooledManagerUpdateOne {
+	mutation := newPooledManagerMutation(c.config, OpUpdateOne, withPooledManagerID(id))
+	return &PooledManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PooledManager.
+func (c *PooledManagerClient) Delete() *PooledManagerDelete {
+	mutation := newPooledManagerMutation(c.config, OpDelete)
+	return &PooledManagerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PooledManagerClient) DeleteOne(pm *PooledManager) *PooledManagerDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PooledManagerClient) DeleteOneID(id int) *PooledManagerDeleteOne {
+	builder := c.Delete().Where(pooledmanager.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PooledManagerDeleteOne{builder}
+}
+
+// Query returns a query builder for PooledManager.
+func (c *PooledManagerClient) Query() *PooledManagerQuery {
+	return &PooledManagerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePooledManager},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PooledManager entity by its id.
+func (c *PooledManagerClient) Get(ctx context.Context, id int) (*PooledManager, error) {
+	return c.Query().Where(pooledmanager.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PooledManagerClient) GetX(ctx context.Context, id int) *PooledManager {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PooledManagerClient) Hooks() []Hook {
+	return c.hooks.PooledManager
+}
+
+// Interceptors returns the client interceptors.
+func (c *PooledManagerClient) Interceptors() []Interceptor {
+	return c.inters.PooledManager
+}
+
+func (c *PooledManagerClient) mutate(ctx context.Context, m *PooledManagerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PooledManagerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PooledManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PooledManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PooledManagerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown PooledManager mutation op: %q", m.Op())
+	}
+}
+
 // ProblemClient is a client for the Problem schema.
 type ProblemClient struct {
 	config
@@ -997,9 +1563,11 @@ func (c *ProblemClient) mutate(ctx context.Context, m *ProblemMutation) (Value,
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Hook
+		Chat, FailedJob, Job, JobAttempt, ManagerCapacity, ManagerSkill, Message,
+		PooledManager, Problem []ent.Hook
 	}
 	inters struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Interceptor
+		Chat, FailedJob, Job, JobAttempt, ManagerCapacity, ManagerSkill, Message,
+		PooledManager, Problem []ent.Interceptor
 	}
 )
@@ -104,11 +104,31 @@ func (db *Database) Job(ctx context.Context) *JobClient {
 	return db.loadClient(ctx).Job
 }
 
+// JobAttempt is the client for interacting with the JobAttempt builders.
+func (db *Database) JobAttempt(ctx context.Context) *JobAttemptClient {
+	return db.loadClient(ctx).JobAttempt
+}
+
+// ManagerCapacity is the client for interacting with the ManagerCapacity builders.
+func (db *Database) ManagerCapacity(ctx context.Context) *ManagerCapacityClient {
+	return db.loadClient(ctx).ManagerCapacity
+}
+
+// ManagerSkill is the client for interacting with the ManagerSkill builders.
+func (db *Database) ManagerSkill(ctx context.Context) *ManagerSkillClient {
+	return db.loadClient(ctx).ManagerSkill
+}
+
 // Message is the client for interacting with the Message builders.
 func (db *Database) Message(ctx context.Context) *MessageClient {
 	return db.loadClient(ctx).Message
 }
 
+// PooledManager is the client for interacting with the PooledManager builders.
+func (db *Database) PooledManager(ctx context.Context) *PooledManagerClient {
+	return db.loadClient(ctx).PooledManager
+}
+
 // Problem is the client for interacting with the Problem builders.
 func (db *Database) Problem(ctx context.Context) *ProblemClient {
 	return db.loadClient(ctx).Problem
@@ -15,7 +15,11 @@ import (
 	"github.com/keepcalmist/chat-service/internal/store/chat"
 	"github.com/keepcalmist/chat-service/internal/store/failedjob"
 	"github.com/keepcalmist/chat-service/internal/store/job"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
 	"github.com/keepcalmist/chat-service/internal/store/message"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
 	"github.com/keepcalmist/chat-service/internal/store/problem"
 )
 
@@ -77,11 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chat.Table:      chat.ValidColumn,
-			failedjob.Table: failedjob.ValidColumn,
-			job.Table:       job.ValidColumn,
-			message.Table:   message.ValidColumn,
-			problem.Table:   problem.ValidColumn,
+			chat.Table:            chat.ValidColumn,
+			failedjob.Table:       failedjob.ValidColumn,
+			job.Table:             job.ValidColumn,
+			jobattempt.Table:      jobattempt.ValidColumn,
+			managercapacity.Table: managercapacity.ValidColumn,
+			managerskill.Table:    managerskill.ValidColumn,
+			message.Table:         message.ValidColumn,
+			pooledmanager.Table:   pooledmanager.ValidColumn,
+			problem.Table:         problem.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -24,6 +24,10 @@ type FailedJob struct {
 	Payload string `json:"payload,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
+	// Requeues holds the value of the "requeues" field.
+	Requeues int `json:"requeues,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID types.JobID `json:"job_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -34,12 +38,16 @@ func (*FailedJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case failedjob.FieldRequeues:
+			values[i] = new(sql.NullInt64)
 		case failedjob.FieldName, failedjob.FieldPayload, failedjob.FieldReason:
 			values[i] = new(sql.NullString)
 		case failedjob.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case failedjob.FieldID:
 			values[i] = new(types.FailedJobID)
+		case failedjob.FieldJobID:
+			values[i] = new(types.JobID)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -79,6 +87,18 @@ func (fj *FailedJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fj.Reason = value.String
 			}
+		case failedjob.FieldRequeues:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requeues", values[i])
+			} else if value.Valid {
+				fj.Requeues = int(value.Int64)
+			}
+		case failedjob.FieldJobID:
+			if value, ok := values[i].(*types.JobID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value != nil {
+				fj.JobID = *value
+			}
 		case failedjob.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -130,6 +150,12 @@ func (fj *FailedJob) String() string {
 	builder.WriteString("reason=")
 	builder.WriteString(fj.Reason)
 	builder.WriteString(", ")
+	builder.WriteString("requeues=")
+	builder.WriteString(fmt.Sprintf("%v", fj.Requeues))
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", fj.JobID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fj.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -20,6 +20,10 @@ const (
 	FieldPayload = "payload"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
+	// FieldRequeues holds the string denoting the requeues field in the database.
+	FieldRequeues = "requeues"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the failedjob in the database.
@@ -32,6 +36,8 @@ var Columns = []string{
 	FieldName,
 	FieldPayload,
 	FieldReason,
+	FieldRequeues,
+	FieldJobID,
 	FieldCreatedAt,
 }
 
@@ -52,6 +58,10 @@ var (
 	PayloadValidator func(string) error
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
+	// DefaultRequeues holds the default value on creation for the "requeues" field.
+	DefaultRequeues int
+	// RequeuesValidator is a validator for the "requeues" field. It is called by the builders before save.
+	RequeuesValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -81,6 +91,16 @@ func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
 }
 
+// ByRequeues orders the results by the requeues field.
+func ByRequeues(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeues, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
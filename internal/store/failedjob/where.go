@@ -70,6 +70,16 @@ func Reason(v string) predicate.FailedJob {
 	return predicate.FailedJob(sql.FieldEQ(FieldReason, v))
 }
 
+// Requeues applies equality check predicate on the "requeues" field. It's identical to RequeuesEQ.
+func Requeues(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldEQ(FieldRequeues, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldEQ(FieldJobID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.FailedJob {
 	return predicate.FailedJob(sql.FieldEQ(FieldCreatedAt, v))
@@ -270,6 +280,96 @@ func ReasonContainsFold(v string) predicate.FailedJob {
 	return predicate.FailedJob(sql.FieldContainsFold(FieldReason, v))
 }
 
+// RequeuesEQ applies the EQ predicate on the "requeues" field.
+func RequeuesEQ(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldEQ(FieldRequeues, v))
+}
+
+// RequeuesNEQ applies the NEQ predicate on the "requeues" field.
+func RequeuesNEQ(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldNEQ(FieldRequeues, v))
+}
+
+// RequeuesIn applies the In predicate on the "requeues" field.
+func RequeuesIn(vs ...int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldIn(FieldRequeues, vs...))
+}
+
+// RequeuesNotIn applies the NotIn predicate on the "requeues" field.
+func RequeuesNotIn(vs ...int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldNotIn(FieldRequeues, vs...))
+}
+
+// RequeuesGT applies the GT predicate on the "requeues" field.
+func RequeuesGT(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldGT(FieldRequeues, v))
+}
+
+// RequeuesGTE applies the GTE predicate on the "requeues" field.
+func RequeuesGTE(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldGTE(FieldRequeues, v))
+}
+
+// RequeuesLT applies the LT predicate on the "requeues" field.
+func RequeuesLT(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldLT(FieldRequeues, v))
+}
+
+// RequeuesLTE applies the LTE predicate on the "requeues" field.
+func RequeuesLTE(v int) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldLTE(FieldRequeues, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v types.JobID) predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDIsNil applies the IsNil predicate on the "job_id" field.
+func JobIDIsNil() predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldIsNull(FieldJobID))
+}
+
+// JobIDNotNil applies the NotNil predicate on the "job_id" field.
+func JobIDNotNil() predicate.FailedJob {
+	return predicate.FailedJob(sql.FieldNotNull(FieldJobID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.FailedJob {
 	return predicate.FailedJob(sql.FieldEQ(FieldCreatedAt, v))
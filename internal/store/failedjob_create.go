@@ -42,6 +42,34 @@ func (fjc *FailedJobCreate) SetReason(s string) *FailedJobCreate {
 	return fjc
 }
 
+// SetRequeues sets the "requeues" field.
+func (fjc *FailedJobCreate) SetRequeues(i int) *FailedJobCreate {
+	fjc.mutation.SetRequeues(i)
+	return fjc
+}
+
+// SetNillableRequeues sets the "requeues" field if the given value is not nil.
+func (fjc *FailedJobCreate) SetNillableRequeues(i *int) *FailedJobCreate {
+	if i != nil {
+		fjc.SetRequeues(*i)
+	}
+	return fjc
+}
+
+// SetJobID sets the "job_id" field.
+func (fjc *FailedJobCreate) SetJobID(ti types.JobID) *FailedJobCreate {
+	fjc.mutation.SetJobID(ti)
+	return fjc
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (fjc *FailedJobCreate) SetNillableJobID(ti *types.JobID) *FailedJobCreate {
+	if ti != nil {
+		fjc.SetJobID(*ti)
+	}
+	return fjc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fjc *FailedJobCreate) SetCreatedAt(t time.Time) *FailedJobCreate {
 	fjc.mutation.SetCreatedAt(t)
@@ -105,6 +133,10 @@ func (fjc *FailedJobCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (fjc *FailedJobCreate) defaults() {
+	if _, ok := fjc.mutation.Requeues(); !ok {
+		v := failedjob.DefaultRequeues
+		fjc.mutation.SetRequeues(v)
+	}
 	if _, ok := fjc.mutation.CreatedAt(); !ok {
 		v := failedjob.DefaultCreatedAt()
 		fjc.mutation.SetCreatedAt(v)
@@ -141,6 +173,19 @@ func (fjc *FailedJobCreate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`store: validator failed for field "FailedJob.reason": %w`, err)}
 		}
 	}
+	if _, ok := fjc.mutation.Requeues(); !ok {
+		return &ValidationError{Name: "requeues", err: errors.New(`store: missing required field "FailedJob.requeues"`)}
+	}
+	if v, ok := fjc.mutation.Requeues(); ok {
+		if err := failedjob.RequeuesValidator(v); err != nil {
+			return &ValidationError{Name: "requeues", err: fmt.Errorf(`store: validator failed for field "FailedJob.requeues": %w`, err)}
+		}
+	}
+	if v, ok := fjc.mutation.JobID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "job_id", err: fmt.Errorf(`store: validator failed for field "FailedJob.job_id": %w`, err)}
+		}
+	}
 	if _, ok := fjc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "FailedJob.created_at"`)}
 	}
@@ -197,6 +242,14 @@ func (fjc *FailedJobCreate) createSpec() (*FailedJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(failedjob.FieldReason, field.TypeString, value)
 		_node.Reason = value
 	}
+	if value, ok := fjc.mutation.Requeues(); ok {
+		_spec.SetField(failedjob.FieldRequeues, field.TypeInt, value)
+		_node.Requeues = value
+	}
+	if value, ok := fjc.mutation.JobID(); ok {
+		_spec.SetField(failedjob.FieldJobID, field.TypeUUID, value)
+		_node.JobID = value
+	}
 	if value, ok := fjc.mutation.CreatedAt(); ok {
 		_spec.SetField(failedjob.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -279,6 +332,12 @@ func (u *FailedJobUpsertOne) UpdateNewValues() *FailedJobUpsertOne {
 		if _, exists := u.create.mutation.Reason(); exists {
 			s.SetIgnore(failedjob.FieldReason)
 		}
+		if _, exists := u.create.mutation.Requeues(); exists {
+			s.SetIgnore(failedjob.FieldRequeues)
+		}
+		if _, exists := u.create.mutation.JobID(); exists {
+			s.SetIgnore(failedjob.FieldJobID)
+		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(failedjob.FieldCreatedAt)
 		}
@@ -505,6 +564,12 @@ func (u *FailedJobUpsertBulk) UpdateNewValues() *FailedJobUpsertBulk {
 			if _, exists := b.mutation.Reason(); exists {
 				s.SetIgnore(failedjob.FieldReason)
 			}
+			if _, exists := b.mutation.Requeues(); exists {
+				s.SetIgnore(failedjob.FieldRequeues)
+			}
+			if _, exists := b.mutation.JobID(); exists {
+				s.SetIgnore(failedjob.FieldJobID)
+			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(failedjob.FieldCreatedAt)
 			}
@@ -68,6 +68,9 @@ func (fju *FailedJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if fju.mutation.JobIDCleared() {
+		_spec.ClearField(failedjob.FieldJobID, field.TypeUUID)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fju.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{failedjob.Label}
@@ -159,6 +162,9 @@ func (fjuo *FailedJobUpdateOne) sqlSave(ctx context.Context) (_node *FailedJob,
 			}
 		}
 	}
+	if fjuo.mutation.JobIDCleared() {
+		_spec.ClearField(failedjob.FieldJobID, field.TypeUUID)
+	}
 	_node = &FailedJob{config: fjuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -45,6 +45,42 @@ func (f JobFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.JobMutation", m)
 }
 
+// The JobAttemptFunc type is an adapter to allow the use of ordinary
+// function as JobAttempt mutator.
+type JobAttemptFunc func(context.Context, *store.JobAttemptMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobAttemptFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.JobAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.JobAttemptMutation", m)
+}
+
+// The ManagerCapacityFunc type is an adapter to allow the use of ordinary
+// function as ManagerCapacity mutator.
+type ManagerCapacityFunc func(context.Context, *store.ManagerCapacityMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ManagerCapacityFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.ManagerCapacityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ManagerCapacityMutation", m)
+}
+
+// The ManagerSkillFunc type is an adapter to allow the use of ordinary
+// function as ManagerSkill mutator.
+type ManagerSkillFunc func(context.Context, *store.ManagerSkillMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ManagerSkillFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.ManagerSkillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ManagerSkillMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *store.MessageMutation) (store.Value, error)
@@ -57,6 +93,18 @@ func (f MessageFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageMutation", m)
 }
 
+// The PooledManagerFunc type is an adapter to allow the use of ordinary
+// function as PooledManager mutator.
+type PooledManagerFunc func(context.Context, *store.PooledManagerMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PooledManagerFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.PooledManagerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.PooledManagerMutation", m)
+}
+
 // The ProblemFunc type is an adapter to allow the use of ordinary
 // function as Problem mutator.
 type ProblemFunc func(context.Context, *store.ProblemMutation) (store.Value, error)
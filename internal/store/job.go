@@ -28,6 +28,14 @@ type Job struct {
 	AvailableAt time.Time `json:"available_at,omitempty"`
 	// ReservedUntil holds the value of the "reserved_until" field.
 	ReservedUntil time.Time `json:"reserved_until,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Requeues holds the value of the "requeues" field.
+	Requeues int `json:"requeues,omitempty"`
+	// OrderingKey holds the value of the "ordering_key" field.
+	OrderingKey string `json:"ordering_key,omitempty"`
+	// DedupKey holds the value of the "dedup_key" field.
+	DedupKey *string `json:"dedup_key,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -38,9 +46,9 @@ func (*Job) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case job.FieldAttempts:
+		case job.FieldAttempts, job.FieldRequeues:
 			values[i] = new(sql.NullInt64)
-		case job.FieldName, job.FieldPayload:
+		case job.FieldName, job.FieldPayload, job.FieldLastError, job.FieldOrderingKey, job.FieldDedupKey:
 			values[i] = new(sql.NullString)
 		case job.FieldAvailableAt, job.FieldReservedUntil, job.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -97,6 +105,31 @@ func (j *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				j.ReservedUntil = value.Time
 			}
+		case job.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				j.LastError = value.String
+			}
+		case job.FieldRequeues:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requeues", values[i])
+			} else if value.Valid {
+				j.Requeues = int(value.Int64)
+			}
+		case job.FieldOrderingKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ordering_key", values[i])
+			} else if value.Valid {
+				j.OrderingKey = value.String
+			}
+		case job.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				j.DedupKey = new(string)
+				*j.DedupKey = value.String
+			}
 		case job.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -154,6 +187,20 @@ func (j *Job) String() string {
 	builder.WriteString("reserved_until=")
 	builder.WriteString(j.ReservedUntil.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(j.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("requeues=")
+	builder.WriteString(fmt.Sprintf("%v", j.Requeues))
+	builder.WriteString(", ")
+	builder.WriteString("ordering_key=")
+	builder.WriteString(j.OrderingKey)
+	builder.WriteString(", ")
+	if v := j.DedupKey; v != nil {
+		builder.WriteString("dedup_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(j.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -24,6 +24,14 @@ const (
 	FieldAvailableAt = "available_at"
 	// FieldReservedUntil holds the string denoting the reserved_until field in the database.
 	FieldReservedUntil = "reserved_until"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldRequeues holds the string denoting the requeues field in the database.
+	FieldRequeues = "requeues"
+	// FieldOrderingKey holds the string denoting the ordering_key field in the database.
+	FieldOrderingKey = "ordering_key"
+	// FieldDedupKey holds the string denoting the dedup_key field in the database.
+	FieldDedupKey = "dedup_key"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the job in the database.
@@ -38,6 +46,10 @@ var Columns = []string{
 	FieldAttempts,
 	FieldAvailableAt,
 	FieldReservedUntil,
+	FieldLastError,
+	FieldRequeues,
+	FieldOrderingKey,
+	FieldDedupKey,
 	FieldCreatedAt,
 }
 
@@ -64,6 +76,10 @@ var (
 	DefaultAvailableAt func() time.Time
 	// DefaultReservedUntil holds the default value on creation for the "reserved_until" field.
 	DefaultReservedUntil func() time.Time
+	// DefaultRequeues holds the default value on creation for the "requeues" field.
+	DefaultRequeues int
+	// RequeuesValidator is a validator for the "requeues" field. It is called by the builders before save.
+	RequeuesValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -103,6 +119,26 @@ func ByReservedUntil(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReservedUntil, opts...).ToFunc()
 }
 
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByRequeues orders the results by the requeues field.
+func ByRequeues(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeues, opts...).ToFunc()
+}
+
+// ByOrderingKey orders the results by the ordering_key field.
+func ByOrderingKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderingKey, opts...).ToFunc()
+}
+
+// ByDedupKey orders the results by the dedup_key field.
+func ByDedupKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupKey, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
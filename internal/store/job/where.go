@@ -80,6 +80,26 @@ func ReservedUntil(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldReservedUntil, v))
 }
 
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// Requeues applies equality check predicate on the "requeues" field. It's identical to RequeuesEQ.
+func Requeues(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRequeues, v))
+}
+
+// OrderingKey applies equality check predicate on the "ordering_key" field. It's identical to OrderingKeyEQ.
+func OrderingKey(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldOrderingKey, v))
+}
+
+// DedupKey applies equality check predicate on the "dedup_key" field. It's identical to DedupKeyEQ.
+func DedupKey(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDedupKey, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
@@ -335,6 +355,271 @@ func ReservedUntilLTE(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldLTE(FieldReservedUntil, v))
 }
 
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// RequeuesEQ applies the EQ predicate on the "requeues" field.
+func RequeuesEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRequeues, v))
+}
+
+// RequeuesNEQ applies the NEQ predicate on the "requeues" field.
+func RequeuesNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldRequeues, v))
+}
+
+// RequeuesIn applies the In predicate on the "requeues" field.
+func RequeuesIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldRequeues, vs...))
+}
+
+// RequeuesNotIn applies the NotIn predicate on the "requeues" field.
+func RequeuesNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldRequeues, vs...))
+}
+
+// RequeuesGT applies the GT predicate on the "requeues" field.
+func RequeuesGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldRequeues, v))
+}
+
+// RequeuesGTE applies the GTE predicate on the "requeues" field.
+func RequeuesGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldRequeues, v))
+}
+
+// RequeuesLT applies the LT predicate on the "requeues" field.
+func RequeuesLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldRequeues, v))
+}
+
+// RequeuesLTE applies the LTE predicate on the "requeues" field.
+func RequeuesLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldRequeues, v))
+}
+
+// OrderingKeyEQ applies the EQ predicate on the "ordering_key" field.
+func OrderingKeyEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldOrderingKey, v))
+}
+
+// OrderingKeyNEQ applies the NEQ predicate on the "ordering_key" field.
+func OrderingKeyNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldOrderingKey, v))
+}
+
+// OrderingKeyIn applies the In predicate on the "ordering_key" field.
+func OrderingKeyIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldOrderingKey, vs...))
+}
+
+// OrderingKeyNotIn applies the NotIn predicate on the "ordering_key" field.
+func OrderingKeyNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldOrderingKey, vs...))
+}
+
+// OrderingKeyGT applies the GT predicate on the "ordering_key" field.
+func OrderingKeyGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldOrderingKey, v))
+}
+
+// OrderingKeyGTE applies the GTE predicate on the "ordering_key" field.
+func OrderingKeyGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldOrderingKey, v))
+}
+
+// OrderingKeyLT applies the LT predicate on the "ordering_key" field.
+func OrderingKeyLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldOrderingKey, v))
+}
+
+// OrderingKeyLTE applies the LTE predicate on the "ordering_key" field.
+func OrderingKeyLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldOrderingKey, v))
+}
+
+// OrderingKeyContains applies the Contains predicate on the "ordering_key" field.
+func OrderingKeyContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldOrderingKey, v))
+}
+
+// OrderingKeyHasPrefix applies the HasPrefix predicate on the "ordering_key" field.
+func OrderingKeyHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldOrderingKey, v))
+}
+
+// OrderingKeyHasSuffix applies the HasSuffix predicate on the "ordering_key" field.
+func OrderingKeyHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldOrderingKey, v))
+}
+
+// OrderingKeyIsNil applies the IsNil predicate on the "ordering_key" field.
+func OrderingKeyIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldOrderingKey))
+}
+
+// OrderingKeyNotNil applies the NotNil predicate on the "ordering_key" field.
+func OrderingKeyNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldOrderingKey))
+}
+
+// OrderingKeyEqualFold applies the EqualFold predicate on the "ordering_key" field.
+func OrderingKeyEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldOrderingKey, v))
+}
+
+// OrderingKeyContainsFold applies the ContainsFold predicate on the "ordering_key" field.
+func OrderingKeyContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldOrderingKey, v))
+}
+
+// DedupKeyEQ applies the EQ predicate on the "dedup_key" field.
+func DedupKeyEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDedupKey, v))
+}
+
+// DedupKeyNEQ applies the NEQ predicate on the "dedup_key" field.
+func DedupKeyNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDedupKey, v))
+}
+
+// DedupKeyIn applies the In predicate on the "dedup_key" field.
+func DedupKeyIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDedupKey, vs...))
+}
+
+// DedupKeyNotIn applies the NotIn predicate on the "dedup_key" field.
+func DedupKeyNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDedupKey, vs...))
+}
+
+// DedupKeyGT applies the GT predicate on the "dedup_key" field.
+func DedupKeyGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDedupKey, v))
+}
+
+// DedupKeyGTE applies the GTE predicate on the "dedup_key" field.
+func DedupKeyGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDedupKey, v))
+}
+
+// DedupKeyLT applies the LT predicate on the "dedup_key" field.
+func DedupKeyLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDedupKey, v))
+}
+
+// DedupKeyLTE applies the LTE predicate on the "dedup_key" field.
+func DedupKeyLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDedupKey, v))
+}
+
+// DedupKeyContains applies the Contains predicate on the "dedup_key" field.
+func DedupKeyContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldDedupKey, v))
+}
+
+// DedupKeyHasPrefix applies the HasPrefix predicate on the "dedup_key" field.
+func DedupKeyHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldDedupKey, v))
+}
+
+// DedupKeyHasSuffix applies the HasSuffix predicate on the "dedup_key" field.
+func DedupKeyHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldDedupKey, v))
+}
+
+// DedupKeyIsNil applies the IsNil predicate on the "dedup_key" field.
+func DedupKeyIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldDedupKey))
+}
+
+// DedupKeyNotNil applies the NotNil predicate on the "dedup_key" field.
+func DedupKeyNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldDedupKey))
+}
+
+// DedupKeyEqualFold applies the EqualFold predicate on the "dedup_key" field.
+func DedupKeyEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldDedupKey, v))
+}
+
+// DedupKeyContainsFold applies the ContainsFold predicate on the "dedup_key" field.
+func DedupKeyContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldDedupKey, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
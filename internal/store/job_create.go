@@ -78,6 +78,62 @@ func (jc *JobCreate) SetNillableReservedUntil(t *time.Time) *JobCreate {
 	return jc
 }
 
+// SetLastError sets the "last_error" field.
+func (jc *JobCreate) SetLastError(s string) *JobCreate {
+	jc.mutation.SetLastError(s)
+	return jc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLastError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLastError(*s)
+	}
+	return jc
+}
+
+// SetRequeues sets the "requeues" field.
+func (jc *JobCreate) SetRequeues(i int) *JobCreate {
+	jc.mutation.SetRequeues(i)
+	return jc
+}
+
+// SetNillableRequeues sets the "requeues" field if the given value is not nil.
+func (jc *JobCreate) SetNillableRequeues(i *int) *JobCreate {
+	if i != nil {
+		jc.SetRequeues(*i)
+	}
+	return jc
+}
+
+// SetOrderingKey sets the "ordering_key" field.
+func (jc *JobCreate) SetOrderingKey(s string) *JobCreate {
+	jc.mutation.SetOrderingKey(s)
+	return jc
+}
+
+// SetNillableOrderingKey sets the "ordering_key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableOrderingKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetOrderingKey(*s)
+	}
+	return jc
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (jc *JobCreate) SetDedupKey(s string) *JobCreate {
+	jc.mutation.SetDedupKey(s)
+	return jc
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableDedupKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetDedupKey(*s)
+	}
+	return jc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
 	jc.mutation.SetCreatedAt(t)
@@ -153,6 +209,10 @@ func (jc *JobCreate) defaults() {
 		v := job.DefaultReservedUntil()
 		jc.mutation.SetReservedUntil(v)
 	}
+	if _, ok := jc.mutation.Requeues(); !ok {
+		v := job.DefaultRequeues
+		jc.mutation.SetRequeues(v)
+	}
 	if _, ok := jc.mutation.CreatedAt(); !ok {
 		v := job.DefaultCreatedAt()
 		jc.mutation.SetCreatedAt(v)
@@ -195,6 +255,14 @@ func (jc *JobCreate) check() error {
 	if _, ok := jc.mutation.ReservedUntil(); !ok {
 		return &ValidationError{Name: "reserved_until", err: errors.New(`store: missing required field "Job.reserved_until"`)}
 	}
+	if _, ok := jc.mutation.Requeues(); !ok {
+		return &ValidationError{Name: "requeues", err: errors.New(`store: missing required field "Job.requeues"`)}
+	}
+	if v, ok := jc.mutation.Requeues(); ok {
+		if err := job.RequeuesValidator(v); err != nil {
+			return &ValidationError{Name: "requeues", err: fmt.Errorf(`store: validator failed for field "Job.requeues": %w`, err)}
+		}
+	}
 	if _, ok := jc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Job.created_at"`)}
 	}
@@ -259,6 +327,22 @@ func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldReservedUntil, field.TypeTime, value)
 		_node.ReservedUntil = value
 	}
+	if value, ok := jc.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := jc.mutation.Requeues(); ok {
+		_spec.SetField(job.FieldRequeues, field.TypeInt, value)
+		_node.Requeues = value
+	}
+	if value, ok := jc.mutation.OrderingKey(); ok {
+		_spec.SetField(job.FieldOrderingKey, field.TypeString, value)
+		_node.OrderingKey = value
+	}
+	if value, ok := jc.mutation.DedupKey(); ok {
+		_spec.SetField(job.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = &value
+	}
 	if value, ok := jc.mutation.CreatedAt(); ok {
 		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -333,6 +417,18 @@ func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
 	return u
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsert) SetAvailableAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldAvailableAt, v)
+	return u
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAvailableAt() *JobUpsert {
+	u.SetExcluded(job.FieldAvailableAt)
+	return u
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsert) SetReservedUntil(v time.Time) *JobUpsert {
 	u.Set(job.FieldReservedUntil, v)
@@ -345,6 +441,24 @@ func (u *JobUpsert) UpdateReservedUntil() *JobUpsert {
 	return u
 }
 
+// SetLastError sets the "last_error" field.
+func (u *JobUpsert) SetLastError(v string) *JobUpsert {
+	u.Set(job.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLastError() *JobUpsert {
+	u.SetExcluded(job.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsert) ClearLastError() *JobUpsert {
+	u.SetNull(job.FieldLastError)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -368,8 +482,14 @@ func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
 		if _, exists := u.create.mutation.Payload(); exists {
 			s.SetIgnore(job.FieldPayload)
 		}
-		if _, exists := u.create.mutation.AvailableAt(); exists {
-			s.SetIgnore(job.FieldAvailableAt)
+		if _, exists := u.create.mutation.Requeues(); exists {
+			s.SetIgnore(job.FieldRequeues)
+		}
+		if _, exists := u.create.mutation.OrderingKey(); exists {
+			s.SetIgnore(job.FieldOrderingKey)
+		}
+		if _, exists := u.create.mutation.DedupKey(); exists {
+			s.SetIgnore(job.FieldDedupKey)
 		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(job.FieldCreatedAt)
@@ -426,6 +546,20 @@ func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
 	})
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsertOne) SetAvailableAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAvailableAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsertOne) SetReservedUntil(v time.Time) *JobUpsertOne {
 	return u.Update(func(s *JobUpsert) {
@@ -440,6 +574,27 @@ func (u *JobUpsertOne) UpdateReservedUntil() *JobUpsertOne {
 	})
 }
 
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertOne) SetLastError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertOne) ClearLastError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
 // Exec executes the query.
 func (u *JobUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -629,8 +784,14 @@ func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
 			if _, exists := b.mutation.Payload(); exists {
 				s.SetIgnore(job.FieldPayload)
 			}
-			if _, exists := b.mutation.AvailableAt(); exists {
-				s.SetIgnore(job.FieldAvailableAt)
+			if _, exists := b.mutation.Requeues(); exists {
+				s.SetIgnore(job.FieldRequeues)
+			}
+			if _, exists := b.mutation.OrderingKey(); exists {
+				s.SetIgnore(job.FieldOrderingKey)
+			}
+			if _, exists := b.mutation.DedupKey(); exists {
+				s.SetIgnore(job.FieldDedupKey)
 			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(job.FieldCreatedAt)
@@ -688,6 +849,20 @@ func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
 	})
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsertBulk) SetAvailableAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAvailableAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsertBulk) SetReservedUntil(v time.Time) *JobUpsertBulk {
 	return u.Update(func(s *JobUpsert) {
@@ -702,6 +877,27 @@ func (u *JobUpsertBulk) UpdateReservedUntil() *JobUpsertBulk {
 	})
 }
 
+// SetLastError sets the "last_error" field.
+func (u *JobUpsertBulk) SetLastError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobUpsertBulk) ClearLastError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLastError()
+	})
+}
+
 // Exec executes the query.
 func (u *JobUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
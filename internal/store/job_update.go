@@ -49,6 +49,20 @@ func (ju *JobUpdate) AddAttempts(i int) *JobUpdate {
 	return ju
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (ju *JobUpdate) SetAvailableAt(t time.Time) *JobUpdate {
+	ju.mutation.SetAvailableAt(t)
+	return ju
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableAvailableAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetAvailableAt(*t)
+	}
+	return ju
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (ju *JobUpdate) SetReservedUntil(t time.Time) *JobUpdate {
 	ju.mutation.SetReservedUntil(t)
@@ -63,6 +77,26 @@ func (ju *JobUpdate) SetNillableReservedUntil(t *time.Time) *JobUpdate {
 	return ju
 }
 
+// SetLastError sets the "last_error" field.
+func (ju *JobUpdate) SetLastError(s string) *JobUpdate {
+	ju.mutation.SetLastError(s)
+	return ju
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLastError(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLastError(*s)
+	}
+	return ju
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ju *JobUpdate) ClearLastError() *JobUpdate {
+	ju.mutation.ClearLastError()
+	return ju
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (ju *JobUpdate) Mutation() *JobMutation {
 	return ju.mutation
@@ -123,9 +157,24 @@ func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ju.mutation.AddedAttempts(); ok {
 		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
 	}
+	if value, ok := ju.mutation.AvailableAt(); ok {
+		_spec.SetField(job.FieldAvailableAt, field.TypeTime, value)
+	}
 	if value, ok := ju.mutation.ReservedUntil(); ok {
 		_spec.SetField(job.FieldReservedUntil, field.TypeTime, value)
 	}
+	if value, ok := ju.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if ju.mutation.LastErrorCleared() {
+		_spec.ClearField(job.FieldLastError, field.TypeString)
+	}
+	if ju.mutation.OrderingKeyCleared() {
+		_spec.ClearField(job.FieldOrderingKey, field.TypeString)
+	}
+	if ju.mutation.DedupKeyCleared() {
+		_spec.ClearField(job.FieldDedupKey, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{job.Label}
@@ -167,6 +216,20 @@ func (juo *JobUpdateOne) AddAttempts(i int) *JobUpdateOne {
 	return juo
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (juo *JobUpdateOne) SetAvailableAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetAvailableAt(t)
+	return juo
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableAvailableAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetAvailableAt(*t)
+	}
+	return juo
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (juo *JobUpdateOne) SetReservedUntil(t time.Time) *JobUpdateOne {
 	juo.mutation.SetReservedUntil(t)
@@ -181,6 +244,26 @@ func (juo *JobUpdateOne) SetNillableReservedUntil(t *time.Time) *JobUpdateOne {
 	return juo
 }
 
+// SetLastError sets the "last_error" field.
+func (juo *JobUpdateOne) SetLastError(s string) *JobUpdateOne {
+	juo.mutation.SetLastError(s)
+	return juo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLastError(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLastError(*s)
+	}
+	return juo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (juo *JobUpdateOne) ClearLastError() *JobUpdateOne {
+	juo.mutation.ClearLastError()
+	return juo
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (juo *JobUpdateOne) Mutation() *JobMutation {
 	return juo.mutation
@@ -271,9 +354,24 @@ func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 	if value, ok := juo.mutation.AddedAttempts(); ok {
 		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
 	}
+	if value, ok := juo.mutation.AvailableAt(); ok {
+		_spec.SetField(job.FieldAvailableAt, field.TypeTime, value)
+	}
 	if value, ok := juo.mutation.ReservedUntil(); ok {
 		_spec.SetField(job.FieldReservedUntil, field.TypeTime, value)
 	}
+	if value, ok := juo.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if juo.mutation.LastErrorCleared() {
+		_spec.ClearField(job.FieldLastError, field.TypeString)
+	}
+	if juo.mutation.OrderingKeyCleared() {
+		_spec.ClearField(job.FieldOrderingKey, field.TypeString)
+	}
+	if juo.mutation.DedupKeyCleared() {
+		_spec.ClearField(job.FieldDedupKey, field.TypeString)
+	}
 	_node = &Job{config: juo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// JobAttempt is the model entity for the JobAttempt schema.
+type JobAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.JobAttemptID `json:"id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID types.JobID `json:"job_id,omitempty"`
+	// JobName holds the value of the "job_name" field.
+	JobName string `json:"job_name,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
+	// Worker holds the value of the "worker" field.
+	Worker string `json:"worker,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Outcome holds the value of the "outcome" field.
+	Outcome jobattempt.Outcome `json:"outcome,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobattempt.FieldAttempt:
+			values[i] = new(sql.NullInt64)
+		case jobattempt.FieldJobName, jobattempt.FieldWorker, jobattempt.FieldOutcome, jobattempt.FieldError:
+			values[i] = new(sql.NullString)
+		case jobattempt.FieldStartedAt, jobattempt.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case jobattempt.FieldID:
+			values[i] = new(types.JobAttemptID)
+		case jobattempt.FieldJobID:
+			values[i] = new(types.JobID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobAttempt fields.
+func (ja *JobAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobattempt.FieldID:
+			if value, ok := values[i].(*types.JobAttemptID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ja.ID = *value
+			}
+		case jobattempt.FieldJobID:
+			if value, ok := values[i].(*types.JobID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value != nil {
+				ja.JobID = *value
+			}
+		case jobattempt.FieldJobName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_name", values[i])
+			} else if value.Valid {
+				ja.JobName = value.String
+			}
+		case jobattempt.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				ja.Attempt = int(value.Int64)
+			}
+		case jobattempt.FieldWorker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker", values[i])
+			} else if value.Valid {
+				ja.Worker = value.String
+			}
+		case jobattempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				ja.StartedAt = value.Time
+			}
+		case jobattempt.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				ja.FinishedAt = value.Time
+			}
+		case jobattempt.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				ja.Outcome = jobattempt.Outcome(value.String)
+			}
+		case jobattempt.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ja.Error = value.String
+			}
+		default:
+			ja.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobAttempt.
+// This includes values selected through modifiers, order, etc.
+func (ja *JobAttempt) Value(name string) (ent.Value, error) {
+	return ja.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobAttempt.
+// Note that you need to call JobAttempt.Unwrap() before calling this method if this JobAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ja *JobAttempt) Update() *JobAttemptUpdateOne {
+	return NewJobAttemptClient(ja.config).UpdateOne(ja)
+}
+
+// Unwrap unwraps the JobAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ja *JobAttempt) Unwrap() *JobAttempt {
+	_tx, ok := ja.config.driver.(*txDriver)
+	if !ok {
+		panic("store: JobAttempt is not a transactional entity")
+	}
+	ja.config.driver = _tx.drv
+	return ja
+}
+
+// String implements the fmt.Stringer.
+func (ja *JobAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ja.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", ja.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("job_name=")
+	builder.WriteString(ja.JobName)
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", ja.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("worker=")
+	builder.WriteString(ja.Worker)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(ja.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(ja.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", ja.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ja.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobAttempts is a parsable slice of JobAttempt.
+type JobAttempts []*JobAttempt
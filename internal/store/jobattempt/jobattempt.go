@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobattempt
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the jobattempt type in the database.
+	Label = "job_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldJobName holds the string denoting the job_name field in the database.
+	FieldJobName = "job_name"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
+	// FieldWorker holds the string denoting the worker field in the database.
+	FieldWorker = "worker"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// Table holds the table name of the jobattempt in the database.
+	Table = "job_attempts"
+)
+
+// Columns holds all SQL columns for jobattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldJobID,
+	FieldJobName,
+	FieldAttempt,
+	FieldWorker,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldOutcome,
+	FieldError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	JobNameValidator func(string) error
+	// AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	AttemptValidator func(int) error
+	// WorkerValidator is a validator for the "worker" field. It is called by the builders before save.
+	WorkerValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.JobAttemptID
+)
+
+// Outcome defines the type for the "outcome" enum field.
+type Outcome string
+
+// Outcome values.
+const (
+	OutcomeSucceeded   Outcome = "succeeded"
+	OutcomeFailed      Outcome = "failed"
+	OutcomeTimedOut    Outcome = "timed_out"
+	OutcomeInterrupted Outcome = "interrupted"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeValidator is a validator for the "outcome" field enum values. It is called by the builders before save.
+func OutcomeValidator(o Outcome) error {
+	switch o {
+	case OutcomeSucceeded, OutcomeFailed, OutcomeTimedOut, OutcomeInterrupted:
+		return nil
+	default:
+		return fmt.Errorf("jobattempt: invalid enum value for outcome field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the JobAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByJobName orders the results by the job_name field.
+func ByJobName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobName, opts...).ToFunc()
+}
+
+// ByAttempt orders the results by the attempt field.
+func ByAttempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempt, opts...).ToFunc()
+}
+
+// ByWorker orders the results by the worker field.
+func ByWorker(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorker, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
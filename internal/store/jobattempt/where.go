@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.JobAttemptID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobName applies equality check predicate on the "job_name" field. It's identical to JobNameEQ.
+func JobName(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldJobName, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// Worker applies equality check predicate on the "worker" field. It's identical to WorkerEQ.
+func Worker(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldWorker, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v types.JobID) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobNameEQ applies the EQ predicate on the "job_name" field.
+func JobNameEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobNameNEQ applies the NEQ predicate on the "job_name" field.
+func JobNameNEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldJobName, v))
+}
+
+// JobNameIn applies the In predicate on the "job_name" field.
+func JobNameIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldJobName, vs...))
+}
+
+// JobNameNotIn applies the NotIn predicate on the "job_name" field.
+func JobNameNotIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldJobName, vs...))
+}
+
+// JobNameGT applies the GT predicate on the "job_name" field.
+func JobNameGT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldJobName, v))
+}
+
+// JobNameGTE applies the GTE predicate on the "job_name" field.
+func JobNameGTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldJobName, v))
+}
+
+// JobNameLT applies the LT predicate on the "job_name" field.
+func JobNameLT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldJobName, v))
+}
+
+// JobNameLTE applies the LTE predicate on the "job_name" field.
+func JobNameLTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldJobName, v))
+}
+
+// JobNameContains applies the Contains predicate on the "job_name" field.
+func JobNameContains(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContains(FieldJobName, v))
+}
+
+// JobNameHasPrefix applies the HasPrefix predicate on the "job_name" field.
+func JobNameHasPrefix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasPrefix(FieldJobName, v))
+}
+
+// JobNameHasSuffix applies the HasSuffix predicate on the "job_name" field.
+func JobNameHasSuffix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasSuffix(FieldJobName, v))
+}
+
+// JobNameEqualFold applies the EqualFold predicate on the "job_name" field.
+func JobNameEqualFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEqualFold(FieldJobName, v))
+}
+
+// JobNameContainsFold applies the ContainsFold predicate on the "job_name" field.
+func JobNameContainsFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContainsFold(FieldJobName, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldAttempt, v))
+}
+
+// WorkerEQ applies the EQ predicate on the "worker" field.
+func WorkerEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldWorker, v))
+}
+
+// WorkerNEQ applies the NEQ predicate on the "worker" field.
+func WorkerNEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldWorker, v))
+}
+
+// WorkerIn applies the In predicate on the "worker" field.
+func WorkerIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldWorker, vs...))
+}
+
+// WorkerNotIn applies the NotIn predicate on the "worker" field.
+func WorkerNotIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldWorker, vs...))
+}
+
+// WorkerGT applies the GT predicate on the "worker" field.
+func WorkerGT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldWorker, v))
+}
+
+// WorkerGTE applies the GTE predicate on the "worker" field.
+func WorkerGTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldWorker, v))
+}
+
+// WorkerLT applies the LT predicate on the "worker" field.
+func WorkerLT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldWorker, v))
+}
+
+// WorkerLTE applies the LTE predicate on the "worker" field.
+func WorkerLTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldWorker, v))
+}
+
+// WorkerContains applies the Contains predicate on the "worker" field.
+func WorkerContains(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContains(FieldWorker, v))
+}
+
+// WorkerHasPrefix applies the HasPrefix predicate on the "worker" field.
+func WorkerHasPrefix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasPrefix(FieldWorker, v))
+}
+
+// WorkerHasSuffix applies the HasSuffix predicate on the "worker" field.
+func WorkerHasSuffix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasSuffix(FieldWorker, v))
+}
+
+// WorkerEqualFold applies the EqualFold predicate on the "worker" field.
+func WorkerEqualFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEqualFold(FieldWorker, v))
+}
+
+// WorkerContainsFold applies the ContainsFold predicate on the "worker" field.
+func WorkerContainsFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContainsFold(FieldWorker, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.FieldContainsFold(FieldError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobAttempt) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobAttempt) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobAttempt) predicate.JobAttempt {
+	return predicate.JobAttempt(sql.NotPredicates(p))
+}
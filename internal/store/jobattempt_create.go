@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// JobAttemptCreate is the builder for creating a JobAttempt entity.
+type JobAttemptCreate struct {
+	config
+	mutation *JobAttemptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobID sets the "job_id" field.
+func (jac *JobAttemptCreate) SetJobID(ti types.JobID) *JobAttemptCreate {
+	jac.mutation.SetJobID(ti)
+	return jac
+}
+
+// SetJobName sets the "job_name" field.
+func (jac *JobAttemptCreate) SetJobName(s string) *JobAttemptCreate {
+	jac.mutation.SetJobName(s)
+	return jac
+}
+
+// SetAttempt sets the "attempt" field.
+func (jac *JobAttemptCreate) SetAttempt(i int) *JobAttemptCreate {
+	jac.mutation.SetAttempt(i)
+	return jac
+}
+
+// SetWorker sets the "worker" field.
+func (jac *JobAttemptCreate) SetWorker(s string) *JobAttemptCreate {
+	jac.mutation.SetWorker(s)
+	return jac
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jac *JobAttemptCreate) SetStartedAt(t time.Time) *JobAttemptCreate {
+	jac.mutation.SetStartedAt(t)
+	return jac
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jac *JobAttemptCreate) SetFinishedAt(t time.Time) *JobAttemptCreate {
+	jac.mutation.SetFinishedAt(t)
+	return jac
+}
+
+// SetOutcome sets the "outcome" field.
+func (jac *JobAttemptCreate) SetOutcome(j jobattempt.Outcome) *JobAttemptCreate {
+	jac.mutation.SetOutcome(j)
+	return jac
+}
+
+// SetError sets the "error" field.
+func (jac *JobAttemptCreate) SetError(s string) *JobAttemptCreate {
+	jac.mutation.SetError(s)
+	return jac
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jac *JobAttemptCreate) SetNillableError(s *string) *JobAttemptCreate {
+	if s != nil {
+		jac.SetError(*s)
+	}
+	return jac
+}
+
+// SetID sets the "id" field.
+func (jac *JobAttemptCreate) SetID(tai types.JobAttemptID) *JobAttemptCreate {
+	jac.mutation.SetID(tai)
+	return jac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (jac *JobAttemptCreate) SetNillableID(tai *types.JobAttemptID) *JobAttemptCreate {
+	if tai != nil {
+		jac.SetID(*tai)
+	}
+	return jac
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jac *JobAttemptCreate) Mutation() *JobAttemptMutation {
+	return jac.mutation
+}
+
+// Save creates the JobAttempt in the database.
+func (jac *JobAttemptCreate) Save(ctx context.Context) (*JobAttempt, error) {
+	jac.defaults()
+	return withHooks(ctx, jac.sqlSave, jac.mutation, jac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jac *JobAttemptCreate) SaveX(ctx context.Context) *JobAttempt {
+	v, err := jac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jac *JobAttemptCreate) Exec(ctx context.Context) error {
+	_, err := jac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jac *JobAttemptCreate) ExecX(ctx context.Context) {
+	if err := jac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jac *JobAttemptCreate) defaults() {
+	if _, ok := jac.mutation.ID(); !ok {
+		v := jobattempt.DefaultID()
+		jac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jac *JobAttemptCreate) check() error {
+	if _, ok := jac.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`store: missing required field "JobAttempt.job_id"`)}
+	}
+	if v, ok := jac.mutation.JobID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "job_id", err: fmt.Errorf(`store: validator failed for field "JobAttempt.job_id": %w`, err)}
+		}
+	}
+	if _, ok := jac.mutation.JobName(); !ok {
+		return &ValidationError{Name: "job_name", err: errors.New(`store: missing required field "JobAttempt.job_name"`)}
+	}
+	if v, ok := jac.mutation.JobName(); ok {
+		if err := jobattempt.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`store: validator failed for field "JobAttempt.job_name": %w`, err)}
+		}
+	}
+	if _, ok := jac.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`store: missing required field "JobAttempt.attempt"`)}
+	}
+	if v, ok := jac.mutation.Attempt(); ok {
+		if err := jobattempt.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`store: validator failed for field "JobAttempt.attempt": %w`, err)}
+		}
+	}
+	if _, ok := jac.mutation.Worker(); !ok {
+		return &ValidationError{Name: "worker", err: errors.New(`store: missing required field "JobAttempt.worker"`)}
+	}
+	if v, ok := jac.mutation.Worker(); ok {
+		if err := jobattempt.WorkerValidator(v); err != nil {
+			return &ValidationError{Name: "worker", err: fmt.Errorf(`store: validator failed for field "JobAttempt.worker": %w`, err)}
+		}
+	}
+	if _, ok := jac.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`store: missing required field "JobAttempt.started_at"`)}
+	}
+	if _, ok := jac.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`store: missing required field "JobAttempt.finished_at"`)}
+	}
+	if _, ok := jac.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`store: missing required field "JobAttempt.outcome"`)}
+	}
+	if v, ok := jac.mutation.Outcome(); ok {
+		if err := jobattempt.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`store: validator failed for field "JobAttempt.outcome": %w`, err)}
+		}
+	}
+	if v, ok := jac.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "JobAttempt.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jac *JobAttemptCreate) sqlSave(ctx context.Context) (*JobAttempt, error) {
+	if err := jac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.JobAttemptID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	jac.mutation.id = &_node.ID
+	jac.mutation.done = true
+	return _node, nil
+}
+
+func (jac *JobAttemptCreate) createSpec() (*JobAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobAttempt{config: jac.config}
+		_spec = sqlgraph.NewCreateSpec(jobattempt.Table, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = jac.conflict
+	if id, ok := jac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := jac.mutation.JobID(); ok {
+		_spec.SetField(jobattempt.FieldJobID, field.TypeUUID, value)
+		_node.JobID = value
+	}
+	if value, ok := jac.mutation.JobName(); ok {
+		_spec.SetField(jobattempt.FieldJobName, field.TypeString, value)
+		_node.JobName = value
+	}
+	if value, ok := jac.mutation.Attempt(); ok {
+		_spec.SetField(jobattempt.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := jac.mutation.Worker(); ok {
+		_spec.SetField(jobattempt.FieldWorker, field.TypeString, value)
+		_node.Worker = value
+	}
+	if value, ok := jac.mutation.StartedAt(); ok {
+		_spec.SetField(jobattempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := jac.mutation.FinishedAt(); ok {
+		_spec.SetField(jobattempt.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := jac.mutation.Outcome(); ok {
+		_spec.SetField(jobattempt.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := jac.mutation.Error(); ok {
+		_spec.SetField(jobattempt.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobAttempt.Create().
+//		SetJobID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobAttemptUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (jac *JobAttemptCreate) OnConflict(opts ...sql.ConflictOption) *JobAttemptUpsertOne {
+	jac.conflict = opts
+	return &JobAttemptUpsertOne{
+		create: jac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jac *JobAttemptCreate) OnConflictColumns(columns ...string) *JobAttemptUpsertOne {
+	jac.conflict = append(jac.conflict, sql.ConflictColumns(columns...))
+	return &JobAttemptUpsertOne{
+		create: jac,
+	}
+}
+
+type (
+	// JobAttemptUpsertOne is the builder for "upsert"-ing
+	//  one JobAttempt node.
+	JobAttemptUpsertOne struct {
+		create *JobAttemptCreate
+	}
+
+	// JobAttemptUpsert is the "OnConflict" setter.
+	JobAttemptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobattempt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobAttemptUpsertOne) UpdateNewValues() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobattempt.FieldID)
+		}
+		if _, exists := u.create.mutation.JobID(); exists {
+			s.SetIgnore(jobattempt.FieldJobID)
+		}
+		if _, exists := u.create.mutation.JobName(); exists {
+			s.SetIgnore(jobattempt.FieldJobName)
+		}
+		if _, exists := u.create.mutation.Attempt(); exists {
+			s.SetIgnore(jobattempt.FieldAttempt)
+		}
+		if _, exists := u.create.mutation.Worker(); exists {
+			s.SetIgnore(jobattempt.FieldWorker)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(jobattempt.FieldStartedAt)
+		}
+		if _, exists := u.create.mutation.FinishedAt(); exists {
+			s.SetIgnore(jobattempt.FieldFinishedAt)
+		}
+		if _, exists := u.create.mutation.Outcome(); exists {
+			s.SetIgnore(jobattempt.FieldOutcome)
+		}
+		if _, exists := u.create.mutation.Error(); exists {
+			s.SetIgnore(jobattempt.FieldError)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobAttemptUpsertOne) Ignore() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobAttemptUpsertOne) DoNothing() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobAttemptCreate.OnConflict
+// documentation for more info.
+func (u *JobAttemptUpsertOne) Update(set func(*JobAttemptUpsert)) *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *JobAttemptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for JobAttemptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobAttemptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobAttemptUpsertOne) ID(ctx context.Context) (id types.JobAttemptID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: JobAttemptUpsertOne.ID is not supported by MySQL driver. Use JobAttemptUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobAttemptUpsertOne) IDX(ctx context.Context) types.JobAttemptID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobAttemptCreateBulk is the builder for creating many JobAttempt entities in bulk.
+type JobAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*JobAttemptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobAttempt entities in the database.
+func (jacb *JobAttemptCreateBulk) Save(ctx context.Context) ([]*JobAttempt, error) {
+	if jacb.err != nil {
+		return nil, jacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jacb.builders))
+	nodes := make([]*JobAttempt, len(jacb.builders))
+	mutators := make([]Mutator, len(jacb.builders))
+	for i := range jacb.builders {
+		func(i int, root context.Context) {
+			builder := jacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jacb *JobAttemptCreateBulk) SaveX(ctx context.Context) []*JobAttempt {
+	v, err := jacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jacb *JobAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := jacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jacb *JobAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := jacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobAttempt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobAttemptUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (jacb *JobAttemptCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobAttemptUpsertBulk {
+	jacb.conflict = opts
+	return &JobAttemptUpsertBulk{
+		create: jacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jacb *JobAttemptCreateBulk) OnConflictColumns(columns ...string) *JobAttemptUpsertBulk {
+	jacb.conflict = append(jacb.conflict, sql.ConflictColumns(columns...))
+	return &JobAttemptUpsertBulk{
+		create: jacb,
+	}
+}
+
+// JobAttemptUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobAttempt nodes.
+type JobAttemptUpsertBulk struct {
+	create *JobAttemptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobattempt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobAttemptUpsertBulk) UpdateNewValues() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobattempt.FieldID)
+			}
+			if _, exists := b.mutation.JobID(); exists {
+				s.SetIgnore(jobattempt.FieldJobID)
+			}
+			if _, exists := b.mutation.JobName(); exists {
+				s.SetIgnore(jobattempt.FieldJobName)
+			}
+			if _, exists := b.mutation.Attempt(); exists {
+				s.SetIgnore(jobattempt.FieldAttempt)
+			}
+			if _, exists := b.mutation.Worker(); exists {
+				s.SetIgnore(jobattempt.FieldWorker)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(jobattempt.FieldStartedAt)
+			}
+			if _, exists := b.mutation.FinishedAt(); exists {
+				s.SetIgnore(jobattempt.FieldFinishedAt)
+			}
+			if _, exists := b.mutation.Outcome(); exists {
+				s.SetIgnore(jobattempt.FieldOutcome)
+			}
+			if _, exists := b.mutation.Error(); exists {
+				s.SetIgnore(jobattempt.FieldError)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobAttemptUpsertBulk) Ignore() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobAttemptUpsertBulk) DoNothing() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobAttemptCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobAttemptUpsertBulk) Update(set func(*JobAttemptUpsert)) *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *JobAttemptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the JobAttemptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for JobAttemptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobAttemptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
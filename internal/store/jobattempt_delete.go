@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// JobAttemptDelete is the builder for deleting a JobAttempt entity.
+type JobAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *JobAttemptMutation
+}
+
+// Where appends a list predicates to the JobAttemptDelete builder.
+func (jad *JobAttemptDelete) Where(ps ...predicate.JobAttempt) *JobAttemptDelete {
+	jad.mutation.Where(ps...)
+	return jad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jad *JobAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jad.sqlExec, jad.mutation, jad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jad *JobAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := jad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jad *JobAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jobattempt.Table, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeUUID))
+	if ps := jad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jad.mutation.done = true
+	return affected, err
+}
+
+// JobAttemptDeleteOne is the builder for deleting a single JobAttempt entity.
+type JobAttemptDeleteOne struct {
+	jad *JobAttemptDelete
+}
+
+// Where appends a list predicates to the JobAttemptDelete builder.
+func (jado *JobAttemptDeleteOne) Where(ps ...predicate.JobAttempt) *JobAttemptDeleteOne {
+	jado.jad.mutation.Where(ps...)
+	return jado
+}
+
+// Exec executes the deletion query.
+func (jado *JobAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := jado.jad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jobattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jado *JobAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := jado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
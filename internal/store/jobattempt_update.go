@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// JobAttemptUpdate is the builder for updating JobAttempt entities.
+type JobAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobAttemptMutation
+}
+
+// Where appends a list predicates to the JobAttemptUpdate builder.
+func (jau *JobAttemptUpdate) Where(ps ...predicate.JobAttempt) *JobAttemptUpdate {
+	jau.mutation.Where(ps...)
+	return jau
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jau *JobAttemptUpdate) Mutation() *JobAttemptMutation {
+	return jau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jau *JobAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jau.sqlSave, jau.mutation, jau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jau *JobAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := jau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jau *JobAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := jau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jau *JobAttemptUpdate) ExecX(ctx context.Context) {
+	if err := jau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jau *JobAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobattempt.Table, jobattempt.Columns, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeUUID))
+	if ps := jau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if jau.mutation.ErrorCleared() {
+		_spec.ClearField(jobattempt.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jau.mutation.done = true
+	return n, nil
+}
+
+// JobAttemptUpdateOne is the builder for updating a single JobAttempt entity.
+type JobAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobAttemptMutation
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jauo *JobAttemptUpdateOne) Mutation() *JobAttemptMutation {
+	return jauo.mutation
+}
+
+// Where appends a list predicates to the JobAttemptUpdate builder.
+func (jauo *JobAttemptUpdateOne) Where(ps ...predicate.JobAttempt) *JobAttemptUpdateOne {
+	jauo.mutation.Where(ps...)
+	return jauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jauo *JobAttemptUpdateOne) Select(field string, fields ...string) *JobAttemptUpdateOne {
+	jauo.fields = append([]string{field}, fields...)
+	return jauo
+}
+
+// Save executes the query and returns the updated JobAttempt entity.
+func (jauo *JobAttemptUpdateOne) Save(ctx context.Context) (*JobAttempt, error) {
+	return withHooks(ctx, jauo.sqlSave, jauo.mutation, jauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jauo *JobAttemptUpdateOne) SaveX(ctx context.Context) *JobAttempt {
+	node, err := jauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jauo *JobAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := jauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jauo *JobAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := jauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jauo *JobAttemptUpdateOne) sqlSave(ctx context.Context) (_node *JobAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobattempt.Table, jobattempt.Columns, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeUUID))
+	id, ok := jauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "JobAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobattempt.FieldID)
+		for _, f := range fields {
+			if !jobattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != jobattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if jauo.mutation.ErrorCleared() {
+		_spec.ClearField(jobattempt.FieldError, field.TypeString)
+	}
+	_node = &JobAttempt{config: jauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+var errNotPgxConn = errors.New("underlying connection is not pgx")
+
+// PSQLListener receives the notifications sent with NOTIFY to the channel.
+// It holds one connection of the client pool while listening.
+type PSQLListener struct {
+	db      *sql.DB
+	channel string
+}
+
+// NewPSQLListener creates a listener sharing the connection pool of the client created by NewPSQLClient.
+func NewPSQLListener(client *Client, channel string) (*PSQLListener, error) {
+	drv := client.driver
+	if d, ok := drv.(*dialect.DebugDriver); ok {
+		drv = d.Driver
+	}
+
+	sqlDrv, ok := drv.(*entsql.Driver)
+	if !ok {
+		return nil, fmt.Errorf("unsupported driver: %T", drv)
+	}
+
+	return &PSQLListener{
+		db:      sqlDrv.DB(),
+		channel: channel,
+	}, nil
+}
+
+// Listen blocks until the context is done or the connection is lost,
+// calling notify on every notification received.
+func (l *PSQLListener) Listen(ctx context.Context, notify func()) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire conn: %v", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errNotPgxConn
+		}
+		return l.listen(ctx, c.Conn(), notify)
+	})
+}
+
+func (l *PSQLListener) listen(ctx context.Context, conn *pgx.Conn, notify func()) error {
+	channel := pgx.Identifier{l.channel}.Sanitize()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+		return fmt.Errorf("listen %s: %v", channel, err)
+	}
+	defer func() {
+		// The connection returns to the pool, so it must not keep receiving notifications.
+		if !conn.IsClosed() {
+			_, _ = conn.Exec(context.Background(), "UNLISTEN "+channel)
+		}
+	}()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("wait for notification: %v", err)
+		}
+		notify()
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerCapacity is the model entity for the ManagerCapacity schema.
+type ManagerCapacity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// Capacity holds the value of the "capacity" field.
+	Capacity int `json:"capacity,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ManagerCapacity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case managercapacity.FieldID, managercapacity.FieldCapacity:
+			values[i] = new(sql.NullInt64)
+		case managercapacity.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case managercapacity.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ManagerCapacity fields.
+func (mc *ManagerCapacity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case managercapacity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mc.ID = int(value.Int64)
+		case managercapacity.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				mc.ManagerID = *value
+			}
+		case managercapacity.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				mc.Capacity = int(value.Int64)
+			}
+		case managercapacity.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mc.UpdatedAt = value.Time
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ManagerCapacity.
+// This includes values selected through modifiers, order, etc.
+func (mc *ManagerCapacity) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ManagerCapacity.
+// Note that you need to call ManagerCapacity.Unwrap() before calling this method if this ManagerCapacity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *ManagerCapacity) Update() *ManagerCapacityUpdateOne {
+	return NewManagerCapacityClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the ManagerCapacity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *ManagerCapacity) Unwrap() *ManagerCapacity {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("store: ManagerCapacity is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *ManagerCapacity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ManagerCapacity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Capacity))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ManagerCapacities is a parsable slice of ManagerCapacity.
+type ManagerCapacities []*ManagerCapacity
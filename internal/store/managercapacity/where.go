@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package managercapacity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLTE(FieldID, id))
+}
+
+// ManagerID applies equality check predicate on the "manager_id" field. It's identical to ManagerIDEQ.
+func ManagerID(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldManagerID, v))
+}
+
+// Capacity applies equality check predicate on the "capacity" field. It's identical to CapacityEQ.
+func Capacity(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldCapacity, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ManagerIDEQ applies the EQ predicate on the "manager_id" field.
+func ManagerIDEQ(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldManagerID, v))
+}
+
+// ManagerIDNEQ applies the NEQ predicate on the "manager_id" field.
+func ManagerIDNEQ(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNEQ(FieldManagerID, v))
+}
+
+// ManagerIDIn applies the In predicate on the "manager_id" field.
+func ManagerIDIn(vs ...types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldIn(FieldManagerID, vs...))
+}
+
+// ManagerIDNotIn applies the NotIn predicate on the "manager_id" field.
+func ManagerIDNotIn(vs ...types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNotIn(FieldManagerID, vs...))
+}
+
+// ManagerIDGT applies the GT predicate on the "manager_id" field.
+func ManagerIDGT(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGT(FieldManagerID, v))
+}
+
+// ManagerIDGTE applies the GTE predicate on the "manager_id" field.
+func ManagerIDGTE(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGTE(FieldManagerID, v))
+}
+
+// ManagerIDLT applies the LT predicate on the "manager_id" field.
+func ManagerIDLT(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLT(FieldManagerID, v))
+}
+
+// ManagerIDLTE applies the LTE predicate on the "manager_id" field.
+func ManagerIDLTE(v types.UserID) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLTE(FieldManagerID, v))
+}
+
+// CapacityEQ applies the EQ predicate on the "capacity" field.
+func CapacityEQ(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldCapacity, v))
+}
+
+// CapacityNEQ applies the NEQ predicate on the "capacity" field.
+func CapacityNEQ(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNEQ(FieldCapacity, v))
+}
+
+// CapacityIn applies the In predicate on the "capacity" field.
+func CapacityIn(vs ...int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldIn(FieldCapacity, vs...))
+}
+
+// CapacityNotIn applies the NotIn predicate on the "capacity" field.
+func CapacityNotIn(vs ...int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNotIn(FieldCapacity, vs...))
+}
+
+// CapacityGT applies the GT predicate on the "capacity" field.
+func CapacityGT(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGT(FieldCapacity, v))
+}
+
+// CapacityGTE applies the GTE predicate on the "capacity" field.
+func CapacityGTE(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGTE(FieldCapacity, v))
+}
+
+// CapacityLT applies the LT predicate on the "capacity" field.
+func CapacityLT(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLT(FieldCapacity, v))
+}
+
+// CapacityLTE applies the LTE predicate on the "capacity" field.
+func CapacityLTE(v int) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLTE(FieldCapacity, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ManagerCapacity) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ManagerCapacity) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ManagerCapacity) predicate.ManagerCapacity {
+	return predicate.ManagerCapacity(sql.NotPredicates(p))
+}
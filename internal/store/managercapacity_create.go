@@ -0,0 +1,582 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerCapacityCreate is the builder for creating a ManagerCapacity entity.
+type ManagerCapacityCreate struct {
+	config
+	mutation *ManagerCapacityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetManagerID sets the "manager_id" field.
+func (mcc *ManagerCapacityCreate) SetManagerID(ti types.UserID) *ManagerCapacityCreate {
+	mcc.mutation.SetManagerID(ti)
+	return mcc
+}
+
+// SetCapacity sets the "capacity" field.
+func (mcc *ManagerCapacityCreate) SetCapacity(i int) *ManagerCapacityCreate {
+	mcc.mutation.SetCapacity(i)
+	return mcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcc *ManagerCapacityCreate) SetUpdatedAt(t time.Time) *ManagerCapacityCreate {
+	mcc.mutation.SetUpdatedAt(t)
+	return mcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mcc *ManagerCapacityCreate) SetNillableUpdatedAt(t *time.Time) *ManagerCapacityCreate {
+	if t != nil {
+		mcc.SetUpdatedAt(*t)
+	}
+	return mcc
+}
+
+// Mutation returns the ManagerCapacityMutation object of the builder.
+func (mcc *ManagerCapacityCreate) Mutation() *ManagerCapacityMutation {
+	return mcc.mutation
+}
+
+// Save creates the ManagerCapacity in the database.
+func (mcc *ManagerCapacityCreate) Save(ctx context.Context) (*ManagerCapacity, error) {
+	mcc.defaults()
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *ManagerCapacityCreate) SaveX(ctx context.Context) *ManagerCapacity {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *ManagerCapacityCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *ManagerCapacityCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *ManagerCapacityCreate) defaults() {
+	if _, ok := mcc.mutation.UpdatedAt(); !ok {
+		v := managercapacity.DefaultUpdatedAt()
+		mcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *ManagerCapacityCreate) check() error {
+	if _, ok := mcc.mutation.ManagerID(); !ok {
+		return &ValidationError{Name: "manager_id", err: errors.New(`store: missing required field "ManagerCapacity.manager_id"`)}
+	}
+	if v, ok := mcc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "ManagerCapacity.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`store: missing required field "ManagerCapacity.capacity"`)}
+	}
+	if v, ok := mcc.mutation.Capacity(); ok {
+		if err := managercapacity.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`store: validator failed for field "ManagerCapacity.capacity": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`store: missing required field "ManagerCapacity.updated_at"`)}
+	}
+	return nil
+}
+
+func (mcc *ManagerCapacityCreate) sqlSave(ctx context.Context) (*ManagerCapacity, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *ManagerCapacityCreate) createSpec() (*ManagerCapacity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ManagerCapacity{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(managercapacity.Table, sqlgraph.NewFieldSpec(managercapacity.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mcc.conflict
+	if value, ok := mcc.mutation.ManagerID(); ok {
+		_spec.SetField(managercapacity.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := mcc.mutation.Capacity(); ok {
+		_spec.SetField(managercapacity.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
+	if value, ok := mcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(managercapacity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagerCapacity.Create().
+//		SetManagerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagerCapacityUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcc *ManagerCapacityCreate) OnConflict(opts ...sql.ConflictOption) *ManagerCapacityUpsertOne {
+	mcc.conflict = opts
+	return &ManagerCapacityUpsertOne{
+		create: mcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcc *ManagerCapacityCreate) OnConflictColumns(columns ...string) *ManagerCapacityUpsertOne {
+	mcc.conflict = append(mcc.conflict, sql.ConflictColumns(columns...))
+	return &ManagerCapacityUpsertOne{
+		create: mcc,
+	}
+}
+
+type (
+	// ManagerCapacityUpsertOne is the builder for "upsert"-ing
+	//  one ManagerCapacity node.
+	ManagerCapacityUpsertOne struct {
+		create *ManagerCapacityCreate
+	}
+
+	// ManagerCapacityUpsert is the "OnConflict" setter.
+	ManagerCapacityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCapacity sets the "capacity" field.
+func (u *ManagerCapacityUpsert) SetCapacity(v int) *ManagerCapacityUpsert {
+	u.Set(managercapacity.FieldCapacity, v)
+	return u
+}
+
+// UpdateCapacity sets the "capacity" field to the value that was provided on create.
+func (u *ManagerCapacityUpsert) UpdateCapacity() *ManagerCapacityUpsert {
+	u.SetExcluded(managercapacity.FieldCapacity)
+	return u
+}
+
+// AddCapacity adds v to the "capacity" field.
+func (u *ManagerCapacityUpsert) AddCapacity(v int) *ManagerCapacityUpsert {
+	u.Add(managercapacity.FieldCapacity, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ManagerCapacityUpsert) SetUpdatedAt(v time.Time) *ManagerCapacityUpsert {
+	u.Set(managercapacity.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ManagerCapacityUpsert) UpdateUpdatedAt() *ManagerCapacityUpsert {
+	u.SetExcluded(managercapacity.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ManagerCapacityUpsertOne) UpdateNewValues() *ManagerCapacityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(managercapacity.FieldManagerID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ManagerCapacityUpsertOne) Ignore() *ManagerCapacityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagerCapacityUpsertOne) DoNothing() *ManagerCapacityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagerCapacityCreate.OnConflict
+// documentation for more info.
+func (u *ManagerCapacityUpsertOne) Update(set func(*ManagerCapacityUpsert)) *ManagerCapacityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagerCapacityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCapacity sets the "capacity" field.
+func (u *ManagerCapacityUpsertOne) SetCapacity(v int) *ManagerCapacityUpsertOne {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.SetCapacity(v)
+	})
+}
+
+// AddCapacity adds v to the "capacity" field.
+func (u *ManagerCapacityUpsertOne) AddCapacity(v int) *ManagerCapacityUpsertOne {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.AddCapacity(v)
+	})
+}
+
+// UpdateCapacity sets the "capacity" field to the value that was provided on create.
+func (u *ManagerCapacityUpsertOne) UpdateCapacity() *ManagerCapacityUpsertOne {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.UpdateCapacity()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ManagerCapacityUpsertOne) SetUpdatedAt(v time.Time) *ManagerCapacityUpsertOne {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ManagerCapacityUpsertOne) UpdateUpdatedAt() *ManagerCapacityUpsertOne {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ManagerCapacityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ManagerCapacityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagerCapacityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ManagerCapacityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ManagerCapacityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ManagerCapacityCreateBulk is the builder for creating many ManagerCapacity entities in bulk.
+type ManagerCapacityCreateBulk struct {
+	config
+	err      error
+	builders []*ManagerCapacityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ManagerCapacity entities in the database.
+func (mccb *ManagerCapacityCreateBulk) Save(ctx context.Context) ([]*ManagerCapacity, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*ManagerCapacity, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ManagerCapacityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *ManagerCapacityCreateBulk) SaveX(ctx context.Context) []*ManagerCapacity {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *ManagerCapacityCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *ManagerCapacityCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagerCapacity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagerCapacityUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (mccb *ManagerCapacityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ManagerCapacityUpsertBulk {
+	mccb.conflict = opts
+	return &ManagerCapacityUpsertBulk{
+		create: mccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mccb *ManagerCapacityCreateBulk) OnConflictColumns(columns ...string) *ManagerCapacityUpsertBulk {
+	mccb.conflict = append(mccb.conflict, sql.ConflictColumns(columns...))
+	return &ManagerCapacityUpsertBulk{
+		create: mccb,
+	}
+}
+
+// ManagerCapacityUpsertBulk is the builder for "upsert"-ing
+// a bulk of ManagerCapacity nodes.
+type ManagerCapacityUpsertBulk struct {
+	create *ManagerCapacityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ManagerCapacityUpsertBulk) UpdateNewValues() *ManagerCapacityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(managercapacity.FieldManagerID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagerCapacity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ManagerCapacityUpsertBulk) Ignore() *ManagerCapacityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagerCapacityUpsertBulk) DoNothing() *ManagerCapacityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagerCapacityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ManagerCapacityUpsertBulk) Update(set func(*ManagerCapacityUpsert)) *ManagerCapacityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagerCapacityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCapacity sets the "capacity" field.
+func (u *ManagerCapacityUpsertBulk) SetCapacity(v int) *ManagerCapacityUpsertBulk {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.SetCapacity(v)
+	})
+}
+
+// AddCapacity adds v to the "capacity" field.
+func (u *ManagerCapacityUpsertBulk) AddCapacity(v int) *ManagerCapacityUpsertBulk {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.AddCapacity(v)
+	})
+}
+
+// UpdateCapacity sets the "capacity" field to the value that was provided on create.
+func (u *ManagerCapacityUpsertBulk) UpdateCapacity() *ManagerCapacityUpsertBulk {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.UpdateCapacity()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ManagerCapacityUpsertBulk) SetUpdatedAt(v time.Time) *ManagerCapacityUpsertBulk {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ManagerCapacityUpsertBulk) UpdateUpdatedAt() *ManagerCapacityUpsertBulk {
+	return u.Update(func(s *ManagerCapacityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ManagerCapacityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the ManagerCapacityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ManagerCapacityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagerCapacityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
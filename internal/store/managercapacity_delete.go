@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerCapacityDelete is the builder for deleting a ManagerCapacity entity.
+type ManagerCapacityDelete struct {
+	config
+	hooks    []Hook
+	mutation *ManagerCapacityMutation
+}
+
+// Where appends a list predicates to the ManagerCapacityDelete builder.
+func (mcd *ManagerCapacityDelete) Where(ps ...predicate.ManagerCapacity) *ManagerCapacityDelete {
+	mcd.mutation.Where(ps...)
+	return mcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mcd *ManagerCapacityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcd.sqlExec, mcd.mutation, mcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcd *ManagerCapacityDelete) ExecX(ctx context.Context) int {
+	n, err := mcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mcd *ManagerCapacityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(managercapacity.Table, sqlgraph.NewFieldSpec(managercapacity.FieldID, field.TypeInt))
+	if ps := mcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mcd.mutation.done = true
+	return affected, err
+}
+
+// ManagerCapacityDeleteOne is the builder for deleting a single ManagerCapacity entity.
+type ManagerCapacityDeleteOne struct {
+	mcd *ManagerCapacityDelete
+}
+
+// Where appends a list predicates to the ManagerCapacityDelete builder.
+func (mcdo *ManagerCapacityDeleteOne) Where(ps ...predicate.ManagerCapacity) *ManagerCapacityDeleteOne {
+	mcdo.mcd.mutation.Where(ps...)
+	return mcdo
+}
+
+// Exec executes the deletion query.
+func (mcdo *ManagerCapacityDeleteOne) Exec(ctx context.Context) error {
+	n, err := mcdo.mcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{managercapacity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcdo *ManagerCapacityDeleteOne) ExecX(ctx context.Context) {
+	if err := mcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
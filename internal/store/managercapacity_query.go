@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerCapacityQuery is the builder for querying ManagerCapacity entities.
+type ManagerCapacityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []managercapacity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ManagerCapacity
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ManagerCapacityQuery builder.
+func (mcq *ManagerCapacityQuery) Where(ps ...predicate.ManagerCapacity) *ManagerCapacityQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mcq *ManagerCapacityQuery) Limit(limit int) *ManagerCapacityQuery {
+	mcq.ctx.Limit = &limit
+	return mcq
+}
+
+// Offset to start from.
+func (mcq *ManagerCapacityQuery) Offset(offset int) *ManagerCapacityQuery {
+	mcq.ctx.Offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *ManagerCapacityQuery) Unique(unique bool) *ManagerCapacityQuery {
+	mcq.ctx.Unique = &unique
+	return mcq
+}
+
+// Order specifies how the records should be ordered.
+func (mcq *ManagerCapacityQuery) Order(o ...managercapacity.OrderOption) *ManagerCapacityQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// First returns the first ManagerCapacity entity from the query.
+// Returns a *NotFoundError when no ManagerCapacity was found.
+func (mcq *ManagerCapacityQuery) First(ctx context.Context) (*ManagerCapacity, error) {
+	nodes, err := mcq.Limit(1).All(setContextOp(ctx, mcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{managercapacity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) FirstX(ctx context.Context) *ManagerCapacity {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ManagerCapacity ID from the query.
+// Returns a *NotFoundError when no ManagerCapacity ID was found.
+func (mcq *ManagerCapacityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(1).IDs(setContextOp(ctx, mcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{managercapacity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) FirstIDX(ctx context.Context) int {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ManagerCapacity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ManagerCapacity entity is found.
+// Returns a *NotFoundError when no ManagerCapacity entities are found.
+func (mcq *ManagerCapacityQuery) Only(ctx context.Context) (*ManagerCapacity, error) {
+	nodes, err := mcq.Limit(2).All(setContextOp(ctx, mcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{managercapacity.Label}
+	default:
+		return nil, &NotSingularError{managercapacity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) OnlyX(ctx context.Context) *ManagerCapacity {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ManagerCapacity ID in the query.
+// Returns a *NotSingularError when more than one ManagerCapacity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *ManagerCapacityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(2).IDs(setContextOp(ctx, mcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{managercapacity.Label}
+	default:
+		err = &NotSingularError{managercapacity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ManagerCapacities.
+func (mcq *ManagerCapacityQuery) All(ctx context.Context) ([]*ManagerCapacity, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "All")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ManagerCapacity, *ManagerCapacityQuery]()
+	return withInterceptors[[]*ManagerCapacity](ctx, mcq, qr, mcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) AllX(ctx context.Context) []*ManagerCapacity {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ManagerCapacity IDs.
+func (mcq *ManagerCapacityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mcq.ctx.Unique == nil && mcq.path != nil {
+		mcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mcq.ctx, "IDs")
+	if err = mcq.Select(managercapacity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) IDsX(ctx context.Context) []int {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *ManagerCapacityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Count")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mcq, querierCount[*ManagerCapacityQuery](), mcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *ManagerCapacityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Exist")
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *ManagerCapacityQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ManagerCapacityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *ManagerCapacityQuery) Clone() *ManagerCapacityQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &ManagerCapacityQuery{
+		config:     mcq.config,
+		ctx:        mcq.ctx.Clone(),
+		order:      append([]managercapacity.OrderOption{}, mcq.order...),
+		inters:     append([]Interceptor{}, mcq.inters...),
+		predicates: append([]predicate.ManagerCapacity{}, mcq.predicates...),
+		// clone intermediate query.
+		sql:  mcq.sql.Clone(),
+		path: mcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ManagerID types.UserID `json:"manager_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ManagerCapacity.Query().
+//		GroupBy(managercapacity.FieldManagerID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (mcq *ManagerCapacityQuery) GroupBy(field string, fields ...string) *ManagerCapacityGroupBy {
+	mcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ManagerCapacityGroupBy{build: mcq}
+	grbuild.flds = &mcq.ctx.Fields
+	grbuild.label = managercapacity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ManagerID types.UserID `json:"manager_id,omitempty"`
+//	}
+//
+//	client.ManagerCapacity.Query().
+//		Select(managercapacity.FieldManagerID).
+//		Scan(ctx, &v)
+func (mcq *ManagerCapacityQuery) Select(fields ...string) *ManagerCapacitySelect {
+	mcq.ctx.Fields = append(mcq.ctx.Fields, fields...)
+	sbuild := &ManagerCapacitySelect{ManagerCapacityQuery: mcq}
+	sbuild.label = managercapacity.Label
+	sbuild.flds, sbuild.scan = &mcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ManagerCapacitySelect configured with the given aggregations.
+func (mcq *ManagerCapacityQuery) Aggregate(fns ...AggregateFunc) *ManagerCapacitySelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *ManagerCapacityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mcq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mcq.ctx.Fields {
+		if !managercapacity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	return nil
+}
+
+func (mcq *ManagerCapacityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ManagerCapacity, error) {
+	var (
+		nodes = []*ManagerCapacity{}
+		_spec = mcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ManagerCapacity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ManagerCapacity{config: mcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mcq *ManagerCapacityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	_spec.Node.Columns = mcq.ctx.Fields
+	if len(mcq.ctx.Fields) > 0 {
+		_spec.Unique = mcq.ctx.Unique != nil && *mcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *ManagerCapacityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(managercapacity.Table, managercapacity.Columns, sqlgraph.NewFieldSpec(managercapacity.FieldID, field.TypeInt))
+	_spec.From = mcq.sql
+	if unique := mcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, managercapacity.FieldID)
+		for i := range fields {
+			if fields[i] != managercapacity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *ManagerCapacityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(managercapacity.Table)
+	columns := mcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = managercapacity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.ctx.Unique != nil && *mcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mcq.modifiers {
+		m(selector)
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mcq *ManagerCapacityQuery) ForUpdate(opts ...sql.LockOption) *ManagerCapacityQuery {
+	if mcq.driver.Dialect() == dialect.Postgres {
+		mcq.Unique(false)
+	}
+	mcq.modifiers = append(mcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mcq *ManagerCapacityQuery) ForShare(opts ...sql.LockOption) *ManagerCapacityQuery {
+	if mcq.driver.Dialect() == dialect.Postgres {
+		mcq.Unique(false)
+	}
+	mcq.modifiers = append(mcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mcq
+}
+
+// ManagerCapacityGroupBy is the group-by builder for ManagerCapacity entities.
+type ManagerCapacityGroupBy struct {
+	selector
+	build *ManagerCapacityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *ManagerCapacityGroupBy) Aggregate(fns ...AggregateFunc) *ManagerCapacityGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcgb *ManagerCapacityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcgb.build.ctx, "GroupBy")
+	if err := mcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManagerCapacityQuery, *ManagerCapacityGroupBy](ctx, mcgb.build, mcgb, mcgb.build.inters, v)
+}
+
+func (mcgb *ManagerCapacityGroupBy) sqlScan(ctx context.Context, root *ManagerCapacityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mcgb.flds)+len(mcgb.fns))
+		for _, f := range *mcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ManagerCapacitySelect is the builder for selecting fields of ManagerCapacity entities.
+type ManagerCapacitySelect struct {
+	*ManagerCapacityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *ManagerCapacitySelect) Aggregate(fns ...AggregateFunc) *ManagerCapacitySelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *ManagerCapacitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcs.ctx, "Select")
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManagerCapacityQuery, *ManagerCapacitySelect](ctx, mcs.ManagerCapacityQuery, mcs, mcs.inters, v)
+}
+
+func (mcs *ManagerCapacitySelect) sqlScan(ctx context.Context, root *ManagerCapacityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
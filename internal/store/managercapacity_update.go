@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerCapacityUpdate is the builder for updating ManagerCapacity entities.
+type ManagerCapacityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ManagerCapacityMutation
+}
+
+// Where appends a list predicates to the ManagerCapacityUpdate builder.
+func (mcu *ManagerCapacityUpdate) Where(ps ...predicate.ManagerCapacity) *ManagerCapacityUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetCapacity sets the "capacity" field.
+func (mcu *ManagerCapacityUpdate) SetCapacity(i int) *ManagerCapacityUpdate {
+	mcu.mutation.ResetCapacity()
+	mcu.mutation.SetCapacity(i)
+	return mcu
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (mcu *ManagerCapacityUpdate) AddCapacity(i int) *ManagerCapacityUpdate {
+	mcu.mutation.AddCapacity(i)
+	return mcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcu *ManagerCapacityUpdate) SetUpdatedAt(t time.Time) *ManagerCapacityUpdate {
+	mcu.mutation.SetUpdatedAt(t)
+	return mcu
+}
+
+// Mutation returns the ManagerCapacityMutation object of the builder.
+func (mcu *ManagerCapacityUpdate) Mutation() *ManagerCapacityMutation {
+	return mcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *ManagerCapacityUpdate) Save(ctx context.Context) (int, error) {
+	mcu.defaults()
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *ManagerCapacityUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *ManagerCapacityUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *ManagerCapacityUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcu *ManagerCapacityUpdate) defaults() {
+	if _, ok := mcu.mutation.UpdatedAt(); !ok {
+		v := managercapacity.UpdateDefaultUpdatedAt()
+		mcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcu *ManagerCapacityUpdate) check() error {
+	if v, ok := mcu.mutation.Capacity(); ok {
+		if err := managercapacity.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`store: validator failed for field "ManagerCapacity.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mcu *ManagerCapacityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(managercapacity.Table, managercapacity.Columns, sqlgraph.NewFieldSpec(managercapacity.FieldID, field.TypeInt))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.Capacity(); ok {
+		_spec.SetField(managercapacity.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedCapacity(); ok {
+		_spec.AddField(managercapacity.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(managercapacity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managercapacity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// ManagerCapacityUpdateOne is the builder for updating a single ManagerCapacity entity.
+type ManagerCapacityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ManagerCapacityMutation
+}
+
+// SetCapacity sets the "capacity" field.
+func (mcuo *ManagerCapacityUpdateOne) SetCapacity(i int) *ManagerCapacityUpdateOne {
+	mcuo.mutation.ResetCapacity()
+	mcuo.mutation.SetCapacity(i)
+	return mcuo
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (mcuo *ManagerCapacityUpdateOne) AddCapacity(i int) *ManagerCapacityUpdateOne {
+	mcuo.mutation.AddCapacity(i)
+	return mcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcuo *ManagerCapacityUpdateOne) SetUpdatedAt(t time.Time) *ManagerCapacityUpdateOne {
+	mcuo.mutation.SetUpdatedAt(t)
+	return mcuo
+}
+
+// Mutation returns the ManagerCapacityMutation object of the builder.
+func (mcuo *ManagerCapacityUpdateOne) Mutation() *ManagerCapacityMutation {
+	return mcuo.mutation
+}
+
+// Where appends a list predicates to the ManagerCapacityUpdate builder.
+func (mcuo *ManagerCapacityUpdateOne) Where(ps ...predicate.ManagerCapacity) *ManagerCapacityUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *ManagerCapacityUpdateOne) Select(field string, fields ...string) *ManagerCapacityUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated ManagerCapacity entity.
+func (mcuo *ManagerCapacityUpdateOne) Save(ctx context.Context) (*ManagerCapacity, error) {
+	mcuo.defaults()
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *ManagerCapacityUpdateOne) SaveX(ctx context.Context) *ManagerCapacity {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *ManagerCapacityUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *ManagerCapacityUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcuo *ManagerCapacityUpdateOne) defaults() {
+	if _, ok := mcuo.mutation.UpdatedAt(); !ok {
+		v := managercapacity.UpdateDefaultUpdatedAt()
+		mcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcuo *ManagerCapacityUpdateOne) check() error {
+	if v, ok := mcuo.mutation.Capacity(); ok {
+		if err := managercapacity.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`store: validator failed for field "ManagerCapacity.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mcuo *ManagerCapacityUpdateOne) sqlSave(ctx context.Context) (_node *ManagerCapacity, err error) {
+	if err := mcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(managercapacity.Table, managercapacity.Columns, sqlgraph.NewFieldSpec(managercapacity.FieldID, field.TypeInt))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "ManagerCapacity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, managercapacity.FieldID)
+		for _, f := range fields {
+			if !managercapacity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != managercapacity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.Capacity(); ok {
+		_spec.SetField(managercapacity.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedCapacity(); ok {
+		_spec.AddField(managercapacity.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(managercapacity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ManagerCapacity{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managercapacity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
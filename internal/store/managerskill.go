@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerSkill is the model entity for the ManagerSkill schema.
+type ManagerSkill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// Skill holds the value of the "skill" field.
+	Skill types.Topic `json:"skill,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ManagerSkill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case managerskill.FieldID:
+			values[i] = new(sql.NullInt64)
+		case managerskill.FieldSkill:
+			values[i] = new(sql.NullString)
+		case managerskill.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case managerskill.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ManagerSkill fields.
+func (ms *ManagerSkill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case managerskill.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ms.ID = int(value.Int64)
+		case managerskill.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				ms.ManagerID = *value
+			}
+		case managerskill.FieldSkill:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skill", values[i])
+			} else if value.Valid {
+				ms.Skill = types.Topic(value.String)
+			}
+		case managerskill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ms.CreatedAt = value.Time
+			}
+		default:
+			ms.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ManagerSkill.
+// This includes values selected through modifiers, order, etc.
+func (ms *ManagerSkill) Value(name string) (ent.Value, error) {
+	return ms.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ManagerSkill.
+// Note that you need to call ManagerSkill.Unwrap() before calling this method if this ManagerSkill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ms *ManagerSkill) Update() *ManagerSkillUpdateOne {
+	return NewManagerSkillClient(ms.config).UpdateOne(ms)
+}
+
+// Unwrap unwraps the ManagerSkill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ms *ManagerSkill) Unwrap() *ManagerSkill {
+	_tx, ok := ms.config.driver.(*txDriver)
+	if !ok {
+		panic("store: ManagerSkill is not a transactional entity")
+	}
+	ms.config.driver = _tx.drv
+	return ms
+}
+
+// String implements the fmt.Stringer.
+func (ms *ManagerSkill) String() string {
+	var builder strings.Builder
+	builder.WriteString("ManagerSkill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ms.ID))
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", ms.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("skill=")
+	builder.WriteString(fmt.Sprintf("%v", ms.Skill))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ms.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ManagerSkills is a parsable slice of ManagerSkill.
+type ManagerSkills []*ManagerSkill
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package managerskill
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the managerskill type in the database.
+	Label = "manager_skill"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldManagerID holds the string denoting the manager_id field in the database.
+	FieldManagerID = "manager_id"
+	// FieldSkill holds the string denoting the skill field in the database.
+	FieldSkill = "skill"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the managerskill in the database.
+	Table = "manager_skills"
+)
+
+// Columns holds all SQL columns for managerskill fields.
+var Columns = []string{
+	FieldID,
+	FieldManagerID,
+	FieldSkill,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// SkillValidator is a validator for the "skill" field enum values. It is called by the builders before save.
+func SkillValidator(s types.Topic) error {
+	switch s {
+	case "cards", "loans", "deposits":
+		return nil
+	default:
+		return fmt.Errorf("managerskill: invalid enum value for skill field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ManagerSkill queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByManagerID orders the results by the manager_id field.
+func ByManagerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManagerID, opts...).ToFunc()
+}
+
+// BySkill orders the results by the skill field.
+func BySkill(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkill, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package managerskill
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLTE(FieldID, id))
+}
+
+// ManagerID applies equality check predicate on the "manager_id" field. It's identical to ManagerIDEQ.
+func ManagerID(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldManagerID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ManagerIDEQ applies the EQ predicate on the "manager_id" field.
+func ManagerIDEQ(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldManagerID, v))
+}
+
+// ManagerIDNEQ applies the NEQ predicate on the "manager_id" field.
+func ManagerIDNEQ(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNEQ(FieldManagerID, v))
+}
+
+// ManagerIDIn applies the In predicate on the "manager_id" field.
+func ManagerIDIn(vs ...types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldIn(FieldManagerID, vs...))
+}
+
+// ManagerIDNotIn applies the NotIn predicate on the "manager_id" field.
+func ManagerIDNotIn(vs ...types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNotIn(FieldManagerID, vs...))
+}
+
+// ManagerIDGT applies the GT predicate on the "manager_id" field.
+func ManagerIDGT(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGT(FieldManagerID, v))
+}
+
+// ManagerIDGTE applies the GTE predicate on the "manager_id" field.
+func ManagerIDGTE(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGTE(FieldManagerID, v))
+}
+
+// ManagerIDLT applies the LT predicate on the "manager_id" field.
+func ManagerIDLT(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLT(FieldManagerID, v))
+}
+
+// ManagerIDLTE applies the LTE predicate on the "manager_id" field.
+func ManagerIDLTE(v types.UserID) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLTE(FieldManagerID, v))
+}
+
+// SkillEQ applies the EQ predicate on the "skill" field.
+func SkillEQ(v types.Topic) predicate.ManagerSkill {
+	vc := v
+	return predicate.ManagerSkill(sql.FieldEQ(FieldSkill, vc))
+}
+
+// SkillNEQ applies the NEQ predicate on the "skill" field.
+func SkillNEQ(v types.Topic) predicate.ManagerSkill {
+	vc := v
+	return predicate.ManagerSkill(sql.FieldNEQ(FieldSkill, vc))
+}
+
+// SkillIn applies the In predicate on the "skill" field.
+func SkillIn(vs ...types.Topic) predicate.ManagerSkill {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ManagerSkill(sql.FieldIn(FieldSkill, v...))
+}
+
+// SkillNotIn applies the NotIn predicate on the "skill" field.
+func SkillNotIn(vs ...types.Topic) predicate.ManagerSkill {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ManagerSkill(sql.FieldNotIn(FieldSkill, v...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ManagerSkill) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ManagerSkill) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ManagerSkill) predicate.ManagerSkill {
+	return predicate.ManagerSkill(sql.NotPredicates(p))
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerSkillCreate is the builder for creating a ManagerSkill entity.
+type ManagerSkillCreate struct {
+	config
+	mutation *ManagerSkillMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetManagerID sets the "manager_id" field.
+func (msc *ManagerSkillCreate) SetManagerID(ti types.UserID) *ManagerSkillCreate {
+	msc.mutation.SetManagerID(ti)
+	return msc
+}
+
+// SetSkill sets the "skill" field.
+func (msc *ManagerSkillCreate) SetSkill(t types.Topic) *ManagerSkillCreate {
+	msc.mutation.SetSkill(t)
+	return msc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (msc *ManagerSkillCreate) SetCreatedAt(t time.Time) *ManagerSkillCreate {
+	msc.mutation.SetCreatedAt(t)
+	return msc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (msc *ManagerSkillCreate) SetNillableCreatedAt(t *time.Time) *ManagerSkillCreate {
+	if t != nil {
+		msc.SetCreatedAt(*t)
+	}
+	return msc
+}
+
+// Mutation returns the ManagerSkillMutation object of the builder.
+func (msc *ManagerSkillCreate) Mutation() *ManagerSkillMutation {
+	return msc.mutation
+}
+
+// Save creates the ManagerSkill in the database.
+func (msc *ManagerSkillCreate) Save(ctx context.Context) (*ManagerSkill, error) {
+	msc.defaults()
+	return withHooks(ctx, msc.sqlSave, msc.mutation, msc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (msc *ManagerSkillCreate) SaveX(ctx context.Context) *ManagerSkill {
+	v, err := msc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msc *ManagerSkillCreate) Exec(ctx context.Context) error {
+	_, err := msc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msc *ManagerSkillCreate) ExecX(ctx context.Context) {
+	if err := msc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msc *ManagerSkillCreate) defaults() {
+	if _, ok := msc.mutation.CreatedAt(); !ok {
+		v := managerskill.DefaultCreatedAt()
+		msc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msc *ManagerSkillCreate) check() error {
+	if _, ok := msc.mutation.ManagerID(); !ok {
+		return &ValidationError{Name: "manager_id", err: errors.New(`store: missing required field "ManagerSkill.manager_id"`)}
+	}
+	if v, ok := msc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "ManagerSkill.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.Skill(); !ok {
+		return &ValidationError{Name: "skill", err: errors.New(`store: missing required field "ManagerSkill.skill"`)}
+	}
+	if v, ok := msc.mutation.Skill(); ok {
+		if err := managerskill.SkillValidator(v); err != nil {
+			return &ValidationError{Name: "skill", err: fmt.Errorf(`store: validator failed for field "ManagerSkill.skill": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "ManagerSkill.created_at"`)}
+	}
+	return nil
+}
+
+func (msc *ManagerSkillCreate) sqlSave(ctx context.Context) (*ManagerSkill, error) {
+	if err := msc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := msc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, msc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	msc.mutation.id = &_node.ID
+	msc.mutation.done = true
+	return _node, nil
+}
+
+func (msc *ManagerSkillCreate) createSpec() (*ManagerSkill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ManagerSkill{config: msc.config}
+		_spec = sqlgraph.NewCreateSpec(managerskill.Table, sqlgraph.NewFieldSpec(managerskill.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = msc.conflict
+	if value, ok := msc.mutation.ManagerID(); ok {
+		_spec.SetField(managerskill.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := msc.mutation.Skill(); ok {
+		_spec.SetField(managerskill.FieldSkill, field.TypeEnum, value)
+		_node.Skill = value
+	}
+	if value, ok := msc.mutation.CreatedAt(); ok {
+		_spec.SetField(managerskill.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagerSkill.Create().
+//		SetManagerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagerSkillUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (msc *ManagerSkillCreate) OnConflict(opts ...sql.ConflictOption) *ManagerSkillUpsertOne {
+	msc.conflict = opts
+	return &ManagerSkillUpsertOne{
+		create: msc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (msc *ManagerSkillCreate) OnConflictColumns(columns ...string) *ManagerSkillUpsertOne {
+	msc.conflict = append(msc.conflict, sql.ConflictColumns(columns...))
+	return &ManagerSkillUpsertOne{
+		create: msc,
+	}
+}
+
+type (
+	// ManagerSkillUpsertOne is the builder for "upsert"-ing
+	//  one ManagerSkill node.
+	ManagerSkillUpsertOne struct {
+		create *ManagerSkillCreate
+	}
+
+	// ManagerSkillUpsert is the "OnConflict" setter.
+	ManagerSkillUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ManagerSkillUpsertOne) UpdateNewValues() *ManagerSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(managerskill.FieldManagerID)
+		}
+		if _, exists := u.create.mutation.Skill(); exists {
+			s.SetIgnore(managerskill.FieldSkill)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(managerskill.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ManagerSkillUpsertOne) Ignore() *ManagerSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagerSkillUpsertOne) DoNothing() *ManagerSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagerSkillCreate.OnConflict
+// documentation for more info.
+func (u *ManagerSkillUpsertOne) Update(set func(*ManagerSkillUpsert)) *ManagerSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagerSkillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ManagerSkillUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ManagerSkillCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagerSkillUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ManagerSkillUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ManagerSkillUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ManagerSkillCreateBulk is the builder for creating many ManagerSkill entities in bulk.
+type ManagerSkillCreateBulk struct {
+	config
+	err      error
+	builders []*ManagerSkillCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ManagerSkill entities in the database.
+func (mscb *ManagerSkillCreateBulk) Save(ctx context.Context) ([]*ManagerSkill, error) {
+	if mscb.err != nil {
+		return nil, mscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mscb.builders))
+	nodes := make([]*ManagerSkill, len(mscb.builders))
+	mutators := make([]Mutator, len(mscb.builders))
+	for i := range mscb.builders {
+		func(i int, root context.Context) {
+			builder := mscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ManagerSkillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mscb *ManagerSkillCreateBulk) SaveX(ctx context.Context) []*ManagerSkill {
+	v, err := mscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mscb *ManagerSkillCreateBulk) Exec(ctx context.Context) error {
+	_, err := mscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mscb *ManagerSkillCreateBulk) ExecX(ctx context.Context) {
+	if err := mscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagerSkill.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagerSkillUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (mscb *ManagerSkillCreateBulk) OnConflict(opts ...sql.ConflictOption) *ManagerSkillUpsertBulk {
+	mscb.conflict = opts
+	return &ManagerSkillUpsertBulk{
+		create: mscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mscb *ManagerSkillCreateBulk) OnConflictColumns(columns ...string) *ManagerSkillUpsertBulk {
+	mscb.conflict = append(mscb.conflict, sql.ConflictColumns(columns...))
+	return &ManagerSkillUpsertBulk{
+		create: mscb,
+	}
+}
+
+// ManagerSkillUpsertBulk is the builder for "upsert"-ing
+// a bulk of ManagerSkill nodes.
+type ManagerSkillUpsertBulk struct {
+	create *ManagerSkillCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ManagerSkillUpsertBulk) UpdateNewValues() *ManagerSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(managerskill.FieldManagerID)
+			}
+			if _, exists := b.mutation.Skill(); exists {
+				s.SetIgnore(managerskill.FieldSkill)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(managerskill.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagerSkill.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ManagerSkillUpsertBulk) Ignore() *ManagerSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagerSkillUpsertBulk) DoNothing() *ManagerSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagerSkillCreateBulk.OnConflict
+// documentation for more info.
+func (u *ManagerSkillUpsertBulk) Update(set func(*ManagerSkillUpsert)) *ManagerSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagerSkillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ManagerSkillUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the ManagerSkillCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ManagerSkillCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagerSkillUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerSkillDelete is the builder for deleting a ManagerSkill entity.
+type ManagerSkillDelete struct {
+	config
+	hooks    []Hook
+	mutation *ManagerSkillMutation
+}
+
+// Where appends a list predicates to the ManagerSkillDelete builder.
+func (msd *ManagerSkillDelete) Where(ps ...predicate.ManagerSkill) *ManagerSkillDelete {
+	msd.mutation.Where(ps...)
+	return msd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (msd *ManagerSkillDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, msd.sqlExec, msd.mutation, msd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msd *ManagerSkillDelete) ExecX(ctx context.Context) int {
+	n, err := msd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (msd *ManagerSkillDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(managerskill.Table, sqlgraph.NewFieldSpec(managerskill.FieldID, field.TypeInt))
+	if ps := msd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, msd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	msd.mutation.done = true
+	return affected, err
+}
+
+// ManagerSkillDeleteOne is the builder for deleting a single ManagerSkill entity.
+type ManagerSkillDeleteOne struct {
+	msd *ManagerSkillDelete
+}
+
+// Where appends a list predicates to the ManagerSkillDelete builder.
+func (msdo *ManagerSkillDeleteOne) Where(ps ...predicate.ManagerSkill) *ManagerSkillDeleteOne {
+	msdo.msd.mutation.Where(ps...)
+	return msdo
+}
+
+// Exec executes the deletion query.
+func (msdo *ManagerSkillDeleteOne) Exec(ctx context.Context) error {
+	n, err := msdo.msd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{managerskill.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msdo *ManagerSkillDeleteOne) ExecX(ctx context.Context) {
+	if err := msdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerSkillQuery is the builder for querying ManagerSkill entities.
+type ManagerSkillQuery struct {
+	config
+	ctx        *QueryContext
+	order      []managerskill.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ManagerSkill
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ManagerSkillQuery builder.
+func (msq *ManagerSkillQuery) Where(ps ...predicate.ManagerSkill) *ManagerSkillQuery {
+	msq.predicates = append(msq.predicates, ps...)
+	return msq
+}
+
+// Limit the number of records to be returned by this query.
+func (msq *ManagerSkillQuery) Limit(limit int) *ManagerSkillQuery {
+	msq.ctx.Limit = &limit
+	return msq
+}
+
+// Offset to start from.
+func (msq *ManagerSkillQuery) Offset(offset int) *ManagerSkillQuery {
+	msq.ctx.Offset = &offset
+	return msq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (msq *ManagerSkillQuery) Unique(unique bool) *ManagerSkillQuery {
+	msq.ctx.Unique = &unique
+	return msq
+}
+
+// Order specifies how the records should be ordered.
+func (msq *ManagerSkillQuery) Order(o ...managerskill.OrderOption) *ManagerSkillQuery {
+	msq.order = append(msq.order, o...)
+	return msq
+}
+
+// First returns the first ManagerSkill entity from the query.
+// Returns a *NotFoundError when no ManagerSkill was found.
+func (msq *ManagerSkillQuery) First(ctx context.Context) (*ManagerSkill, error) {
+	nodes, err := msq.Limit(1).All(setContextOp(ctx, msq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{managerskill.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (msq *ManagerSkillQuery) FirstX(ctx context.Context) *ManagerSkill {
+	node, err := msq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ManagerSkill ID from the query.
+// Returns a *NotFoundError when no ManagerSkill ID was found.
+func (msq *ManagerSkillQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msq.Limit(1).IDs(setContextOp(ctx, msq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{managerskill.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (msq *ManagerSkillQuery) FirstIDX(ctx context.Context) int {
+	id, err := msq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ManagerSkill entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ManagerSkill entity is found.
+// Returns a *NotFoundError when no ManagerSkill entities are found.
+func (msq *ManagerSkillQuery) Only(ctx context.Context) (*ManagerSkill, error) {
+	nodes, err := msq.Limit(2).All(setContextOp(ctx, msq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{managerskill.Label}
+	default:
+		return nil, &NotSingularError{managerskill.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (msq *ManagerSkillQuery) OnlyX(ctx context.Context) *ManagerSkill {
+	node, err := msq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ManagerSkill ID in the query.
+// Returns a *NotSingularError when more than one ManagerSkill ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (msq *ManagerSkillQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msq.Limit(2).IDs(setContextOp(ctx, msq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{managerskill.Label}
+	default:
+		err = &NotSingularError{managerskill.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (msq *ManagerSkillQuery) OnlyIDX(ctx context.Context) int {
+	id, err := msq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ManagerSkills.
+func (msq *ManagerSkillQuery) All(ctx context.Context) ([]*ManagerSkill, error) {
+	ctx = setContextOp(ctx, msq.ctx, "All")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ManagerSkill, *ManagerSkillQuery]()
+	return withInterceptors[[]*ManagerSkill](ctx, msq, qr, msq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (msq *ManagerSkillQuery) AllX(ctx context.Context) []*ManagerSkill {
+	nodes, err := msq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ManagerSkill IDs.
+func (msq *ManagerSkillQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if msq.ctx.Unique == nil && msq.path != nil {
+		msq.Unique(true)
+	}
+	ctx = setContextOp(ctx, msq.ctx, "IDs")
+	if err = msq.Select(managerskill.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (msq *ManagerSkillQuery) IDsX(ctx context.Context) []int {
+	ids, err := msq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (msq *ManagerSkillQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Count")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, msq, querierCount[*ManagerSkillQuery](), msq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (msq *ManagerSkillQuery) CountX(ctx context.Context) int {
+	count, err := msq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (msq *ManagerSkillQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Exist")
+	switch _, err := msq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (msq *ManagerSkillQuery) ExistX(ctx context.Context) bool {
+	exist, err := msq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ManagerSkillQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (msq *ManagerSkillQuery) Clone() *ManagerSkillQuery {
+	if msq == nil {
+		return nil
+	}
+	return &ManagerSkillQuery{
+		config:     msq.config,
+		ctx:        msq.ctx.Clone(),
+		order:      append([]managerskill.OrderOption{}, msq.order...),
+		inters:     append([]Interceptor{}, msq.inters...),
+		predicates: append([]predicate.ManagerSkill{}, msq.predicates...),
+		// clone intermediate query.
+		sql:  msq.sql.Clone(),
+		path: msq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ManagerID types.UserID `json:"manager_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ManagerSkill.Query().
+//		GroupBy(managerskill.FieldManagerID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (msq *ManagerSkillQuery) GroupBy(field string, fields ...string) *ManagerSkillGroupBy {
+	msq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ManagerSkillGroupBy{build: msq}
+	grbuild.flds = &msq.ctx.Fields
+	grbuild.label = managerskill.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ManagerID types.UserID `json:"manager_id,omitempty"`
+//	}
+//
+//	client.ManagerSkill.Query().
+//		Select(managerskill.FieldManagerID).
+//		Scan(ctx, &v)
+func (msq *ManagerSkillQuery) Select(fields ...string) *ManagerSkillSelect {
+	msq.ctx.Fields = append(msq.ctx.Fields, fields...)
+	sbuild := &ManagerSkillSelect{ManagerSkillQuery: msq}
+	sbuild.label = managerskill.Label
+	sbuild.flds, sbuild.scan = &msq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ManagerSkillSelect configured with the given aggregations.
+func (msq *ManagerSkillQuery) Aggregate(fns ...AggregateFunc) *ManagerSkillSelect {
+	return msq.Select().Aggregate(fns...)
+}
+
+func (msq *ManagerSkillQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range msq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, msq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range msq.ctx.Fields {
+		if !managerskill.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if msq.path != nil {
+		prev, err := msq.path(ctx)
+		if err != nil {
+			return err
+		}
+		msq.sql = prev
+	}
+	return nil
+}
+
+func (msq *ManagerSkillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ManagerSkill, error) {
+	var (
+		nodes = []*ManagerSkill{}
+		_spec = msq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ManagerSkill).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ManagerSkill{config: msq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(msq.modifiers) > 0 {
+		_spec.Modifiers = msq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, msq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (msq *ManagerSkillQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := msq.querySpec()
+	if len(msq.modifiers) > 0 {
+		_spec.Modifiers = msq.modifiers
+	}
+	_spec.Node.Columns = msq.ctx.Fields
+	if len(msq.ctx.Fields) > 0 {
+		_spec.Unique = msq.ctx.Unique != nil && *msq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, msq.driver, _spec)
+}
+
+func (msq *ManagerSkillQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(managerskill.Table, managerskill.Columns, sqlgraph.NewFieldSpec(managerskill.FieldID, field.TypeInt))
+	_spec.From = msq.sql
+	if unique := msq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if msq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := msq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, managerskill.FieldID)
+		for i := range fields {
+			if fields[i] != managerskill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := msq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := msq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (msq *ManagerSkillQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(msq.driver.Dialect())
+	t1 := builder.Table(managerskill.Table)
+	columns := msq.ctx.Fields
+	if len(columns) == 0 {
+		columns = managerskill.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if msq.sql != nil {
+		selector = msq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if msq.ctx.Unique != nil && *msq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range msq.modifiers {
+		m(selector)
+	}
+	for _, p := range msq.predicates {
+		p(selector)
+	}
+	for _, p := range msq.order {
+		p(selector)
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (msq *ManagerSkillQuery) ForUpdate(opts ...sql.LockOption) *ManagerSkillQuery {
+	if msq.driver.Dialect() == dialect.Postgres {
+		msq.Unique(false)
+	}
+	msq.modifiers = append(msq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return msq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (msq *ManagerSkillQuery) ForShare(opts ...sql.LockOption) *ManagerSkillQuery {
+	if msq.driver.Dialect() == dialect.Postgres {
+		msq.Unique(false)
+	}
+	msq.modifiers = append(msq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return msq
+}
+
+// ManagerSkillGroupBy is the group-by builder for ManagerSkill entities.
+type ManagerSkillGroupBy struct {
+	selector
+	build *ManagerSkillQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (msgb *ManagerSkillGroupBy) Aggregate(fns ...AggregateFunc) *ManagerSkillGroupBy {
+	msgb.fns = append(msgb.fns, fns...)
+	return msgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msgb *ManagerSkillGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, msgb.build.ctx, "GroupBy")
+	if err := msgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManagerSkillQuery, *ManagerSkillGroupBy](ctx, msgb.build, msgb, msgb.build.inters, v)
+}
+
+func (msgb *ManagerSkillGroupBy) sqlScan(ctx context.Context, root *ManagerSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(msgb.fns))
+	for _, fn := range msgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*msgb.flds)+len(msgb.fns))
+		for _, f := range *msgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*msgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ManagerSkillSelect is the builder for selecting fields of ManagerSkill entities.
+type ManagerSkillSelect struct {
+	*ManagerSkillQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mss *ManagerSkillSelect) Aggregate(fns ...AggregateFunc) *ManagerSkillSelect {
+	mss.fns = append(mss.fns, fns...)
+	return mss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mss *ManagerSkillSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mss.ctx, "Select")
+	if err := mss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManagerSkillQuery, *ManagerSkillSelect](ctx, mss.ManagerSkillQuery, mss, mss.inters, v)
+}
+
+func (mss *ManagerSkillSelect) sqlScan(ctx context.Context, root *ManagerSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mss.fns))
+	for _, fn := range mss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
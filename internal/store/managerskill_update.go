@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// ManagerSkillUpdate is the builder for updating ManagerSkill entities.
+type ManagerSkillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ManagerSkillMutation
+}
+
+// Where appends a list predicates to the ManagerSkillUpdate builder.
+func (msu *ManagerSkillUpdate) Where(ps ...predicate.ManagerSkill) *ManagerSkillUpdate {
+	msu.mutation.Where(ps...)
+	return msu
+}
+
+// Mutation returns the ManagerSkillMutation object of the builder.
+func (msu *ManagerSkillUpdate) Mutation() *ManagerSkillMutation {
+	return msu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (msu *ManagerSkillUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, msu.sqlSave, msu.mutation, msu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msu *ManagerSkillUpdate) SaveX(ctx context.Context) int {
+	affected, err := msu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (msu *ManagerSkillUpdate) Exec(ctx context.Context) error {
+	_, err := msu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msu *ManagerSkillUpdate) ExecX(ctx context.Context) {
+	if err := msu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (msu *ManagerSkillUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(managerskill.Table, managerskill.Columns, sqlgraph.NewFieldSpec(managerskill.FieldID, field.TypeInt))
+	if ps := msu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, msu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managerskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	msu.mutation.done = true
+	return n, nil
+}
+
+// ManagerSkillUpdateOne is the builder for updating a single ManagerSkill entity.
+type ManagerSkillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ManagerSkillMutation
+}
+
+// Mutation returns the ManagerSkillMutation object of the builder.
+func (msuo *ManagerSkillUpdateOne) Mutation() *ManagerSkillMutation {
+	return msuo.mutation
+}
+
+// Where appends a list predicates to the ManagerSkillUpdate builder.
+func (msuo *ManagerSkillUpdateOne) Where(ps ...predicate.ManagerSkill) *ManagerSkillUpdateOne {
+	msuo.mutation.Where(ps...)
+	return msuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (msuo *ManagerSkillUpdateOne) Select(field string, fields ...string) *ManagerSkillUpdateOne {
+	msuo.fields = append([]string{field}, fields...)
+	return msuo
+}
+
+// Save executes the query and returns the updated ManagerSkill entity.
+func (msuo *ManagerSkillUpdateOne) Save(ctx context.Context) (*ManagerSkill, error) {
+	return withHooks(ctx, msuo.sqlSave, msuo.mutation, msuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msuo *ManagerSkillUpdateOne) SaveX(ctx context.Context) *ManagerSkill {
+	node, err := msuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (msuo *ManagerSkillUpdateOne) Exec(ctx context.Context) error {
+	_, err := msuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msuo *ManagerSkillUpdateOne) ExecX(ctx context.Context) {
+	if err := msuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (msuo *ManagerSkillUpdateOne) sqlSave(ctx context.Context) (_node *ManagerSkill, err error) {
+	_spec := sqlgraph.NewUpdateSpec(managerskill.Table, managerskill.Columns, sqlgraph.NewFieldSpec(managerskill.FieldID, field.TypeInt))
+	id, ok := msuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "ManagerSkill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := msuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, managerskill.FieldID)
+		for _, f := range fields {
+			if !managerskill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != managerskill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := msuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ManagerSkill{config: msuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, msuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managerskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	msuo.mutation.done = true
+	return _node, nil
+}
@@ -27,6 +27,8 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 2147483647},
 		{Name: "payload", Type: field.TypeString, Size: 2147483647},
 		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "requeues", Type: field.TypeInt, Default: 0},
+		{Name: "job_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// FailedJobsTable holds the schema information for the "failed_jobs" table.
@@ -34,6 +36,18 @@ var (
 		Name:       "failed_jobs",
 		Columns:    FailedJobsColumns,
 		PrimaryKey: []*schema.Column{FailedJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "failedjob_name_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FailedJobsColumns[1], FailedJobsColumns[6]},
+			},
+			{
+				Name:    "failedjob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FailedJobsColumns[6]},
+			},
+		},
 	}
 	// JobsColumns holds the columns for the "jobs" table.
 	JobsColumns = []*schema.Column{
@@ -43,6 +57,10 @@ var (
 		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "available_at", Type: field.TypeTime},
 		{Name: "reserved_until", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "requeues", Type: field.TypeInt, Default: 0},
+		{Name: "ordering_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// JobsTable holds the schema information for the "jobs" table.
@@ -54,13 +72,86 @@ var (
 			{
 				Name:    "job_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{JobsColumns[6]},
+				Columns: []*schema.Column{JobsColumns[10]},
 			},
 			{
 				Name:    "job_reserved_until_available_at",
 				Unique:  false,
 				Columns: []*schema.Column{JobsColumns[5], JobsColumns[4]},
 			},
+			{
+				Name:    "job_ordering_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[8], JobsColumns[10]},
+			},
+			{
+				Name:    "job_dedup_key",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[9]},
+			},
+		},
+	}
+	// JobAttemptsColumns holds the columns for the "job_attempts" table.
+	JobAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "job_id", Type: field.TypeUUID},
+		{Name: "job_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "worker", Type: field.TypeString, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"succeeded", "failed", "timed_out", "interrupted"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// JobAttemptsTable holds the schema information for the "job_attempts" table.
+	JobAttemptsTable = &schema.Table{
+		Name:       "job_attempts",
+		Columns:    JobAttemptsColumns,
+		PrimaryKey: []*schema.Column{JobAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobattempt_job_id_attempt",
+				Unique:  false,
+				Columns: []*schema.Column{JobAttemptsColumns[1], JobAttemptsColumns[3]},
+			},
+			{
+				Name:    "jobattempt_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobAttemptsColumns[5]},
+			},
+		},
+	}
+	// ManagerCapacitiesColumns holds the columns for the "manager_capacities" table.
+	ManagerCapacitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "manager_id", Type: field.TypeUUID, Unique: true},
+		{Name: "capacity", Type: field.TypeInt},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ManagerCapacitiesTable holds the schema information for the "manager_capacities" table.
+	ManagerCapacitiesTable = &schema.Table{
+		Name:       "manager_capacities",
+		Columns:    ManagerCapacitiesColumns,
+		PrimaryKey: []*schema.Column{ManagerCapacitiesColumns[0]},
+	}
+	// ManagerSkillsColumns holds the columns for the "manager_skills" table.
+	ManagerSkillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "manager_id", Type: field.TypeUUID},
+		{Name: "skill", Type: field.TypeEnum, Enums: []string{"cards", "loans", "deposits"}},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ManagerSkillsTable holds the schema information for the "manager_skills" table.
+	ManagerSkillsTable = &schema.Table{
+		Name:       "manager_skills",
+		Columns:    ManagerSkillsColumns,
+		PrimaryKey: []*schema.Column{ManagerSkillsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "managerskill_manager_id_skill",
+				Unique:  true,
+				Columns: []*schema.Column{ManagerSkillsColumns[1], ManagerSkillsColumns[2]},
+			},
 		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
@@ -105,12 +196,26 @@ var (
 			},
 		},
 	}
+	// PooledManagersColumns holds the columns for the "pooled_managers" table.
+	PooledManagersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "manager_id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PooledManagersTable holds the schema information for the "pooled_managers" table.
+	PooledManagersTable = &schema.Table{
+		Name:       "pooled_managers",
+		Columns:    PooledManagersColumns,
+		PrimaryKey: []*schema.Column{PooledManagersColumns[0]},
+	}
 	// ProblemsColumns holds the columns for the "problems" table.
 	ProblemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "manager_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "assigned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "topic", Type: field.TypeEnum, Nullable: true, Enums: []string{"cards", "loans", "deposits"}},
 		{Name: "chat_id", Type: field.TypeUUID},
 	}
 	// ProblemsTable holds the schema information for the "problems" table.
@@ -121,7 +226,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "problems_chats_chat",
-				Columns:    []*schema.Column{ProblemsColumns[4]},
+				Columns:    []*schema.Column{ProblemsColumns[6]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -130,7 +235,7 @@ var (
 			{
 				Name:    "problem_chat_id",
 				Unique:  true,
-				Columns: []*schema.Column{ProblemsColumns[4]},
+				Columns: []*schema.Column{ProblemsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "(resolved_at IS NULL AND manager_id IS NULL)",
 				},
@@ -138,7 +243,7 @@ var (
 			{
 				Name:    "problems_chat_id_idx",
 				Unique:  true,
-				Columns: []*schema.Column{ProblemsColumns[4]},
+				Columns: []*schema.Column{ProblemsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "(resolved_at IS NULL AND manager_id IS NOT NULL)",
 				},
@@ -150,7 +255,11 @@ var (
 		ChatsTable,
 		FailedJobsTable,
 		JobsTable,
+		JobAttemptsTable,
+		ManagerCapacitiesTable,
+		ManagerSkillsTable,
 		MessagesTable,
+		PooledManagersTable,
 		ProblemsTable,
 	}
 )
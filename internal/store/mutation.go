@@ -14,7 +14,11 @@ import (
 	"github.com/keepcalmist/chat-service/internal/store/chat"
 	"github.com/keepcalmist/chat-service/internal/store/failedjob"
 	"github.com/keepcalmist/chat-service/internal/store/job"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
 	"github.com/keepcalmist/chat-service/internal/store/message"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
 	"github.com/keepcalmist/chat-service/internal/store/predicate"
 	"github.com/keepcalmist/chat-service/internal/store/problem"
 	"github.com/keepcalmist/chat-service/internal/types"
@@ -29,11 +33,15 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChat      = "Chat"
-	TypeFailedJob = "FailedJob"
-	TypeJob       = "Job"
-	TypeMessage   = "Message"
-	TypeProblem   = "Problem"
+	TypeChat            = "Chat"
+	TypeFailedJob       = "FailedJob"
+	TypeJob             = "Job"
+	TypeJobAttempt      = "JobAttempt"
+	TypeManagerCapacity = "ManagerCapacity"
+	TypeManagerSkill    = "ManagerSkill"
+	TypeMessage         = "Message"
+	TypePooledManager   = "PooledManager"
+	TypeProblem         = "Problem"
 )
 
 // ChatMutation represents an operation that mutates the Chat nodes in the graph.
@@ -607,6 +615,9 @@ type FailedJobMutation struct {
 	name          *string
 	payload       *string
 	reason        *string
+	requeues      *int
+	addrequeues   *int
+	job_id        *types.JobID
 	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
@@ -826,6 +837,111 @@ func (m *FailedJobMutation) ResetReason() {
 	m.reason = nil
 }
 
+// SetRequeues sets the "requeues" field.
+func (m *FailedJobMutation) SetRequeues(i int) {
+	m.requeues = &i
+	m.addrequeues = nil
+}
+
+// Requeues returns the value of the "requeues" field in the mutation.
+func (m *FailedJobMutation) Requeues() (r int, exists bool) {
+	v := m.requeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeues returns the old "requeues" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldRequeues(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeues: %w", err)
+	}
+	return oldValue.Requeues, nil
+}
+
+// AddRequeues adds i to the "requeues" field.
+func (m *FailedJobMutation) AddRequeues(i int) {
+	if m.addrequeues != nil {
+		*m.addrequeues += i
+	} else {
+		m.addrequeues = &i
+	}
+}
+
+// AddedRequeues returns the value that was added to the "requeues" field in this mutation.
+func (m *FailedJobMutation) AddedRequeues() (r int, exists bool) {
+	v := m.addrequeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequeues resets all changes to the "requeues" field.
+func (m *FailedJobMutation) ResetRequeues() {
+	m.requeues = nil
+	m.addrequeues = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *FailedJobMutation) SetJobID(ti types.JobID) {
+	m.job_id = &ti
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *FailedJobMutation) JobID() (r types.JobID, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldJobID(ctx context.Context) (v types.JobID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (m *FailedJobMutation) ClearJobID() {
+	m.job_id = nil
+	m.clearedFields[failedjob.FieldJobID] = struct{}{}
+}
+
+// JobIDCleared returns if the "job_id" field was cleared in this mutation.
+func (m *FailedJobMutation) JobIDCleared() bool {
+	_, ok := m.clearedFields[failedjob.FieldJobID]
+	return ok
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *FailedJobMutation) ResetJobID() {
+	m.job_id = nil
+	delete(m.clearedFields, failedjob.FieldJobID)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -896,7 +1012,7 @@ func (m *FailedJobMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FailedJobMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 6)
 	if m.name != nil {
 		fields = append(fields, failedjob.FieldName)
 	}
@@ -906,6 +1022,12 @@ func (m *FailedJobMutation) Fields() []string {
 	if m.reason != nil {
 		fields = append(fields, failedjob.FieldReason)
 	}
+	if m.requeues != nil {
+		fields = append(fields, failedjob.FieldRequeues)
+	}
+	if m.job_id != nil {
+		fields = append(fields, failedjob.FieldJobID)
+	}
 	if m.created_at != nil {
 		fields = append(fields, failedjob.FieldCreatedAt)
 	}
@@ -923,6 +1045,10 @@ func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 		return m.Payload()
 	case failedjob.FieldReason:
 		return m.Reason()
+	case failedjob.FieldRequeues:
+		return m.Requeues()
+	case failedjob.FieldJobID:
+		return m.JobID()
 	case failedjob.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -940,6 +1066,10 @@ func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldPayload(ctx)
 	case failedjob.FieldReason:
 		return m.OldReason(ctx)
+	case failedjob.FieldRequeues:
+		return m.OldRequeues(ctx)
+	case failedjob.FieldJobID:
+		return m.OldJobID(ctx)
 	case failedjob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -972,6 +1102,20 @@ func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetReason(v)
 		return nil
+	case failedjob.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeues(v)
+		return nil
+	case failedjob.FieldJobID:
+		v, ok := value.(types.JobID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
 	case failedjob.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -986,13 +1130,21 @@ func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *FailedJobMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addrequeues != nil {
+		fields = append(fields, failedjob.FieldRequeues)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case failedjob.FieldRequeues:
+		return m.AddedRequeues()
+	}
 	return nil, false
 }
 
@@ -1001,6 +1153,13 @@ func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case failedjob.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequeues(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FailedJob numeric field %s", name)
 }
@@ -1008,7 +1167,11 @@ func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *FailedJobMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(failedjob.FieldJobID) {
+		fields = append(fields, failedjob.FieldJobID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1021,6 +1184,11 @@ func (m *FailedJobMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *FailedJobMutation) ClearField(name string) error {
+	switch name {
+	case failedjob.FieldJobID:
+		m.ClearJobID()
+		return nil
+	}
 	return fmt.Errorf("unknown FailedJob nullable field %s", name)
 }
 
@@ -1037,6 +1205,12 @@ func (m *FailedJobMutation) ResetField(name string) error {
 	case failedjob.FieldReason:
 		m.ResetReason()
 		return nil
+	case failedjob.FieldRequeues:
+		m.ResetRequeues()
+		return nil
+	case failedjob.FieldJobID:
+		m.ResetJobID()
+		return nil
 	case failedjob.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -1104,6 +1278,11 @@ type JobMutation struct {
 	addattempts    *int
 	available_at   *time.Time
 	reserved_until *time.Time
+	last_error     *string
+	requeues       *int
+	addrequeues    *int
+	ordering_key   *string
+	dedup_key      *string
 	created_at     *time.Time
 	clearedFields  map[string]struct{}
 	done           bool
@@ -1415,6 +1594,209 @@ func (m *JobMutation) ResetReservedUntil() {
 	m.reserved_until = nil
 }
 
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *JobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[job.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *JobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, job.FieldLastError)
+}
+
+// SetRequeues sets the "requeues" field.
+func (m *JobMutation) SetRequeues(i int) {
+	m.requeues = &i
+	m.addrequeues = nil
+}
+
+// Requeues returns the value of the "requeues" field in the mutation.
+func (m *JobMutation) Requeues() (r int, exists bool) {
+	v := m.requeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeues returns the old "requeues" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRequeues(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeues: %w", err)
+	}
+	return oldValue.Requeues, nil
+}
+
+// AddRequeues adds i to the "requeues" field.
+func (m *JobMutation) AddRequeues(i int) {
+	if m.addrequeues != nil {
+		*m.addrequeues += i
+	} else {
+		m.addrequeues = &i
+	}
+}
+
+// AddedRequeues returns the value that was added to the "requeues" field in this mutation.
+func (m *JobMutation) AddedRequeues() (r int, exists bool) {
+	v := m.addrequeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequeues resets all changes to the "requeues" field.
+func (m *JobMutation) ResetRequeues() {
+	m.requeues = nil
+	m.addrequeues = nil
+}
+
+// SetOrderingKey sets the "ordering_key" field.
+func (m *JobMutation) SetOrderingKey(s string) {
+	m.ordering_key = &s
+}
+
+// OrderingKey returns the value of the "ordering_key" field in the mutation.
+func (m *JobMutation) OrderingKey() (r string, exists bool) {
+	v := m.ordering_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderingKey returns the old "ordering_key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldOrderingKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderingKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderingKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderingKey: %w", err)
+	}
+	return oldValue.OrderingKey, nil
+}
+
+// ClearOrderingKey clears the value of the "ordering_key" field.
+func (m *JobMutation) ClearOrderingKey() {
+	m.ordering_key = nil
+	m.clearedFields[job.FieldOrderingKey] = struct{}{}
+}
+
+// OrderingKeyCleared returns if the "ordering_key" field was cleared in this mutation.
+func (m *JobMutation) OrderingKeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldOrderingKey]
+	return ok
+}
+
+// ResetOrderingKey resets all changes to the "ordering_key" field.
+func (m *JobMutation) ResetOrderingKey() {
+	m.ordering_key = nil
+	delete(m.clearedFields, job.FieldOrderingKey)
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (m *JobMutation) SetDedupKey(s string) {
+	m.dedup_key = &s
+}
+
+// DedupKey returns the value of the "dedup_key" field in the mutation.
+func (m *JobMutation) DedupKey() (r string, exists bool) {
+	v := m.dedup_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDedupKey returns the old "dedup_key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDedupKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDedupKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDedupKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDedupKey: %w", err)
+	}
+	return oldValue.DedupKey, nil
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (m *JobMutation) ClearDedupKey() {
+	m.dedup_key = nil
+	m.clearedFields[job.FieldDedupKey] = struct{}{}
+}
+
+// DedupKeyCleared returns if the "dedup_key" field was cleared in this mutation.
+func (m *JobMutation) DedupKeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldDedupKey]
+	return ok
+}
+
+// ResetDedupKey resets all changes to the "dedup_key" field.
+func (m *JobMutation) ResetDedupKey() {
+	m.dedup_key = nil
+	delete(m.clearedFields, job.FieldDedupKey)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *JobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -1485,7 +1867,7 @@ func (m *JobMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 10)
 	if m.name != nil {
 		fields = append(fields, job.FieldName)
 	}
@@ -1501,6 +1883,18 @@ func (m *JobMutation) Fields() []string {
 	if m.reserved_until != nil {
 		fields = append(fields, job.FieldReservedUntil)
 	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.requeues != nil {
+		fields = append(fields, job.FieldRequeues)
+	}
+	if m.ordering_key != nil {
+		fields = append(fields, job.FieldOrderingKey)
+	}
+	if m.dedup_key != nil {
+		fields = append(fields, job.FieldDedupKey)
+	}
 	if m.created_at != nil {
 		fields = append(fields, job.FieldCreatedAt)
 	}
@@ -1522,6 +1916,14 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 		return m.AvailableAt()
 	case job.FieldReservedUntil:
 		return m.ReservedUntil()
+	case job.FieldLastError:
+		return m.LastError()
+	case job.FieldRequeues:
+		return m.Requeues()
+	case job.FieldOrderingKey:
+		return m.OrderingKey()
+	case job.FieldDedupKey:
+		return m.DedupKey()
 	case job.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -1543,6 +1945,14 @@ func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldAvailableAt(ctx)
 	case job.FieldReservedUntil:
 		return m.OldReservedUntil(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	case job.FieldRequeues:
+		return m.OldRequeues(ctx)
+	case job.FieldOrderingKey:
+		return m.OldOrderingKey(ctx)
+	case job.FieldDedupKey:
+		return m.OldDedupKey(ctx)
 	case job.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -1589,6 +1999,34 @@ func (m *JobMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetReservedUntil(v)
 		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case job.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeues(v)
+		return nil
+	case job.FieldOrderingKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderingKey(v)
+		return nil
+	case job.FieldDedupKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupKey(v)
+		return nil
 	case job.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -1607,6 +2045,9 @@ func (m *JobMutation) AddedFields() []string {
 	if m.addattempts != nil {
 		fields = append(fields, job.FieldAttempts)
 	}
+	if m.addrequeues != nil {
+		fields = append(fields, job.FieldRequeues)
+	}
 	return fields
 }
 
@@ -1617,6 +2058,8 @@ func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case job.FieldAttempts:
 		return m.AddedAttempts()
+	case job.FieldRequeues:
+		return m.AddedRequeues()
 	}
 	return nil, false
 }
@@ -1633,6 +2076,13 @@ func (m *JobMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddAttempts(v)
 		return nil
+	case job.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequeues(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Job numeric field %s", name)
 }
@@ -1640,7 +2090,17 @@ func (m *JobMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *JobMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(job.FieldLastError) {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.FieldCleared(job.FieldOrderingKey) {
+		fields = append(fields, job.FieldOrderingKey)
+	}
+	if m.FieldCleared(job.FieldDedupKey) {
+		fields = append(fields, job.FieldDedupKey)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1653,6 +2113,17 @@ func (m *JobMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case job.FieldOrderingKey:
+		m.ClearOrderingKey()
+		return nil
+	case job.FieldDedupKey:
+		m.ClearDedupKey()
+		return nil
+	}
 	return fmt.Errorf("unknown Job nullable field %s", name)
 }
 
@@ -1675,6 +2146,18 @@ func (m *JobMutation) ResetField(name string) error {
 	case job.FieldReservedUntil:
 		m.ResetReservedUntil()
 		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case job.FieldRequeues:
+		m.ResetRequeues()
+		return nil
+	case job.FieldOrderingKey:
+		m.ResetOrderingKey()
+		return nil
+	case job.FieldDedupKey:
+		m.ResetDedupKey()
+		return nil
 	case job.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -1730,42 +2213,38 @@ func (m *JobMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Job edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// JobAttemptMutation represents an operation that mutates the JobAttempt nodes in the graph.
+type JobAttemptMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *types.MessageID
-	author_id              *types.UserID
-	is_visible_for_client  *bool
-	is_visible_for_manager *bool
-	body                   *string
-	checked_at             *time.Time
-	is_blocked             *bool
-	is_service             *bool
-	created_at             *time.Time
-	initial_request_id     *types.RequestID
-	clearedFields          map[string]struct{}
-	chat                   *types.ChatID
-	clearedchat            bool
-	problem                *types.ProblemID
-	clearedproblem         bool
-	done                   bool
-	oldValue               func(context.Context) (*Message, error)
-	predicates             []predicate.Message
+	op            Op
+	typ           string
+	id            *types.JobAttemptID
+	job_id        *types.JobID
+	job_name      *string
+	attempt       *int
+	addattempt    *int
+	worker        *string
+	started_at    *time.Time
+	finished_at   *time.Time
+	outcome       *jobattempt.Outcome
+	error         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JobAttempt, error)
+	predicates    []predicate.JobAttempt
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*JobAttemptMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// jobattemptOption allows management of the mutation configuration using functional options.
+type jobattemptOption func(*JobAttemptMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newJobAttemptMutation creates new mutation for the JobAttempt entity.
+func newJobAttemptMutation(c config, op Op, opts ...jobattemptOption) *JobAttemptMutation {
+	m := &JobAttemptMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeJobAttempt,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1774,20 +2253,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id types.MessageID) messageOption {
-	return func(m *MessageMutation) {
+// withJobAttemptID sets the ID field of the mutation.
+func withJobAttemptID(id types.JobAttemptID) jobattemptOption {
+	return func(m *JobAttemptMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *JobAttempt
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*JobAttempt, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().JobAttempt.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1796,10 +2275,10 @@ func withMessageID(id types.MessageID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withJobAttempt sets the old JobAttempt of the mutation.
+func withJobAttempt(node *JobAttempt) jobattemptOption {
+	return func(m *JobAttemptMutation) {
+		m.oldValue = func(context.Context) (*JobAttempt, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1808,7 +2287,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m JobAttemptMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1816,7 +2295,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m JobAttemptMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1826,14 +2305,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id types.MessageID) {
+// operation is only accepted on creation of JobAttempt entities.
+func (m *JobAttemptMutation) SetID(id types.JobAttemptID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+func (m *JobAttemptMutation) ID() (id types.JobAttemptID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1844,396 +2323,2072 @@ func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+func (m *JobAttemptMutation) IDs(ctx context.Context) ([]types.JobAttemptID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.MessageID{id}, nil
+			return []types.JobAttemptID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JobAttempt.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAuthorID sets the "author_id" field.
-func (m *MessageMutation) SetAuthorID(ti types.UserID) {
-	m.author_id = &ti
+// SetJobID sets the "job_id" field.
+func (m *JobAttemptMutation) SetJobID(ti types.JobID) {
+	m.job_id = &ti
 }
 
-// AuthorID returns the value of the "author_id" field in the mutation.
-func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
-	v := m.author_id
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *JobAttemptMutation) JobID() (r types.JobID, exists bool) {
+	v := m.job_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorID returns the old "author_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+func (m *JobAttemptMutation) OldJobID(ctx context.Context) (v types.JobID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.AuthorID, nil
-}
-
-// ClearAuthorID clears the value of the "author_id" field.
-func (m *MessageMutation) ClearAuthorID() {
-	m.author_id = nil
-	m.clearedFields[message.FieldAuthorID] = struct{}{}
-}
-
-// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
-func (m *MessageMutation) AuthorIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAuthorID]
-	return ok
+	return oldValue.JobID, nil
 }
 
-// ResetAuthorID resets all changes to the "author_id" field.
-func (m *MessageMutation) ResetAuthorID() {
-	m.author_id = nil
-	delete(m.clearedFields, message.FieldAuthorID)
+// ResetJobID resets all changes to the "job_id" field.
+func (m *JobAttemptMutation) ResetJobID() {
+	m.job_id = nil
 }
 
-// SetIsVisibleForClient sets the "is_visible_for_client" field.
-func (m *MessageMutation) SetIsVisibleForClient(b bool) {
-	m.is_visible_for_client = &b
+// SetJobName sets the "job_name" field.
+func (m *JobAttemptMutation) SetJobName(s string) {
+	m.job_name = &s
 }
 
-// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
-func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
-	v := m.is_visible_for_client
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *JobAttemptMutation) JobName() (r string, exists bool) {
+	v := m.job_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldJobName returns the old "job_name" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+func (m *JobAttemptMutation) OldJobName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+		return v, errors.New("OldJobName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
 	}
-	return oldValue.IsVisibleForClient, nil
+	return oldValue.JobName, nil
 }
 
-// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
-func (m *MessageMutation) ResetIsVisibleForClient() {
-	m.is_visible_for_client = nil
+// ResetJobName resets all changes to the "job_name" field.
+func (m *JobAttemptMutation) ResetJobName() {
+	m.job_name = nil
 }
 
-// SetIsVisibleForManager sets the "is_visible_for_manager" field.
-func (m *MessageMutation) SetIsVisibleForManager(b bool) {
-	m.is_visible_for_manager = &b
+// SetAttempt sets the "attempt" field.
+func (m *JobAttemptMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
 }
 
-// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
-func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
-	v := m.is_visible_for_manager
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *JobAttemptMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempt returns the old "attempt" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+func (m *JobAttemptMutation) OldAttempt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
 	}
-	return oldValue.IsVisibleForManager, nil
-}
-
-// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
-func (m *MessageMutation) ResetIsVisibleForManager() {
-	m.is_visible_for_manager = nil
+	return oldValue.Attempt, nil
 }
 
-// SetBody sets the "body" field.
-func (m *MessageMutation) SetBody(s string) {
-	m.body = &s
+// AddAttempt adds i to the "attempt" field.
+func (m *JobAttemptMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *MessageMutation) Body() (r string, exists bool) {
-	v := m.body
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *JobAttemptMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
-	}
-	return oldValue.Body, nil
-}
-
-// ResetBody resets all changes to the "body" field.
-func (m *MessageMutation) ResetBody() {
-	m.body = nil
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *JobAttemptMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (m *MessageMutation) SetCheckedAt(t time.Time) {
-	m.checked_at = &t
+// SetWorker sets the "worker" field.
+func (m *JobAttemptMutation) SetWorker(s string) {
+	m.worker = &s
 }
 
-// CheckedAt returns the value of the "checked_at" field in the mutation.
-func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
-	v := m.checked_at
+// Worker returns the value of the "worker" field in the mutation.
+func (m *JobAttemptMutation) Worker() (r string, exists bool) {
+	v := m.worker
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldWorker returns the old "worker" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobAttemptMutation) OldWorker(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorker is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+		return v, errors.New("OldWorker requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorker: %w", err)
 	}
-	return oldValue.CheckedAt, nil
+	return oldValue.Worker, nil
 }
 
-// ClearCheckedAt clears the value of the "checked_at" field.
-func (m *MessageMutation) ClearCheckedAt() {
-	m.checked_at = nil
-	m.clearedFields[message.FieldCheckedAt] = struct{}{}
-}
-
-// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
-func (m *MessageMutation) CheckedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldCheckedAt]
-	return ok
-}
-
-// ResetCheckedAt resets all changes to the "checked_at" field.
-func (m *MessageMutation) ResetCheckedAt() {
-	m.checked_at = nil
-	delete(m.clearedFields, message.FieldCheckedAt)
+// ResetWorker resets all changes to the "worker" field.
+func (m *JobAttemptMutation) ResetWorker() {
+	m.worker = nil
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *MessageMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetStartedAt sets the "started_at" field.
+func (m *JobAttemptMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobAttemptMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *JobAttemptMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.IsBlocked, nil
-}
-
-// ClearIsBlocked clears the value of the "is_blocked" field.
-func (m *MessageMutation) ClearIsBlocked() {
-	m.is_blocked = nil
-	m.clearedFields[message.FieldIsBlocked] = struct{}{}
-}
-
-// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
-func (m *MessageMutation) IsBlockedCleared() bool {
-	_, ok := m.clearedFields[message.FieldIsBlocked]
-	return ok
+	return oldValue.StartedAt, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *MessageMutation) ResetIsBlocked() {
-	m.is_blocked = nil
-	delete(m.clearedFields, message.FieldIsBlocked)
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobAttemptMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetIsService sets the "is_service" field.
-func (m *MessageMutation) SetIsService(b bool) {
-	m.is_service = &b
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobAttemptMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// IsService returns the value of the "is_service" field in the mutation.
-func (m *MessageMutation) IsService() (r bool, exists bool) {
-	v := m.is_service
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobAttemptMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsService returns the old "is_service" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+func (m *JobAttemptMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsService requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.IsService, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ResetIsService resets all changes to the "is_service" field.
-func (m *MessageMutation) ResetIsService() {
-	m.is_service = nil
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobAttemptMutation) ResetFinishedAt() {
+	m.finished_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOutcome sets the "outcome" field.
+func (m *JobAttemptMutation) SetOutcome(j jobattempt.Outcome) {
+	m.outcome = &j
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *JobAttemptMutation) Outcome() (r jobattempt.Outcome, exists bool) {
+	v := m.outcome
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldOutcome returns the old "outcome" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobAttemptMutation) OldOutcome(ctx context.Context) (v jobattempt.Outcome, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Outcome, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *JobAttemptMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
+// SetError sets the "error" field.
+func (m *JobAttemptMutation) SetError(s string) {
+	m.error = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
+// Error returns the value of the "error" field in the mutation.
+func (m *JobAttemptMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+func (m *JobAttemptMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.Error, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
-	m.chat = nil
+// ClearError clears the value of the "error" field.
+func (m *JobAttemptMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobattempt.FieldError] = struct{}{}
 }
 
-// SetInitialRequestID sets the "initial_request_id" field.
-func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
-	m.initial_request_id = &ti
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobAttemptMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobattempt.FieldError]
+	return ok
 }
 
-// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
-func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
-	v := m.initial_request_id
-	if v == nil {
-		return
+// ResetError resets all changes to the "error" field.
+func (m *JobAttemptMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobattempt.FieldError)
+}
+
+// Where appends a list predicates to the JobAttemptMutation builder.
+func (m *JobAttemptMutation) Where(ps ...predicate.JobAttempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobAttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobAttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobAttempt).
+func (m *JobAttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.job_id != nil {
+		fields = append(fields, jobattempt.FieldJobID)
+	}
+	if m.job_name != nil {
+		fields = append(fields, jobattempt.FieldJobName)
+	}
+	if m.attempt != nil {
+		fields = append(fields, jobattempt.FieldAttempt)
+	}
+	if m.worker != nil {
+		fields = append(fields, jobattempt.FieldWorker)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobattempt.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, jobattempt.FieldFinishedAt)
+	}
+	if m.outcome != nil {
+		fields = append(fields, jobattempt.FieldOutcome)
+	}
+	if m.error != nil {
+		fields = append(fields, jobattempt.FieldError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobattempt.FieldJobID:
+		return m.JobID()
+	case jobattempt.FieldJobName:
+		return m.JobName()
+	case jobattempt.FieldAttempt:
+		return m.Attempt()
+	case jobattempt.FieldWorker:
+		return m.Worker()
+	case jobattempt.FieldStartedAt:
+		return m.StartedAt()
+	case jobattempt.FieldFinishedAt:
+		return m.FinishedAt()
+	case jobattempt.FieldOutcome:
+		return m.Outcome()
+	case jobattempt.FieldError:
+		return m.Error()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobattempt.FieldJobID:
+		return m.OldJobID(ctx)
+	case jobattempt.FieldJobName:
+		return m.OldJobName(ctx)
+	case jobattempt.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case jobattempt.FieldWorker:
+		return m.OldWorker(ctx)
+	case jobattempt.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobattempt.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case jobattempt.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case jobattempt.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobAttempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobattempt.FieldJobID:
+		v, ok := value.(types.JobID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case jobattempt.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case jobattempt.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case jobattempt.FieldWorker:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorker(v)
+		return nil
+	case jobattempt.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobattempt.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case jobattempt.FieldOutcome:
+		v, ok := value.(jobattempt.Outcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case jobattempt.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobAttempt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobAttemptMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempt != nil {
+		fields = append(fields, jobattempt.FieldAttempt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobattempt.FieldAttempt:
+		return m.AddedAttempt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobAttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobattempt.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobAttempt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobAttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobattempt.FieldError) {
+		fields = append(fields, jobattempt.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobAttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobAttemptMutation) ClearField(name string) error {
+	switch name {
+	case jobattempt.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobAttempt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobAttemptMutation) ResetField(name string) error {
+	switch name {
+	case jobattempt.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case jobattempt.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case jobattempt.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case jobattempt.FieldWorker:
+		m.ResetWorker()
+		return nil
+	case jobattempt.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobattempt.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case jobattempt.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case jobattempt.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobAttempt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobAttemptMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobAttemptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobAttemptMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobAttemptMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobAttempt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobAttemptMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobAttempt edge %s", name)
+}
+
+// ManagerCapacityMutation represents an operation that mutates the ManagerCapacity nodes in the graph.
+type ManagerCapacityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	manager_id    *types.UserID
+	capacity      *int
+	addcapacity   *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ManagerCapacity, error)
+	predicates    []predicate.ManagerCapacity
+}
+
+var _ ent.Mutation = (*ManagerCapacityMutation)(nil)
+
+// managercapacityOption allows management of the mutation configuration using functional options.
+type managercapacityOption func(*ManagerCapacityMutation)
+
+// newManagerCapacityMutation creates new mutation for the ManagerCapacity entity.
+func newManagerCapacityMutation(c config, op Op, opts ...managercapacityOption) *ManagerCapacityMutation {
+	m := &ManagerCapacityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeManagerCapacity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withManagerCapacityID sets the ID field of the mutation.
+func withManagerCapacityID(id int) managercapacityOption {
+	return func(m *ManagerCapacityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ManagerCapacity
+		)
+		m.oldValue = func(ctx context.Context) (*ManagerCapacity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ManagerCapacity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withManagerCapacity sets the old ManagerCapacity of the mutation.
+func withManagerCapacity(node *ManagerCapacity) managercapacityOption {
+	return func(m *ManagerCapacityMutation) {
+		m.oldValue = func(context.Context) (*ManagerCapacity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ManagerCapacityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ManagerCapacityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ManagerCapacityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ManagerCapacityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ManagerCapacity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetManagerID sets the "manager_id" field.
+func (m *ManagerCapacityMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
+}
+
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *ManagerCapacityMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManagerID returns the old "manager_id" field's value of the ManagerCapacity entity.
+// If the ManagerCapacity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerCapacityMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+	}
+	return oldValue.ManagerID, nil
+}
+
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *ManagerCapacityMutation) ResetManagerID() {
+	m.manager_id = nil
+}
+
+// SetCapacity sets the "capacity" field.
+func (m *ManagerCapacityMutation) SetCapacity(i int) {
+	m.capacity = &i
+	m.addcapacity = nil
+}
+
+// Capacity returns the value of the "capacity" field in the mutation.
+func (m *ManagerCapacityMutation) Capacity() (r int, exists bool) {
+	v := m.capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapacity returns the old "capacity" field's value of the ManagerCapacity entity.
+// If the ManagerCapacity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerCapacityMutation) OldCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacity: %w", err)
+	}
+	return oldValue.Capacity, nil
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (m *ManagerCapacityMutation) AddCapacity(i int) {
+	if m.addcapacity != nil {
+		*m.addcapacity += i
+	} else {
+		m.addcapacity = &i
+	}
+}
+
+// AddedCapacity returns the value that was added to the "capacity" field in this mutation.
+func (m *ManagerCapacityMutation) AddedCapacity() (r int, exists bool) {
+	v := m.addcapacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCapacity resets all changes to the "capacity" field.
+func (m *ManagerCapacityMutation) ResetCapacity() {
+	m.capacity = nil
+	m.addcapacity = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ManagerCapacityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ManagerCapacityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ManagerCapacity entity.
+// If the ManagerCapacity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerCapacityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ManagerCapacityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ManagerCapacityMutation builder.
+func (m *ManagerCapacityMutation) Where(ps ...predicate.ManagerCapacity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ManagerCapacityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ManagerCapacityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ManagerCapacity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ManagerCapacityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ManagerCapacityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ManagerCapacity).
+func (m *ManagerCapacityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ManagerCapacityMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.manager_id != nil {
+		fields = append(fields, managercapacity.FieldManagerID)
+	}
+	if m.capacity != nil {
+		fields = append(fields, managercapacity.FieldCapacity)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, managercapacity.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ManagerCapacityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case managercapacity.FieldManagerID:
+		return m.ManagerID()
+	case managercapacity.FieldCapacity:
+		return m.Capacity()
+	case managercapacity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ManagerCapacityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case managercapacity.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case managercapacity.FieldCapacity:
+		return m.OldCapacity(ctx)
+	case managercapacity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ManagerCapacity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ManagerCapacityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case managercapacity.FieldManagerID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManagerID(v)
+		return nil
+	case managercapacity.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacity(v)
+		return nil
+	case managercapacity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ManagerCapacity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ManagerCapacityMutation) AddedFields() []string {
+	var fields []string
+	if m.addcapacity != nil {
+		fields = append(fields, managercapacity.FieldCapacity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ManagerCapacityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case managercapacity.FieldCapacity:
+		return m.AddedCapacity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ManagerCapacityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case managercapacity.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ManagerCapacity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ManagerCapacityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ManagerCapacityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ManagerCapacityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ManagerCapacity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ManagerCapacityMutation) ResetField(name string) error {
+	switch name {
+	case managercapacity.FieldManagerID:
+		m.ResetManagerID()
+		return nil
+	case managercapacity.FieldCapacity:
+		m.ResetCapacity()
+		return nil
+	case managercapacity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ManagerCapacity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ManagerCapacityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ManagerCapacityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ManagerCapacityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ManagerCapacityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ManagerCapacityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ManagerCapacityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ManagerCapacityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ManagerCapacity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ManagerCapacityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ManagerCapacity edge %s", name)
+}
+
+// ManagerSkillMutation represents an operation that mutates the ManagerSkill nodes in the graph.
+type ManagerSkillMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	manager_id    *types.UserID
+	skill         *types.Topic
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ManagerSkill, error)
+	predicates    []predicate.ManagerSkill
+}
+
+var _ ent.Mutation = (*ManagerSkillMutation)(nil)
+
+// managerskillOption allows management of the mutation configuration using functional options.
+type managerskillOption func(*ManagerSkillMutation)
+
+// newManagerSkillMutation creates new mutation for the ManagerSkill entity.
+func newManagerSkillMutation(c config, op Op, opts ...managerskillOption) *ManagerSkillMutation {
+	m := &ManagerSkillMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeManagerSkill,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withManagerSkillID sets the ID field of the mutation.
+func withManagerSkillID(id int) managerskillOption {
+	return func(m *ManagerSkillMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ManagerSkill
+		)
+		m.oldValue = func(ctx context.Context) (*ManagerSkill, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ManagerSkill.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withManagerSkill sets the old ManagerSkill of the mutation.
+func withManagerSkill(node *ManagerSkill) managerskillOption {
+	return func(m *ManagerSkillMutation) {
+		m.oldValue = func(context.Context) (*ManagerSkill, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ManagerSkillMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ManagerSkillMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ManagerSkillMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ManagerSkillMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ManagerSkill.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetManagerID sets the "manager_id" field.
+func (m *ManagerSkillMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
+}
+
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *ManagerSkillMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManagerID returns the old "manager_id" field's value of the ManagerSkill entity.
+// If the ManagerSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerSkillMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+	}
+	return oldValue.ManagerID, nil
+}
+
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *ManagerSkillMutation) ResetManagerID() {
+	m.manager_id = nil
+}
+
+// SetSkill sets the "skill" field.
+func (m *ManagerSkillMutation) SetSkill(t types.Topic) {
+	m.skill = &t
+}
+
+// Skill returns the value of the "skill" field in the mutation.
+func (m *ManagerSkillMutation) Skill() (r types.Topic, exists bool) {
+	v := m.skill
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkill returns the old "skill" field's value of the ManagerSkill entity.
+// If the ManagerSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerSkillMutation) OldSkill(ctx context.Context) (v types.Topic, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkill is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkill requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkill: %w", err)
+	}
+	return oldValue.Skill, nil
+}
+
+// ResetSkill resets all changes to the "skill" field.
+func (m *ManagerSkillMutation) ResetSkill() {
+	m.skill = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ManagerSkillMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ManagerSkillMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ManagerSkill entity.
+// If the ManagerSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ManagerSkillMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ManagerSkillMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ManagerSkillMutation builder.
+func (m *ManagerSkillMutation) Where(ps ...predicate.ManagerSkill) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ManagerSkillMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ManagerSkillMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ManagerSkill, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ManagerSkillMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ManagerSkillMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ManagerSkill).
+func (m *ManagerSkillMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ManagerSkillMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.manager_id != nil {
+		fields = append(fields, managerskill.FieldManagerID)
+	}
+	if m.skill != nil {
+		fields = append(fields, managerskill.FieldSkill)
+	}
+	if m.created_at != nil {
+		fields = append(fields, managerskill.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ManagerSkillMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case managerskill.FieldManagerID:
+		return m.ManagerID()
+	case managerskill.FieldSkill:
+		return m.Skill()
+	case managerskill.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ManagerSkillMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case managerskill.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case managerskill.FieldSkill:
+		return m.OldSkill(ctx)
+	case managerskill.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ManagerSkill field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ManagerSkillMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case managerskill.FieldManagerID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManagerID(v)
+		return nil
+	case managerskill.FieldSkill:
+		v, ok := value.(types.Topic)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkill(v)
+		return nil
+	case managerskill.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ManagerSkill field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ManagerSkillMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ManagerSkillMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ManagerSkillMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ManagerSkill numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ManagerSkillMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ManagerSkillMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ManagerSkillMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ManagerSkill nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ManagerSkillMutation) ResetField(name string) error {
+	switch name {
+	case managerskill.FieldManagerID:
+		m.ResetManagerID()
+		return nil
+	case managerskill.FieldSkill:
+		m.ResetSkill()
+		return nil
+	case managerskill.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ManagerSkill field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ManagerSkillMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ManagerSkillMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ManagerSkillMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ManagerSkillMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ManagerSkillMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ManagerSkillMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ManagerSkillMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ManagerSkill unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ManagerSkillMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ManagerSkill edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *types.MessageID
+	author_id              *types.UserID
+	is_visible_for_client  *bool
+	is_visible_for_manager *bool
+	body                   *string
+	checked_at             *time.Time
+	is_blocked             *bool
+	is_service             *bool
+	created_at             *time.Time
+	initial_request_id     *types.RequestID
+	clearedFields          map[string]struct{}
+	chat                   *types.ChatID
+	clearedchat            bool
+	problem                *types.ProblemID
+	clearedproblem         bool
+	done                   bool
+	oldValue               func(context.Context) (*Message, error)
+	predicates             []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id types.MessageID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id types.MessageID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *MessageMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *MessageMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[message.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *MessageMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *MessageMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, message.FieldAuthorID)
+}
+
+// SetIsVisibleForClient sets the "is_visible_for_client" field.
+func (m *MessageMutation) SetIsVisibleForClient(b bool) {
+	m.is_visible_for_client = &b
+}
+
+// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
+func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
+	v := m.is_visible_for_client
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+	}
+	return oldValue.IsVisibleForClient, nil
+}
+
+// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
+func (m *MessageMutation) ResetIsVisibleForClient() {
+	m.is_visible_for_client = nil
+}
+
+// SetIsVisibleForManager sets the "is_visible_for_manager" field.
+func (m *MessageMutation) SetIsVisibleForManager(b bool) {
+	m.is_visible_for_manager = &b
+}
+
+// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
+func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
+	v := m.is_visible_for_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+	}
+	return oldValue.IsVisibleForManager, nil
+}
+
+// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
+func (m *MessageMutation) ResetIsVisibleForManager() {
+	m.is_visible_for_manager = nil
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *MessageMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *MessageMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *MessageMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *MessageMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, message.FieldCheckedAt)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *MessageMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ClearIsBlocked clears the value of the "is_blocked" field.
+func (m *MessageMutation) ClearIsBlocked() {
+	m.is_blocked = nil
+	m.clearedFields[message.FieldIsBlocked] = struct{}{}
+}
+
+// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
+func (m *MessageMutation) IsBlockedCleared() bool {
+	_, ok := m.clearedFields[message.FieldIsBlocked]
+	return ok
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *MessageMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+	delete(m.clearedFields, message.FieldIsBlocked)
+}
+
+// SetIsService sets the "is_service" field.
+func (m *MessageMutation) SetIsService(b bool) {
+	m.is_service = &b
+}
+
+// IsService returns the value of the "is_service" field in the mutation.
+func (m *MessageMutation) IsService() (r bool, exists bool) {
+	v := m.is_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsService returns the old "is_service" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+	}
+	return oldValue.IsService, nil
+}
+
+// ResetIsService resets all changes to the "is_service" field.
+func (m *MessageMutation) ResetIsService() {
+	m.is_service = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetInitialRequestID sets the "initial_request_id" field.
+func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
+	m.initial_request_id = &ti
+}
+
+// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
+func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
+	v := m.initial_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
 // OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
@@ -2243,96 +4398,677 @@ func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.Requ
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+	}
+	return oldValue.InitialRequestID, nil
+}
+
+// ResetInitialRequestID resets all changes to the "initial_request_id" field.
+func (m *MessageMutation) ResetInitialRequestID() {
+	m.initial_request_id = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *MessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[message.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *MessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// SetProblemID sets the "problem" edge to the Problem entity by id.
+func (m *MessageMutation) SetProblemID(id types.ProblemID) {
+	m.problem = &id
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *MessageMutation) ClearProblem() {
+	m.clearedproblem = true
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *MessageMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemID returns the "problem" edge ID in the mutation.
+func (m *MessageMutation) ProblemID() (id types.ProblemID, exists bool) {
+	if m.problem != nil {
+		return *m.problem, true
+	}
+	return
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *MessageMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.author_id != nil {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.is_visible_for_client != nil {
+		fields = append(fields, message.FieldIsVisibleForClient)
+	}
+	if m.is_visible_for_manager != nil {
+		fields = append(fields, message.FieldIsVisibleForManager)
+	}
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, message.FieldIsBlocked)
+	}
+	if m.is_service != nil {
+		fields = append(fields, message.FieldIsService)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.chat != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.initial_request_id != nil {
+		fields = append(fields, message.FieldInitialRequestID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldAuthorID:
+		return m.AuthorID()
+	case message.FieldIsVisibleForClient:
+		return m.IsVisibleForClient()
+	case message.FieldIsVisibleForManager:
+		return m.IsVisibleForManager()
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldCheckedAt:
+		return m.CheckedAt()
+	case message.FieldIsBlocked:
+		return m.IsBlocked()
+	case message.FieldIsService:
+		return m.IsService()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldInitialRequestID:
+		return m.InitialRequestID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case message.FieldIsVisibleForClient:
+		return m.OldIsVisibleForClient(ctx)
+	case message.FieldIsVisibleForManager:
+		return m.OldIsVisibleForManager(ctx)
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case message.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case message.FieldIsService:
+		return m.OldIsService(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldInitialRequestID:
+		return m.OldInitialRequestID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldAuthorID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case message.FieldIsVisibleForClient:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForClient(v)
+		return nil
+	case message.FieldIsVisibleForManager:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForManager(v)
+		return nil
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case message.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case message.FieldIsService:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsService(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldInitialRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialRequestID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldAuthorID) {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.FieldCleared(message.FieldCheckedAt) {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.FieldCleared(message.FieldIsBlocked) {
+		fields = append(fields, message.FieldIsBlocked)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case message.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
+	case message.FieldIsBlocked:
+		m.ClearIsBlocked()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case message.FieldIsVisibleForClient:
+		m.ResetIsVisibleForClient()
+		return nil
+	case message.FieldIsVisibleForManager:
+		m.ResetIsVisibleForManager()
+		return nil
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case message.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case message.FieldIsService:
+		m.ResetIsService()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldInitialRequestID:
+		m.ResetInitialRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.chat != nil {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.problem != nil {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchat {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.clearedproblem {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChat:
+		return m.clearedchat
+	case message.EdgeProblem:
+		return m.clearedproblem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ClearChat()
+		return nil
+	case message.EdgeProblem:
+		m.ClearProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ResetChat()
+		return nil
+	case message.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// PooledManagerMutation represents an operation that mutates the PooledManager nodes in the graph.
+type PooledManagerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	manager_id    *types.UserID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PooledManager, error)
+	predicates    []predicate.PooledManager
+}
+
+var _ ent.Mutation = (*PooledManagerMutation)(nil)
+
+// pooledmanagerOption allows management of the mutation configuration using functional options.
+type pooledmanagerOption func(*PooledManagerMutation)
+
+// newPooledManagerMutation creates new mutation for the PooledManager entity.
+func newPooledManagerMutation(c config, op Op, opts ...pooledmanagerOption) *PooledManagerMutation {
+	m := &PooledManagerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePooledManager,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.InitialRequestID, nil
+	return m
 }
 
-// ResetInitialRequestID resets all changes to the "initial_request_id" field.
-func (m *MessageMutation) ResetInitialRequestID() {
-	m.initial_request_id = nil
+// withPooledManagerID sets the ID field of the mutation.
+func withPooledManagerID(id int) pooledmanagerOption {
+	return func(m *PooledManagerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PooledManager
+		)
+		m.oldValue = func(ctx context.Context) (*PooledManager, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PooledManager.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *MessageMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[message.FieldChatID] = struct{}{}
+// withPooledManager sets the old PooledManager of the mutation.
+func withPooledManager(node *PooledManager) pooledmanagerOption {
+	return func(m *PooledManagerMutation) {
+		m.oldValue = func(context.Context) (*PooledManager, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *MessageMutation) ChatCleared() bool {
-	return m.clearedchat
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PooledManagerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PooledManagerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetChat resets all changes to the "chat" edge.
-func (m *MessageMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PooledManagerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetProblemID sets the "problem" edge to the Problem entity by id.
-func (m *MessageMutation) SetProblemID(id types.ProblemID) {
-	m.problem = &id
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PooledManagerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PooledManager.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (m *MessageMutation) ClearProblem() {
-	m.clearedproblem = true
+// SetManagerID sets the "manager_id" field.
+func (m *PooledManagerMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
 }
 
-// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
-func (m *MessageMutation) ProblemCleared() bool {
-	return m.clearedproblem
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *PooledManagerMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProblemID returns the "problem" edge ID in the mutation.
-func (m *MessageMutation) ProblemID() (id types.ProblemID, exists bool) {
-	if m.problem != nil {
-		return *m.problem, true
+// OldManagerID returns the old "manager_id" field's value of the PooledManager entity.
+// If the PooledManager object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PooledManagerMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+	}
+	return oldValue.ManagerID, nil
 }
 
-// ProblemIDs returns the "problem" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
-	if id := m.problem; id != nil {
-		ids = append(ids, *id)
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *PooledManagerMutation) ResetManagerID() {
+	m.manager_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PooledManagerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PooledManagerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetProblem resets all changes to the "problem" edge.
-func (m *MessageMutation) ResetProblem() {
-	m.problem = nil
-	m.clearedproblem = false
+// OldCreatedAt returns the old "created_at" field's value of the PooledManager entity.
+// If the PooledManager object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PooledManagerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PooledManagerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PooledManagerMutation builder.
+func (m *PooledManagerMutation) Where(ps ...predicate.PooledManager) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PooledManagerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *PooledManagerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PooledManager, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2340,54 +5076,30 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *PooledManagerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *PooledManagerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (PooledManager).
+func (m *PooledManagerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.author_id != nil {
-		fields = append(fields, message.FieldAuthorID)
-	}
-	if m.is_visible_for_client != nil {
-		fields = append(fields, message.FieldIsVisibleForClient)
-	}
-	if m.is_visible_for_manager != nil {
-		fields = append(fields, message.FieldIsVisibleForManager)
-	}
-	if m.body != nil {
-		fields = append(fields, message.FieldBody)
-	}
-	if m.checked_at != nil {
-		fields = append(fields, message.FieldCheckedAt)
-	}
-	if m.is_blocked != nil {
-		fields = append(fields, message.FieldIsBlocked)
-	}
-	if m.is_service != nil {
-		fields = append(fields, message.FieldIsService)
+func (m *PooledManagerMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.manager_id != nil {
+		fields = append(fields, pooledmanager.FieldManagerID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
-	}
-	if m.chat != nil {
-		fields = append(fields, message.FieldChatID)
-	}
-	if m.initial_request_id != nil {
-		fields = append(fields, message.FieldInitialRequestID)
+		fields = append(fields, pooledmanager.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2395,28 +5107,12 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *PooledManagerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldAuthorID:
-		return m.AuthorID()
-	case message.FieldIsVisibleForClient:
-		return m.IsVisibleForClient()
-	case message.FieldIsVisibleForManager:
-		return m.IsVisibleForManager()
-	case message.FieldBody:
-		return m.Body()
-	case message.FieldCheckedAt:
-		return m.CheckedAt()
-	case message.FieldIsBlocked:
-		return m.IsBlocked()
-	case message.FieldIsService:
-		return m.IsService()
-	case message.FieldCreatedAt:
+	case pooledmanager.FieldManagerID:
+		return m.ManagerID()
+	case pooledmanager.FieldCreatedAt:
 		return m.CreatedAt()
-	case message.FieldChatID:
-		return m.ChatID()
-	case message.FieldInitialRequestID:
-		return m.InitialRequestID()
 	}
 	return nil, false
 }
@@ -2424,301 +5120,140 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PooledManagerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldAuthorID:
-		return m.OldAuthorID(ctx)
-	case message.FieldIsVisibleForClient:
-		return m.OldIsVisibleForClient(ctx)
-	case message.FieldIsVisibleForManager:
-		return m.OldIsVisibleForManager(ctx)
-	case message.FieldBody:
-		return m.OldBody(ctx)
-	case message.FieldCheckedAt:
-		return m.OldCheckedAt(ctx)
-	case message.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case message.FieldIsService:
-		return m.OldIsService(ctx)
-	case message.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case message.FieldChatID:
-		return m.OldChatID(ctx)
-	case message.FieldInitialRequestID:
-		return m.OldInitialRequestID(ctx)
+	case pooledmanager.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case pooledmanager.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown PooledManager field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *PooledManagerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldAuthorID:
+	case pooledmanager.FieldManagerID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorID(v)
-		return nil
-	case message.FieldIsVisibleForClient:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForClient(v)
-		return nil
-	case message.FieldIsVisibleForManager:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForManager(v)
-		return nil
-	case message.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
-		return nil
-	case message.FieldCheckedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckedAt(v)
-		return nil
-	case message.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
-		return nil
-	case message.FieldIsService:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsService(v)
+		m.SetManagerID(v)
 		return nil
-	case message.FieldCreatedAt:
+	case pooledmanager.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case message.FieldChatID:
-		v, ok := value.(types.ChatID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChatID(v)
-		return nil
-	case message.FieldInitialRequestID:
-		v, ok := value.(types.RequestID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInitialRequestID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown PooledManager field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *PooledManagerMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PooledManagerMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *PooledManagerMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown PooledManager numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(message.FieldAuthorID) {
-		fields = append(fields, message.FieldAuthorID)
-	}
-	if m.FieldCleared(message.FieldCheckedAt) {
-		fields = append(fields, message.FieldCheckedAt)
-	}
-	if m.FieldCleared(message.FieldIsBlocked) {
-		fields = append(fields, message.FieldIsBlocked)
-	}
-	return fields
+func (m *PooledManagerMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *PooledManagerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
-	switch name {
-	case message.FieldAuthorID:
-		m.ClearAuthorID()
-		return nil
-	case message.FieldCheckedAt:
-		m.ClearCheckedAt()
-		return nil
-	case message.FieldIsBlocked:
-		m.ClearIsBlocked()
-		return nil
-	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+func (m *PooledManagerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PooledManager nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *PooledManagerMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldAuthorID:
-		m.ResetAuthorID()
-		return nil
-	case message.FieldIsVisibleForClient:
-		m.ResetIsVisibleForClient()
-		return nil
-	case message.FieldIsVisibleForManager:
-		m.ResetIsVisibleForManager()
-		return nil
-	case message.FieldBody:
-		m.ResetBody()
-		return nil
-	case message.FieldCheckedAt:
-		m.ResetCheckedAt()
-		return nil
-	case message.FieldIsBlocked:
-		m.ResetIsBlocked()
-		return nil
-	case message.FieldIsService:
-		m.ResetIsService()
+	case pooledmanager.FieldManagerID:
+		m.ResetManagerID()
 		return nil
-	case message.FieldCreatedAt:
+	case pooledmanager.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case message.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case message.FieldInitialRequestID:
-		m.ResetInitialRequestID()
-		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown PooledManager field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.chat != nil {
-		edges = append(edges, message.EdgeChat)
-	}
-	if m.problem != nil {
-		edges = append(edges, message.EdgeProblem)
-	}
+func (m *PooledManagerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeChat:
-		if id := m.chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeProblem:
-		if id := m.problem; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PooledManagerMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *PooledManagerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *PooledManagerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedchat {
-		edges = append(edges, message.EdgeChat)
-	}
-	if m.clearedproblem {
-		edges = append(edges, message.EdgeProblem)
-	}
+func (m *PooledManagerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case message.EdgeChat:
-		return m.clearedchat
-	case message.EdgeProblem:
-		return m.clearedproblem
-	}
+func (m *PooledManagerMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	switch name {
-	case message.EdgeChat:
-		m.ClearChat()
-		return nil
-	case message.EdgeProblem:
-		m.ClearProblem()
-		return nil
-	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *PooledManagerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PooledManager unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	switch name {
-	case message.EdgeChat:
-		m.ResetChat()
-		return nil
-	case message.EdgeProblem:
-		m.ResetProblem()
-		return nil
-	}
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *PooledManagerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PooledManager edge %s", name)
 }
 
 // ProblemMutation represents an operation that mutates the Problem nodes in the graph.
@@ -2730,6 +5265,8 @@ type ProblemMutation struct {
 	manager_id      *types.UserID
 	created_at      *time.Time
 	resolved_at     *time.Time
+	assigned_at     *time.Time
+	topic           *types.Topic
 	clearedFields   map[string]struct{}
 	chat            *types.ChatID
 	clearedchat     bool
@@ -3015,6 +5552,104 @@ func (m *ProblemMutation) ResetResolvedAt() {
 	delete(m.clearedFields, problem.FieldResolvedAt)
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (m *ProblemMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *ProblemMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldAssignedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (m *ProblemMutation) ClearAssignedAt() {
+	m.assigned_at = nil
+	m.clearedFields[problem.FieldAssignedAt] = struct{}{}
+}
+
+// AssignedAtCleared returns if the "assigned_at" field was cleared in this mutation.
+func (m *ProblemMutation) AssignedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldAssignedAt]
+	return ok
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *ProblemMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+	delete(m.clearedFields, problem.FieldAssignedAt)
+}
+
+// SetTopic sets the "topic" field.
+func (m *ProblemMutation) SetTopic(t types.Topic) {
+	m.topic = &t
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *ProblemMutation) Topic() (r types.Topic, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldTopic(ctx context.Context) (v *types.Topic, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (m *ProblemMutation) ClearTopic() {
+	m.topic = nil
+	m.clearedFields[problem.FieldTopic] = struct{}{}
+}
+
+// TopicCleared returns if the "topic" field was cleared in this mutation.
+func (m *ProblemMutation) TopicCleared() bool {
+	_, ok := m.clearedFields[problem.FieldTopic]
+	return ok
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *ProblemMutation) ResetTopic() {
+	m.topic = nil
+	delete(m.clearedFields, problem.FieldTopic)
+}
+
 // ClearChat clears the "chat" edge to the Chat entity.
 func (m *ProblemMutation) ClearChat() {
 	m.clearedchat = true
@@ -3130,7 +5765,7 @@ func (m *ProblemMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ProblemMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 6)
 	if m.manager_id != nil {
 		fields = append(fields, problem.FieldManagerID)
 	}
@@ -3143,6 +5778,12 @@ func (m *ProblemMutation) Fields() []string {
 	if m.resolved_at != nil {
 		fields = append(fields, problem.FieldResolvedAt)
 	}
+	if m.assigned_at != nil {
+		fields = append(fields, problem.FieldAssignedAt)
+	}
+	if m.topic != nil {
+		fields = append(fields, problem.FieldTopic)
+	}
 	return fields
 }
 
@@ -3159,6 +5800,10 @@ func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case problem.FieldResolvedAt:
 		return m.ResolvedAt()
+	case problem.FieldAssignedAt:
+		return m.AssignedAt()
+	case problem.FieldTopic:
+		return m.Topic()
 	}
 	return nil, false
 }
@@ -3176,6 +5821,10 @@ func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCreatedAt(ctx)
 	case problem.FieldResolvedAt:
 		return m.OldResolvedAt(ctx)
+	case problem.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	case problem.FieldTopic:
+		return m.OldTopic(ctx)
 	}
 	return nil, fmt.Errorf("unknown Problem field %s", name)
 }
@@ -3213,6 +5862,20 @@ func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetResolvedAt(v)
 		return nil
+	case problem.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	case problem.FieldTopic:
+		v, ok := value.(types.Topic)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopic(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Problem field %s", name)
 }
@@ -3249,6 +5912,12 @@ func (m *ProblemMutation) ClearedFields() []string {
 	if m.FieldCleared(problem.FieldResolvedAt) {
 		fields = append(fields, problem.FieldResolvedAt)
 	}
+	if m.FieldCleared(problem.FieldAssignedAt) {
+		fields = append(fields, problem.FieldAssignedAt)
+	}
+	if m.FieldCleared(problem.FieldTopic) {
+		fields = append(fields, problem.FieldTopic)
+	}
 	return fields
 }
 
@@ -3269,6 +5938,12 @@ func (m *ProblemMutation) ClearField(name string) error {
 	case problem.FieldResolvedAt:
 		m.ClearResolvedAt()
 		return nil
+	case problem.FieldAssignedAt:
+		m.ClearAssignedAt()
+		return nil
+	case problem.FieldTopic:
+		m.ClearTopic()
+		return nil
 	}
 	return fmt.Errorf("unknown Problem nullable field %s", name)
 }
@@ -3289,6 +5964,12 @@ func (m *ProblemMutation) ResetField(name string) error {
 	case problem.FieldResolvedAt:
 		m.ResetResolvedAt()
 		return nil
+	case problem.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	case problem.FieldTopic:
+		m.ResetTopic()
+		return nil
 	}
 	return fmt.Errorf("unknown Problem field %s", name)
 }
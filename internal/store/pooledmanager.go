@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// PooledManager is the model entity for the PooledManager schema.
+type PooledManager struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PooledManager) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pooledmanager.FieldID:
+			values[i] = new(sql.NullInt64)
+		case pooledmanager.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pooledmanager.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PooledManager fields.
+func (pm *PooledManager) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pooledmanager.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case pooledmanager.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				pm.ManagerID = *value
+			}
+		case pooledmanager.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PooledManager.
+// This includes values selected through modifiers, order, etc.
+func (pm *PooledManager) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PooledManager.
+// Note that you need to call PooledManager.Unwrap() before calling this method if this PooledManager
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PooledManager) Update() *PooledManagerUpdateOne {
+	return NewPooledManagerClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PooledManager entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PooledManager) Unwrap() *PooledManager {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("store: PooledManager is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PooledManager) String() string {
+	var builder strings.Builder
+	builder.WriteString("PooledManager(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PooledManagers is a parsable slice of PooledManager.
+type PooledManagers []*PooledManager
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// PooledManagerCreate is the builder for creating a PooledManager entity.
+type PooledManagerCreate struct {
+	config
+	mutation *PooledManagerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetManagerID sets the "manager_id" field.
+func (pmc *PooledManagerCreate) SetManagerID(ti types.UserID) *PooledManagerCreate {
+	pmc.mutation.SetManagerID(ti)
+	return pmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmc *PooledManagerCreate) SetCreatedAt(t time.Time) *PooledManagerCreate {
+	pmc.mutation.SetCreatedAt(t)
+	return pmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmc *PooledManagerCreate) SetNillableCreatedAt(t *time.Time) *PooledManagerCreate {
+	if t != nil {
+		pmc.SetCreatedAt(*t)
+	}
+	return pmc
+}
+
+// Mutation returns the PooledManagerMutation object of the builder.
+func (pmc *PooledManagerCreate) Mutation() *PooledManagerMutation {
+	return pmc.mutation
+}
+
+// Save creates the PooledManager in the database.
+func (pmc *PooledManagerCreate) Save(ctx context.Context) (*PooledManager, error) {
+	pmc.defaults()
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PooledManagerCreate) SaveX(ctx context.Context) *PooledManager {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PooledManagerCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PooledManagerCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *PooledManagerCreate) defaults() {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		v := pooledmanager.DefaultCreatedAt()
+		pmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PooledManagerCreate) check() error {
+	if _, ok := pmc.mutation.ManagerID(); !ok {
+		return &ValidationError{Name: "manager_id", err: errors.New(`store: missing required field "PooledManager.manager_id"`)}
+	}
+	if v, ok := pmc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "PooledManager.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "PooledManager.created_at"`)}
+	}
+	return nil
+}
+
+func (pmc *PooledManagerCreate) sqlSave(ctx context.Context) (*PooledManager, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PooledManagerCreate) createSpec() (*PooledManager, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PooledManager{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(pooledmanager.Table, sqlgraph.NewFieldSpec(pooledmanager.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pmc.conflict
+	if value, ok := pmc.mutation.ManagerID(); ok {
+		_spec.SetField(pooledmanager.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := pmc.mutation.CreatedAt(); ok {
+		_spec.SetField(pooledmanager.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PooledManager.Create().
+//		SetManagerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PooledManagerUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *PooledManagerCreate) OnConflict(opts ...sql.ConflictOption) *PooledManagerUpsertOne {
+	pmc.conflict = opts
+	return &PooledManagerUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *PooledManagerCreate) OnConflictColumns(columns ...string) *PooledManagerUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &PooledManagerUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// PooledManagerUpsertOne is the builder for "upsert"-ing
+	//  one PooledManager node.
+	PooledManagerUpsertOne struct {
+		create *PooledManagerCreate
+	}
+
+	// PooledManagerUpsert is the "OnConflict" setter.
+	PooledManagerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PooledManagerUpsertOne) UpdateNewValues() *PooledManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(pooledmanager.FieldManagerID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pooledmanager.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PooledManagerUpsertOne) Ignore() *PooledManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PooledManagerUpsertOne) DoNothing() *PooledManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PooledManagerCreate.OnConflict
+// documentation for more info.
+func (u *PooledManagerUpsertOne) Update(set func(*PooledManagerUpsert)) *PooledManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PooledManagerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PooledManagerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for PooledManagerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PooledManagerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PooledManagerUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PooledManagerUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PooledManagerCreateBulk is the builder for creating many PooledManager entities in bulk.
+type PooledManagerCreateBulk struct {
+	config
+	err      error
+	builders []*PooledManagerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PooledManager entities in the database.
+func (pmcb *PooledManagerCreateBulk) Save(ctx context.Context) ([]*PooledManager, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PooledManager, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PooledManagerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PooledManagerCreateBulk) SaveX(ctx context.Context) []*PooledManager {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PooledManagerCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PooledManagerCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PooledManager.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PooledManagerUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *PooledManagerCreateBulk) OnConflict(opts ...sql.ConflictOption) *PooledManagerUpsertBulk {
+	pmcb.conflict = opts
+	return &PooledManagerUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *PooledManagerCreateBulk) OnConflictColumns(columns ...string) *PooledManagerUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &PooledManagerUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// PooledManagerUpsertBulk is the builder for "upsert"-ing
+// a bulk of PooledManager nodes.
+type PooledManagerUpsertBulk struct {
+	create *PooledManagerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PooledManagerUpsertBulk) UpdateNewValues() *PooledManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(pooledmanager.FieldManagerID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pooledmanager.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PooledManager.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PooledManagerUpsertBulk) Ignore() *PooledManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PooledManagerUpsertBulk) DoNothing() *PooledManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PooledManagerCreateBulk.OnConflict
+// documentation for more info.
+func (u *PooledManagerUpsertBulk) Update(set func(*PooledManagerUpsert)) *PooledManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PooledManagerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PooledManagerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the PooledManagerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for PooledManagerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PooledManagerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
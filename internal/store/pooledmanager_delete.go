@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// PooledManagerDelete is the builder for deleting a PooledManager entity.
+type PooledManagerDelete struct {
+	config
+	hooks    []Hook
+	mutation *PooledManagerMutation
+}
+
+// Where appends a list predicates to the PooledManagerDelete builder.
+func (pmd *PooledManagerDelete) Where(ps ...predicate.PooledManager) *PooledManagerDelete {
+	pmd.mutation.Where(ps...)
+	return pmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmd *PooledManagerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmd.sqlExec, pmd.mutation, pmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmd *PooledManagerDelete) ExecX(ctx context.Context) int {
+	n, err := pmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmd *PooledManagerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pooledmanager.Table, sqlgraph.NewFieldSpec(pooledmanager.FieldID, field.TypeInt))
+	if ps := pmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmd.mutation.done = true
+	return affected, err
+}
+
+// PooledManagerDeleteOne is the builder for deleting a single PooledManager entity.
+type PooledManagerDeleteOne struct {
+	pmd *PooledManagerDelete
+}
+
+// Where appends a list predicates to the PooledManagerDelete builder.
+func (pmdo *PooledManagerDeleteOne) Where(ps ...predicate.PooledManager) *PooledManagerDeleteOne {
+	pmdo.pmd.mutation.Where(ps...)
+	return pmdo
+}
+
+// Exec executes the deletion query.
+func (pmdo *PooledManagerDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmdo.pmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pooledmanager.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmdo *PooledManagerDeleteOne) ExecX(ctx context.Context) {
+	if err := pmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
+	"github.com/keepcalmist/chat-service/internal/store/predicate"
+)
+
+// PooledManagerUpdate is the builder for updating PooledManager entities.
+type PooledManagerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PooledManagerMutation
+}
+
+// Where appends a list predicates to the PooledManagerUpdate builder.
+func (pmu *PooledManagerUpdate) Where(ps ...predicate.PooledManager) *PooledManagerUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// Mutation returns the PooledManagerMutation object of the builder.
+func (pmu *PooledManagerUpdate) Mutation() *PooledManagerMutation {
+	return pmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PooledManagerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PooledManagerUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PooledManagerUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PooledManagerUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pmu *PooledManagerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pooledmanager.Table, pooledmanager.Columns, sqlgraph.NewFieldSpec(pooledmanager.FieldID, field.TypeInt))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pooledmanager.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PooledManagerUpdateOne is the builder for updating a single PooledManager entity.
+type PooledManagerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PooledManagerMutation
+}
+
+// Mutation returns the PooledManagerMutation object of the builder.
+func (pmuo *PooledManagerUpdateOne) Mutation() *PooledManagerMutation {
+	return pmuo.mutation
+}
+
+// Where appends a list predicates to the PooledManagerUpdate builder.
+func (pmuo *PooledManagerUpdateOne) Where(ps ...predicate.PooledManager) *PooledManagerUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PooledManagerUpdateOne) Select(field string, fields ...string) *PooledManagerUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PooledManager entity.
+func (pmuo *PooledManagerUpdateOne) Save(ctx context.Context) (*PooledManager, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PooledManagerUpdateOne) SaveX(ctx context.Context) *PooledManager {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PooledManagerUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PooledManagerUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pmuo *PooledManagerUpdateOne) sqlSave(ctx context.Context) (_node *PooledManager, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pooledmanager.Table, pooledmanager.Columns, sqlgraph.NewFieldSpec(pooledmanager.FieldID, field.TypeInt))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "PooledManager.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pooledmanager.FieldID)
+		for _, f := range fields {
+			if !pooledmanager.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != pooledmanager.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &PooledManager{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pooledmanager.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
@@ -15,8 +15,20 @@ type FailedJob func(*sql.Selector)
 // Job is the predicate function for job builders.
 type Job func(*sql.Selector)
 
+// JobAttempt is the predicate function for jobattempt builders.
+type JobAttempt func(*sql.Selector)
+
+// ManagerCapacity is the predicate function for managercapacity builders.
+type ManagerCapacity func(*sql.Selector)
+
+// ManagerSkill is the predicate function for managerskill builders.
+type ManagerSkill func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// PooledManager is the predicate function for pooledmanager builders.
+type PooledManager func(*sql.Selector)
+
 // Problem is the predicate function for problem builders.
 type Problem func(*sql.Selector)
@@ -27,6 +27,10 @@ type Problem struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// ResolvedAt holds the value of the "resolved_at" field.
 	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	// Topic holds the value of the "topic" field.
+	Topic *types.Topic `json:"topic,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProblemQuery when eager-loading is set.
 	Edges        ProblemEdges `json:"edges"`
@@ -73,7 +77,9 @@ func (*Problem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case problem.FieldManagerID:
 			values[i] = &sql.NullScanner{S: new(types.UserID)}
-		case problem.FieldCreatedAt, problem.FieldResolvedAt:
+		case problem.FieldTopic:
+			values[i] = new(sql.NullString)
+		case problem.FieldCreatedAt, problem.FieldResolvedAt, problem.FieldAssignedAt:
 			values[i] = new(sql.NullTime)
 		case problem.FieldChatID:
 			values[i] = new(types.ChatID)
@@ -126,6 +132,20 @@ func (pr *Problem) assignValues(columns []string, values []any) error {
 				pr.ResolvedAt = new(time.Time)
 				*pr.ResolvedAt = value.Time
 			}
+		case problem.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				pr.AssignedAt = new(time.Time)
+				*pr.AssignedAt = value.Time
+			}
+		case problem.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				pr.Topic = new(types.Topic)
+				*pr.Topic = types.Topic(value.String)
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -187,6 +207,16 @@ func (pr *Problem) String() string {
 		builder.WriteString("resolved_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := pr.AssignedAt; v != nil {
+		builder.WriteString("assigned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.Topic; v != nil {
+		builder.WriteString("topic=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package problem
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,10 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
 	FieldResolvedAt = "resolved_at"
+	// FieldAssignedAt holds the string denoting the assigned_at field in the database.
+	FieldAssignedAt = "assigned_at"
+	// FieldTopic holds the string denoting the topic field in the database.
+	FieldTopic = "topic"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
 	EdgeChat = "chat"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
@@ -52,6 +57,8 @@ var Columns = []string{
 	FieldChatID,
 	FieldCreatedAt,
 	FieldResolvedAt,
+	FieldAssignedAt,
+	FieldTopic,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,6 +78,16 @@ var (
 	DefaultID func() types.ProblemID
 )
 
+// TopicValidator is a validator for the "topic" field enum values. It is called by the builders before save.
+func TopicValidator(t types.Topic) error {
+	switch t {
+	case "cards", "loans", "deposits":
+		return nil
+	default:
+		return fmt.Errorf("problem: invalid enum value for topic field: %q", t)
+	}
+}
+
 // OrderOption defines the ordering options for the Problem queries.
 type OrderOption func(*sql.Selector)
 
@@ -99,6 +116,16 @@ func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
 }
 
+// ByAssignedAt orders the results by the assigned_at field.
+func ByAssignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedAt, opts...).ToFunc()
+}
+
+// ByTopic orders the results by the topic field.
+func ByTopic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopic, opts...).ToFunc()
+}
+
 // ByChatField orders the results by chat field.
 func ByChatField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -76,6 +76,11 @@ func ResolvedAt(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldResolvedAt, v))
 }
 
+// AssignedAt applies equality check predicate on the "assigned_at" field. It's identical to AssignedAtEQ.
+func AssignedAt(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldAssignedAt, v))
+}
+
 // ManagerIDEQ applies the EQ predicate on the "manager_id" field.
 func ManagerIDEQ(v types.UserID) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldManagerID, v))
@@ -236,6 +241,96 @@ func ResolvedAtNotNil() predicate.Problem {
 	return predicate.Problem(sql.FieldNotNull(FieldResolvedAt))
 }
 
+// AssignedAtEQ applies the EQ predicate on the "assigned_at" field.
+func AssignedAtEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtNEQ applies the NEQ predicate on the "assigned_at" field.
+func AssignedAtNEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtIn applies the In predicate on the "assigned_at" field.
+func AssignedAtIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtNotIn applies the NotIn predicate on the "assigned_at" field.
+func AssignedAtNotIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtGT applies the GT predicate on the "assigned_at" field.
+func AssignedAtGT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldAssignedAt, v))
+}
+
+// AssignedAtGTE applies the GTE predicate on the "assigned_at" field.
+func AssignedAtGTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldAssignedAt, v))
+}
+
+// AssignedAtLT applies the LT predicate on the "assigned_at" field.
+func AssignedAtLT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldAssignedAt, v))
+}
+
+// AssignedAtLTE applies the LTE predicate on the "assigned_at" field.
+func AssignedAtLTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldAssignedAt, v))
+}
+
+// AssignedAtIsNil applies the IsNil predicate on the "assigned_at" field.
+func AssignedAtIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldAssignedAt))
+}
+
+// AssignedAtNotNil applies the NotNil predicate on the "assigned_at" field.
+func AssignedAtNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldAssignedAt))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v types.Topic) predicate.Problem {
+	vc := v
+	return predicate.Problem(sql.FieldEQ(FieldTopic, vc))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v types.Topic) predicate.Problem {
+	vc := v
+	return predicate.Problem(sql.FieldNEQ(FieldTopic, vc))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...types.Topic) predicate.Problem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Problem(sql.FieldIn(FieldTopic, v...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...types.Topic) predicate.Problem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Problem(sql.FieldNotIn(FieldTopic, v...))
+}
+
+// TopicIsNil applies the IsNil predicate on the "topic" field.
+func TopicIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldTopic))
+}
+
+// TopicNotNil applies the NotNil predicate on the "topic" field.
+func TopicNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldTopic))
+}
+
 // HasChat applies the HasEdge predicate on the "chat" edge.
 func HasChat() predicate.Problem {
 	return predicate.Problem(func(s *sql.Selector) {
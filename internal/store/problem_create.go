@@ -74,6 +74,34 @@ func (pc *ProblemCreate) SetNillableResolvedAt(t *time.Time) *ProblemCreate {
 	return pc
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (pc *ProblemCreate) SetAssignedAt(t time.Time) *ProblemCreate {
+	pc.mutation.SetAssignedAt(t)
+	return pc
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableAssignedAt(t *time.Time) *ProblemCreate {
+	if t != nil {
+		pc.SetAssignedAt(*t)
+	}
+	return pc
+}
+
+// SetTopic sets the "topic" field.
+func (pc *ProblemCreate) SetTopic(t types.Topic) *ProblemCreate {
+	pc.mutation.SetTopic(t)
+	return pc
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableTopic(t *types.Topic) *ProblemCreate {
+	if t != nil {
+		pc.SetTopic(*t)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *ProblemCreate) SetID(ti types.ProblemID) *ProblemCreate {
 	pc.mutation.SetID(ti)
@@ -171,6 +199,11 @@ func (pc *ProblemCreate) check() error {
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Problem.created_at"`)}
 	}
+	if v, ok := pc.mutation.Topic(); ok {
+		if err := problem.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`store: validator failed for field "Problem.topic": %w`, err)}
+		}
+	}
 	if v, ok := pc.mutation.ID(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "Problem.id": %w`, err)}
@@ -227,6 +260,14 @@ func (pc *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		_spec.SetField(problem.FieldResolvedAt, field.TypeTime, value)
 		_node.ResolvedAt = &value
 	}
+	if value, ok := pc.mutation.AssignedAt(); ok {
+		_spec.SetField(problem.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = &value
+	}
+	if value, ok := pc.mutation.Topic(); ok {
+		_spec.SetField(problem.FieldTopic, field.TypeEnum, value)
+		_node.Topic = &value
+	}
 	if nodes := pc.mutation.ChatIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -348,6 +389,24 @@ func (u *ProblemUpsert) ClearResolvedAt() *ProblemUpsert {
 	return u
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (u *ProblemUpsert) SetAssignedAt(v time.Time) *ProblemUpsert {
+	u.Set(problem.FieldAssignedAt, v)
+	return u
+}
+
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateAssignedAt() *ProblemUpsert {
+	u.SetExcluded(problem.FieldAssignedAt)
+	return u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *ProblemUpsert) ClearAssignedAt() *ProblemUpsert {
+	u.SetNull(problem.FieldAssignedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -371,6 +430,9 @@ func (u *ProblemUpsertOne) UpdateNewValues() *ProblemUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(problem.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.Topic(); exists {
+			s.SetIgnore(problem.FieldTopic)
+		}
 	}))
 	return u
 }
@@ -444,6 +506,27 @@ func (u *ProblemUpsertOne) ClearResolvedAt() *ProblemUpsertOne {
 	})
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (u *ProblemUpsertOne) SetAssignedAt(v time.Time) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetAssignedAt(v)
+	})
+}
+
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateAssignedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateAssignedAt()
+	})
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *ProblemUpsertOne) ClearAssignedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearAssignedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -633,6 +716,9 @@ func (u *ProblemUpsertBulk) UpdateNewValues() *ProblemUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(problem.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.Topic(); exists {
+				s.SetIgnore(problem.FieldTopic)
+			}
 		}
 	}))
 	return u
@@ -707,6 +793,27 @@ func (u *ProblemUpsertBulk) ClearResolvedAt() *ProblemUpsertBulk {
 	})
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (u *ProblemUpsertBulk) SetAssignedAt(v time.Time) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetAssignedAt(v)
+	})
+}
+
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateAssignedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateAssignedAt()
+	})
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *ProblemUpsertBulk) ClearAssignedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearAssignedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -70,6 +70,26 @@ func (pu *ProblemUpdate) ClearResolvedAt() *ProblemUpdate {
 	return pu
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (pu *ProblemUpdate) SetAssignedAt(t time.Time) *ProblemUpdate {
+	pu.mutation.SetAssignedAt(t)
+	return pu
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableAssignedAt(t *time.Time) *ProblemUpdate {
+	if t != nil {
+		pu.SetAssignedAt(*t)
+	}
+	return pu
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (pu *ProblemUpdate) ClearAssignedAt() *ProblemUpdate {
+	pu.mutation.ClearAssignedAt()
+	return pu
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (pu *ProblemUpdate) AddMessageIDs(ids ...types.MessageID) *ProblemUpdate {
 	pu.mutation.AddMessageIDs(ids...)
@@ -175,6 +195,15 @@ func (pu *ProblemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.ResolvedAtCleared() {
 		_spec.ClearField(problem.FieldResolvedAt, field.TypeTime)
 	}
+	if value, ok := pu.mutation.AssignedAt(); ok {
+		_spec.SetField(problem.FieldAssignedAt, field.TypeTime, value)
+	}
+	if pu.mutation.AssignedAtCleared() {
+		_spec.ClearField(problem.FieldAssignedAt, field.TypeTime)
+	}
+	if pu.mutation.TopicCleared() {
+		_spec.ClearField(problem.FieldTopic, field.TypeEnum)
+	}
 	if pu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -280,6 +309,26 @@ func (puo *ProblemUpdateOne) ClearResolvedAt() *ProblemUpdateOne {
 	return puo
 }
 
+// SetAssignedAt sets the "assigned_at" field.
+func (puo *ProblemUpdateOne) SetAssignedAt(t time.Time) *ProblemUpdateOne {
+	puo.mutation.SetAssignedAt(t)
+	return puo
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableAssignedAt(t *time.Time) *ProblemUpdateOne {
+	if t != nil {
+		puo.SetAssignedAt(*t)
+	}
+	return puo
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (puo *ProblemUpdateOne) ClearAssignedAt() *ProblemUpdateOne {
+	puo.mutation.ClearAssignedAt()
+	return puo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (puo *ProblemUpdateOne) AddMessageIDs(ids ...types.MessageID) *ProblemUpdateOne {
 	puo.mutation.AddMessageIDs(ids...)
@@ -415,6 +464,15 @@ func (puo *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err e
 	if puo.mutation.ResolvedAtCleared() {
 		_spec.ClearField(problem.FieldResolvedAt, field.TypeTime)
 	}
+	if value, ok := puo.mutation.AssignedAt(); ok {
+		_spec.SetField(problem.FieldAssignedAt, field.TypeTime, value)
+	}
+	if puo.mutation.AssignedAtCleared() {
+		_spec.ClearField(problem.FieldAssignedAt, field.TypeTime)
+	}
+	if puo.mutation.TopicCleared() {
+		_spec.ClearField(problem.FieldTopic, field.TypeEnum)
+	}
 	if puo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -8,7 +8,11 @@ import (
 	"github.com/keepcalmist/chat-service/internal/store/chat"
 	"github.com/keepcalmist/chat-service/internal/store/failedjob"
 	"github.com/keepcalmist/chat-service/internal/store/job"
+	"github.com/keepcalmist/chat-service/internal/store/jobattempt"
+	"github.com/keepcalmist/chat-service/internal/store/managercapacity"
+	"github.com/keepcalmist/chat-service/internal/store/managerskill"
 	"github.com/keepcalmist/chat-service/internal/store/message"
+	"github.com/keepcalmist/chat-service/internal/store/pooledmanager"
 	"github.com/keepcalmist/chat-service/internal/store/problem"
 	"github.com/keepcalmist/chat-service/internal/store/schema"
 	"github.com/keepcalmist/chat-service/internal/types"
@@ -42,8 +46,14 @@ func init() {
 	failedjobDescReason := failedjobFields[3].Descriptor()
 	// failedjob.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	failedjob.ReasonValidator = failedjobDescReason.Validators[0].(func(string) error)
+	// failedjobDescRequeues is the schema descriptor for requeues field.
+	failedjobDescRequeues := failedjobFields[4].Descriptor()
+	// failedjob.DefaultRequeues holds the default value on creation for the requeues field.
+	failedjob.DefaultRequeues = failedjobDescRequeues.Default.(int)
+	// failedjob.RequeuesValidator is a validator for the "requeues" field. It is called by the builders before save.
+	failedjob.RequeuesValidator = failedjobDescRequeues.Validators[0].(func(int) error)
 	// failedjobDescCreatedAt is the schema descriptor for created_at field.
-	failedjobDescCreatedAt := failedjobFields[4].Descriptor()
+	failedjobDescCreatedAt := failedjobFields[6].Descriptor()
 	// failedjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	failedjob.DefaultCreatedAt = failedjobDescCreatedAt.Default.(func() time.Time)
 	// failedjobDescID is the schema descriptor for id field.
@@ -74,14 +84,56 @@ func init() {
 	jobDescReservedUntil := jobFields[5].Descriptor()
 	// job.DefaultReservedUntil holds the default value on creation for the reserved_until field.
 	job.DefaultReservedUntil = jobDescReservedUntil.Default.(func() time.Time)
+	// jobDescRequeues is the schema descriptor for requeues field.
+	jobDescRequeues := jobFields[7].Descriptor()
+	// job.DefaultRequeues holds the default value on creation for the requeues field.
+	job.DefaultRequeues = jobDescRequeues.Default.(int)
+	// job.RequeuesValidator is a validator for the "requeues" field. It is called by the builders before save.
+	job.RequeuesValidator = jobDescRequeues.Validators[0].(func(int) error)
 	// jobDescCreatedAt is the schema descriptor for created_at field.
-	jobDescCreatedAt := jobFields[6].Descriptor()
+	jobDescCreatedAt := jobFields[10].Descriptor()
 	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
 	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
 	// jobDescID is the schema descriptor for id field.
 	jobDescID := jobFields[0].Descriptor()
 	// job.DefaultID holds the default value on creation for the id field.
 	job.DefaultID = jobDescID.Default.(func() types.JobID)
+	jobattemptFields := schema.JobAttempt{}.Fields()
+	_ = jobattemptFields
+	// jobattemptDescJobName is the schema descriptor for job_name field.
+	jobattemptDescJobName := jobattemptFields[2].Descriptor()
+	// jobattempt.JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	jobattempt.JobNameValidator = jobattemptDescJobName.Validators[0].(func(string) error)
+	// jobattemptDescAttempt is the schema descriptor for attempt field.
+	jobattemptDescAttempt := jobattemptFields[3].Descriptor()
+	// jobattempt.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	jobattempt.AttemptValidator = jobattemptDescAttempt.Validators[0].(func(int) error)
+	// jobattemptDescWorker is the schema descriptor for worker field.
+	jobattemptDescWorker := jobattemptFields[4].Descriptor()
+	// jobattempt.WorkerValidator is a validator for the "worker" field. It is called by the builders before save.
+	jobattempt.WorkerValidator = jobattemptDescWorker.Validators[0].(func(string) error)
+	// jobattemptDescID is the schema descriptor for id field.
+	jobattemptDescID := jobattemptFields[0].Descriptor()
+	// jobattempt.DefaultID holds the default value on creation for the id field.
+	jobattempt.DefaultID = jobattemptDescID.Default.(func() types.JobAttemptID)
+	managercapacityFields := schema.ManagerCapacity{}.Fields()
+	_ = managercapacityFields
+	// managercapacityDescCapacity is the schema descriptor for capacity field.
+	managercapacityDescCapacity := managercapacityFields[1].Descriptor()
+	// managercapacity.CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	managercapacity.CapacityValidator = managercapacityDescCapacity.Validators[0].(func(int) error)
+	// managercapacityDescUpdatedAt is the schema descriptor for updated_at field.
+	managercapacityDescUpdatedAt := managercapacityFields[2].Descriptor()
+	// managercapacity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	managercapacity.DefaultUpdatedAt = managercapacityDescUpdatedAt.Default.(func() time.Time)
+	// managercapacity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	managercapacity.UpdateDefaultUpdatedAt = managercapacityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	managerskillFields := schema.ManagerSkill{}.Fields()
+	_ = managerskillFields
+	// managerskillDescCreatedAt is the schema descriptor for created_at field.
+	managerskillDescCreatedAt := managerskillFields[2].Descriptor()
+	// managerskill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	managerskill.DefaultCreatedAt = managerskillDescCreatedAt.Default.(func() time.Time)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescIsVisibleForClient is the schema descriptor for is_visible_for_client field.
@@ -126,6 +178,12 @@ func init() {
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() types.MessageID)
+	pooledmanagerFields := schema.PooledManager{}.Fields()
+	_ = pooledmanagerFields
+	// pooledmanagerDescCreatedAt is the schema descriptor for created_at field.
+	pooledmanagerDescCreatedAt := pooledmanagerFields[1].Descriptor()
+	// pooledmanager.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pooledmanager.DefaultCreatedAt = pooledmanagerDescCreatedAt.Default.(func() time.Time)
 	problemFields := schema.Problem{}.Fields()
 	_ = problemFields
 	// problemDescCreatedAt is the schema descriptor for created_at field.
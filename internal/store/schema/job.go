@@ -23,8 +23,14 @@ func (Job) Fields() []ent.Field {
 		field.Text("name").NotEmpty().Immutable(),
 		field.Text("payload").NotEmpty().Immutable(),
 		field.Int("attempts").Default(0).Max(jobMaxAttempts),
-		field.Time("available_at").Default(time.Now).Immutable(),
+		field.Time("available_at").Default(time.Now),
 		field.Time("reserved_until").Default(time.Now),
+		field.Text("last_error").Optional(),
+		field.Int("requeues").Default(0).NonNegative().Immutable(),
+		// ordering_key makes the jobs with the same key run strictly in order of creation.
+		field.Text("ordering_key").Optional().Immutable(),
+		// dedup_key is unique among the pending jobs, the completed jobs are deleted.
+		field.Text("dedup_key").Optional().Nillable().Immutable(),
 		field.Time("created_at").Immutable().Default(time.Now),
 	}
 }
@@ -33,6 +39,8 @@ func (Job) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("created_at"),
 		index.Fields("reserved_until", "available_at"),
+		index.Fields("ordering_key", "created_at"),
+		index.Fields("dedup_key").Unique(),
 	}
 }
 
@@ -46,6 +54,43 @@ func (FailedJob) Fields() []ent.Field {
 		field.Text("name").NotEmpty().Immutable(),
 		field.Text("payload").NotEmpty().Immutable(),
 		field.Text("reason").NotEmpty().Immutable(),
+		field.Int("requeues").Default(0).NonNegative().Immutable(),
+		// job_id links the failed job with its attempts. Empty for the jobs failed before the attempts history.
+		field.UUID("job_id", types.JobID{}).Optional().Immutable(),
 		field.Time("created_at").Immutable().Default(time.Now),
 	}
 }
+
+func (FailedJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+// JobAttempt is the history of the job handling. There is no edge to the job,
+// because the attempts outlive it, e.g. to investigate why the job was moved to the DLQ.
+type JobAttempt struct {
+	ent.Schema
+}
+
+func (JobAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.JobAttemptID{}).Default(types.NewJobAttemptID).Unique().Immutable(),
+		field.UUID("job_id", types.JobID{}).Immutable(),
+		field.Text("job_name").NotEmpty().Immutable(),
+		field.Int("attempt").Positive().Immutable(),
+		field.Text("worker").NotEmpty().Immutable(),
+		field.Time("started_at").Immutable(),
+		field.Time("finished_at").Immutable(),
+		field.Enum("outcome").Values("succeeded", "failed", "timed_out", "interrupted").Immutable(),
+		field.Text("error").Optional().Immutable(),
+	}
+}
+
+func (JobAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "attempt"),
+		index.Fields("started_at"),
+	}
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerCapacity is the number of the problems the manager can handle at the same time.
+// It overrides the default limit from the config.
+type ManagerCapacity struct {
+	ent.Schema
+}
+
+func (ManagerCapacity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("manager_id", types.UserID{}).Unique().Immutable(),
+		field.Int("capacity").Positive(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// ManagerSkill is the topic the manager can handle the problems of.
+type ManagerSkill struct {
+	ent.Schema
+}
+
+func (ManagerSkill) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("manager_id", types.UserID{}).Immutable(),
+		field.Enum("skill").GoType(types.Topic("")).Immutable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+func (ManagerSkill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("manager_id", "skill").Unique(),
+	}
+}
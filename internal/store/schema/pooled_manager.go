@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+// PooledManager is the manager waiting for the problems in the manager pool.
+// The auto-incremented ID keeps the FIFO order of the pool.
+type PooledManager struct {
+	ent.Schema
+}
+
+func (PooledManager) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("manager_id", types.UserID{}).Unique().Immutable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
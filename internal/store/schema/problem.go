@@ -25,6 +25,8 @@ func (Problem) Fields() []ent.Field {
 		field.UUID("chat_id", types.ChatID{}).Immutable(),
 		field.Time("created_at").Immutable().Default(time.Now),
 		field.Time("resolved_at").Nillable().Optional(),
+		field.Time("assigned_at").Nillable().Optional(),
+		field.Enum("topic").GoType(types.Topic("")).Nillable().Optional().Immutable(),
 	}
 }
 
@@ -18,8 +18,16 @@ type Tx struct {
 	FailedJob *FailedJobClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
+	// JobAttempt is the client for interacting with the JobAttempt builders.
+	JobAttempt *JobAttemptClient
+	// ManagerCapacity is the client for interacting with the ManagerCapacity builders.
+	ManagerCapacity *ManagerCapacityClient
+	// ManagerSkill is the client for interacting with the ManagerSkill builders.
+	ManagerSkill *ManagerSkillClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PooledManager is the client for interacting with the PooledManager builders.
+	PooledManager *PooledManagerClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
 
@@ -156,7 +164,11 @@ func (tx *Tx) init() {
 	tx.Chat = NewChatClient(tx.config)
 	tx.FailedJob = NewFailedJobClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
+	tx.JobAttempt = NewJobAttemptClient(tx.config)
+	tx.ManagerCapacity = NewManagerCapacityClient(tx.config)
+	tx.ManagerSkill = NewManagerSkillClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.PooledManager = NewPooledManagerClient(tx.config)
 	tx.Problem = NewProblemClient(tx.config)
 }
 
@@ -27,7 +27,7 @@ func NewDBSuite(dbPrefix string) DBSuite {
 func (ds *DBSuite) SetupSuite() {
 	ds.ContextSuite.SetupSuite()
 
-	db := ds.DBPrefix + strings.ReplaceAll(uuid.New().String(), "-", "")
+	db := UniqueDBName(ds.DBPrefix)
 	ds.T().Logf("database: %s", db)
 
 	ds.Store, ds.cleanUp = PrepareDB(ds.SuiteCtx, ds.T(), db)
@@ -40,3 +40,8 @@ func (ds *DBSuite) TearDownSuite() {
 	}
 	ds.ContextSuite.TearDownSuite()
 }
+
+// UniqueDBName returns the name of the database for the test, so the tests can run in parallel.
+func UniqueDBName(prefix string) string {
+	return prefix + strings.ReplaceAll(uuid.New().String(), "-", "")
+}
@@ -0,0 +1,32 @@
+package types
+
+// Topic is the subject of the client problem. The problem is routed to the managers skilled in its topic.
+type Topic string
+
+const (
+	TopicCards    Topic = "cards"
+	TopicLoans    Topic = "loans"
+	TopicDeposits Topic = "deposits"
+)
+
+// Topics are all the known topics.
+var Topics = []Topic{TopicCards, TopicLoans, TopicDeposits}
+
+// Valid reports whether the topic is one of the known topics.
+func (t Topic) Valid() bool {
+	for _, topic := range Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Values lists the topics for the ent enum fields.
+func (Topic) Values() []string {
+	values := make([]string, 0, len(Topics))
+	for _, t := range Topics {
+		values = append(values, string(t))
+	}
+	return values
+}
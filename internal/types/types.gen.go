@@ -9,7 +9,7 @@ import (
 )
 
 type IDs interface {
-	ChatID | FailedJobID | JobID | MessageID | ProblemID | RequestID | UserID
+	ChatID | EventID | FailedJobID | JobAttemptID | JobID | MessageID | ProblemID | RequestID | UserID
 }
 
 var ErrEmptyID = errors.New("empty id")
@@ -73,6 +73,49 @@ func (id ChatID) Validate() error {
 	return nil
 }
 
+type EventID uuid.UUID
+
+var EventIDNil = EventID(uuid.Nil)
+
+func NewEventID() EventID {
+	return EventID(uuid.New())
+}
+
+func (id EventID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id EventID) Value() (driver.Value, error) {
+	return uuid.UUID(id).Value()
+}
+
+func (id *EventID) Scan(v any) error {
+	return (*uuid.UUID)(id).Scan(v)
+}
+
+func (id EventID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *EventID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id EventID) IsZero() bool {
+	return id == EventIDNil
+}
+
+func (id EventID) Matches(other any) bool {
+	return id == other
+}
+
+func (id EventID) Validate() error {
+	if id.IsZero() {
+		return ErrEmptyID
+	}
+	return nil
+}
+
 type FailedJobID uuid.UUID
 
 var FailedJobIDNil = FailedJobID(uuid.Nil)
@@ -116,6 +159,49 @@ func (id FailedJobID) Validate() error {
 	return nil
 }
 
+type JobAttemptID uuid.UUID
+
+var JobAttemptIDNil = JobAttemptID(uuid.Nil)
+
+func NewJobAttemptID() JobAttemptID {
+	return JobAttemptID(uuid.New())
+}
+
+func (id JobAttemptID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id JobAttemptID) Value() (driver.Value, error) {
+	return uuid.UUID(id).Value()
+}
+
+func (id *JobAttemptID) Scan(v any) error {
+	return (*uuid.UUID)(id).Scan(v)
+}
+
+func (id JobAttemptID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *JobAttemptID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id JobAttemptID) IsZero() bool {
+	return id == JobAttemptIDNil
+}
+
+func (id JobAttemptID) Matches(other any) bool {
+	return id == other
+}
+
+func (id JobAttemptID) Validate() error {
+	if id.IsZero() {
+		return ErrEmptyID
+	}
+	return nil
+}
+
 type JobID uuid.UUID
 
 var JobIDNil = JobID(uuid.Nil)
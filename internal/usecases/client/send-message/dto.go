@@ -11,6 +11,8 @@ type Request struct {
 	ID          types.RequestID `validate:"required"`
 	ClientID    types.UserID    `validate:"required"`
 	MessageBody string          `validate:"required,gte=1,lte=1000"`
+	// Topic is chosen by the client for the new problem. It is inferred from the message if empty.
+	Topic types.Topic `validate:"omitempty,topic"`
 }
 
 func (r Request) Validate() error {
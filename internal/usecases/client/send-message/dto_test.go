@@ -27,7 +27,28 @@ func TestRequest_Validate(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "valid request with topic",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageBody: "Hello, guys!",
+				Topic:       types.TopicLoans,
+			},
+			wantErr: false,
+		},
+
 		// Negative.
+		{
+			name: "unknown topic",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageBody: "Hello, guys!",
+				Topic:       "mortgage",
+			},
+			wantErr: true,
+		},
 		{
 			name: "require request id",
 			request: sendmessage.Request{
@@ -129,18 +129,55 @@ func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
 }
 
 // CreateIfNotExists mocks base method.
-func (m *MockproblemsRepository) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error) {
+func (m *MockproblemsRepository) CreateIfNotExists(ctx context.Context, chatID types.ChatID, topic types.Topic) (types.ProblemID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, chatID)
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, chatID, topic)
 	ret0, _ := ret[0].(types.ProblemID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateIfNotExists indicates an expected call of CreateIfNotExists.
-func (mr *MockproblemsRepositoryMockRecorder) CreateIfNotExists(ctx, chatID interface{}) *gomock.Call {
+func (mr *MockproblemsRepositoryMockRecorder) CreateIfNotExists(ctx, chatID, topic interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockproblemsRepository)(nil).CreateIfNotExists), ctx, chatID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockproblemsRepository)(nil).CreateIfNotExists), ctx, chatID, topic)
+}
+
+// MocktopicDetector is a mock of topicDetector interface.
+type MocktopicDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MocktopicDetectorMockRecorder
+}
+
+// MocktopicDetectorMockRecorder is the mock recorder for MocktopicDetector.
+type MocktopicDetectorMockRecorder struct {
+	mock *MocktopicDetector
+}
+
+// NewMocktopicDetector creates a new mock instance.
+func NewMocktopicDetector(ctrl *gomock.Controller) *MocktopicDetector {
+	mock := &MocktopicDetector{ctrl: ctrl}
+	mock.recorder = &MocktopicDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktopicDetector) EXPECT() *MocktopicDetectorMockRecorder {
+	return m.recorder
+}
+
+// Detect mocks base method.
+func (m *MocktopicDetector) Detect(msgBody string) types.Topic {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detect", msgBody)
+	ret0, _ := ret[0].(types.Topic)
+	return ret0
+}
+
+// Detect indicates an expected call of Detect.
+func (mr *MocktopicDetectorMockRecorder) Detect(msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MocktopicDetector)(nil).Detect), msgBody)
 }
 
 // Mocktransactor is a mock of transactor interface.
@@ -203,17 +240,17 @@ func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
 	return m.recorder
 }
 
-// Put mocks base method.
-func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+// PutOrdered mocks base method.
+func (m *MockoutboxService) PutOrdered(ctx context.Context, name, payload, orderingKey string, availableAt time.Time) (types.JobID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret := m.ctrl.Call(m, "PutOrdered", ctx, name, payload, orderingKey, availableAt)
 	ret0, _ := ret[0].(types.JobID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+// PutOrdered indicates an expected call of PutOrdered.
+func (mr *MockoutboxServiceMockRecorder) PutOrdered(ctx, name, payload, orderingKey, availableAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrdered", reflect.TypeOf((*MockoutboxService)(nil).PutOrdered), ctx, name, payload, orderingKey, availableAt)
 }
@@ -36,7 +36,11 @@ type messagesRepository interface {
 }
 
 type problemsRepository interface {
-	CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error)
+	CreateIfNotExists(ctx context.Context, chatID types.ChatID, topic types.Topic) (types.ProblemID, error)
+}
+
+type topicDetector interface {
+	Detect(msgBody string) types.Topic
 }
 
 type transactor interface {
@@ -44,7 +48,7 @@ type transactor interface {
 }
 
 type outboxService interface {
-	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+	PutOrdered(ctx context.Context, name, payload, orderingKey string, availableAt time.Time) (types.JobID, error)
 }
 
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
@@ -53,6 +57,7 @@ type Options struct {
 	msgRepo     messagesRepository `option:"mandatory" validate:"required"`
 	outbox      outboxService      `option:"mandatory" validate:"required"`
 	problemRepo problemsRepository `option:"mandatory" validate:"required"`
+	topics      topicDetector      `option:"mandatory" validate:"required"`
 	tx          transactor         `option:"mandatory" validate:"required"`
 }
 
@@ -86,7 +91,12 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			return ErrChatNotCreated
 		}
 
-		problemID, err := u.problemRepo.CreateIfNotExists(ctx, chatID)
+		topic := req.Topic
+		if topic == "" {
+			topic = u.topics.Detect(req.MessageBody)
+		}
+
+		problemID, err := u.problemRepo.CreateIfNotExists(ctx, chatID, topic)
 		if err != nil {
 			return ErrProblemNotCreated
 		}
@@ -103,7 +113,12 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			return err
 		}
 
-		_, err = u.outbox.Put(ctx, sendclientmessagejob.Name, msg.ID.String(), time.Now())
+		payload, err := sendclientmessagejob.MarshalPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("marshal job payload: %w", err)
+		}
+
+		_, err = u.outbox.PutOrdered(ctx, sendclientmessagejob.Name, payload, chatID.String(), time.Now())
 		if err != nil {
 			return fmt.Errorf("failed to put job to outbox: %w", err)
 		}
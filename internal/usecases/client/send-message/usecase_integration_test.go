@@ -16,6 +16,7 @@ import (
 	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
 	"github.com/keepcalmist/chat-service/internal/services/outbox"
+	topicdetector "github.com/keepcalmist/chat-service/internal/services/topic-detector"
 	"github.com/keepcalmist/chat-service/internal/testingh"
 	"github.com/keepcalmist/chat-service/internal/types"
 	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/client/send-message"
@@ -49,7 +50,7 @@ func (s *UseCaseIntegrationSuite) SetupSuite() {
 	msgRepo, err := messagesrepo.New(messagesrepo.NewOptions(s.Database))
 	s.Require().NoError(err)
 
-	outBoxSvc, err := outbox.New(outbox.NewOptions(1, 10*time.Second, time.Minute, jobsRepo, s.Database))
+	outBoxSvc, err := outbox.New(outbox.NewOptions(1, 1, 10*time.Second, time.Minute, jobsRepo, s.Database))
 	s.Require().NoError(err)
 
 	problemRepo, err := problemsrepo.New(problemsrepo.NewOptions(s.Database))
@@ -60,6 +61,7 @@ func (s *UseCaseIntegrationSuite) SetupSuite() {
 		msgRepo,
 		outBoxSvc,
 		problemRepo,
+		topicdetector.New(nil),
 		s.Database,
 	))
 	s.Require().NoError(err)
@@ -71,6 +73,7 @@ func (s *UseCaseIntegrationSuite) SetupSuite() {
 		s.msgRepoMock,
 		outBoxSvc,
 		problemRepo,
+		topicdetector.New(nil),
 		s.Database,
 	))
 	s.Require().NoError(err)
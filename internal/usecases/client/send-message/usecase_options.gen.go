@@ -15,6 +15,7 @@ func NewOptions(
 	msgRepo messagesRepository,
 	outbox outboxService,
 	problemRepo problemsRepository,
+	topics topicDetector,
 	tx transactor,
 	options ...OptOptionsSetter,
 ) Options {
@@ -26,6 +27,7 @@ func NewOptions(
 	o.msgRepo = msgRepo
 	o.outbox = outbox
 	o.problemRepo = problemRepo
+	o.topics = topics
 	o.tx = tx
 
 	for _, opt := range options {
@@ -40,6 +42,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("outbox", _validate_Options_outbox(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemRepo", _validate_Options_problemRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("topics", _validate_Options_topics(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("tx", _validate_Options_tx(o)))
 	return errs.AsError()
 }
@@ -72,6 +75,13 @@ func _validate_Options_problemRepo(o *Options) error {
 	return nil
 }
 
+func _validate_Options_topics(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.topics, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `topics` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_tx(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.tx, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `tx` did not pass the test: %w", err)
@@ -26,6 +26,7 @@ type UseCaseSuite struct {
 	msgRepo     *sendmessagemocks.MockmessagesRepository
 	outBoxSvc   *sendmessagemocks.MockoutboxService
 	problemRepo *sendmessagemocks.MockproblemsRepository
+	topics      *sendmessagemocks.MocktopicDetector
 	txtor       *sendmessagemocks.Mocktransactor
 	uCase       sendmessage.UseCase
 }
@@ -41,10 +42,18 @@ func (s *UseCaseSuite) SetupTest() {
 	s.msgRepo = sendmessagemocks.NewMockmessagesRepository(s.ctrl)
 	s.outBoxSvc = sendmessagemocks.NewMockoutboxService(s.ctrl)
 	s.problemRepo = sendmessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.topics = sendmessagemocks.NewMocktopicDetector(s.ctrl)
 	s.txtor = sendmessagemocks.NewMocktransactor(s.ctrl)
 
 	var err error
-	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.chatRepo, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.uCase, err = sendmessage.New(sendmessage.NewOptions(
+		s.chatRepo,
+		s.msgRepo,
+		s.outBoxSvc,
+		s.problemRepo,
+		s.topics,
+		s.txtor,
+	))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -171,7 +180,9 @@ func (s *UseCaseSuite) TestCreateProblemError() {
 		})
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
-	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(types.ProblemIDNil, errors.New("unexpected"))
+	s.topics.EXPECT().Detect("Hello!").Return(types.Topic(""))
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, types.Topic("")).
+		Return(types.ProblemIDNil, errors.New("unexpected"))
 
 	req := sendmessage.Request{
 		ID:          reqID,
@@ -201,7 +212,8 @@ func (s *UseCaseSuite) TestCreateMessageError() {
 		})
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
-	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.topics.EXPECT().Detect(msgBody).Return(types.Topic(""))
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, types.Topic("")).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(nil, errors.New("unexpected"))
 
@@ -232,10 +244,11 @@ func (s *UseCaseSuite) TestPutJobError() {
 		})
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
-	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.topics.EXPECT().Detect(msgBody).Return(types.Topic(""))
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, types.Topic("")).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), chatID.String(), gomock.Any()).
 		Return(types.JobIDNil, errors.New("unexpected"))
 
 	req := sendmessage.Request{
@@ -266,10 +279,11 @@ func (s *UseCaseSuite) TestTransactionError() {
 		})
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
-	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.topics.EXPECT().Detect(msgBody).Return(types.Topic(""))
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, types.Topic("")).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), chatID.String(), gomock.Any()).
 		Return(types.NewJobID(), nil)
 
 	req := sendmessage.Request{
@@ -304,7 +318,8 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 		})
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
-	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.topics.EXPECT().Detect(msgBody).Return(types.Topic(""))
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, types.Topic("")).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(&messagesrepo.Message{
 			ID:                  messageID,
@@ -317,7 +332,7 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 			IsBlocked:           false,
 			IsService:           false,
 		}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), chatID.String(), gomock.Any()).
 		Return(types.NewJobID(), nil)
 
 	req := sendmessage.Request{
@@ -335,3 +350,53 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 	s.Require().Equal(messageID, resp.MessageID)
 	s.Require().True(createdAt.Equal(resp.CreatedAt))
 }
+
+func (s *UseCaseSuite) TestProblemTopic() {
+	for _, tt := range []struct {
+		name          string
+		reqTopic      types.Topic
+		detectedTopic types.Topic
+		expectedTopic types.Topic
+	}{
+		{name: "chosen by client", reqTopic: types.TopicDeposits, expectedTopic: types.TopicDeposits},
+		{name: "detected by keywords", detectedTopic: types.TopicCards, expectedTopic: types.TopicCards},
+		{name: "unknown"},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			clientID := types.NewUserID()
+			chatID := types.NewChatID()
+			problemID := types.NewProblemID()
+			const msgBody = "My card is blocked"
+
+			s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+			s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+			if tt.reqTopic == "" {
+				s.topics.EXPECT().Detect(msgBody).Return(tt.detectedTopic)
+			}
+			s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID, tt.expectedTopic).Return(problemID, nil)
+			s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
+				Return(&messagesrepo.Message{ID: types.NewMessageID(), ChatID: chatID, AuthorID: clientID}, nil)
+			s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), chatID.String(), gomock.Any()).
+				Return(types.NewJobID(), nil)
+
+			req := sendmessage.Request{
+				ID:          reqID,
+				ClientID:    clientID,
+				MessageBody: msgBody,
+				Topic:       tt.reqTopic,
+			}
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().NoError(err)
+		})
+	}
+}
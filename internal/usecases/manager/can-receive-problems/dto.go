@@ -16,4 +16,10 @@ func (r Request) Validate() error {
 
 type Response struct {
 	Result bool
+	// InPool is true if the manager is waiting for the problems and can leave the pool.
+	InPool bool
+	// Load is the number of the manager's open problems.
+	Load int
+	// Capacity is the number of the problems the manager can handle at the same time.
+	Capacity int
 }
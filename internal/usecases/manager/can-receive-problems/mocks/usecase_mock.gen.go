@@ -35,19 +35,20 @@ func (m *MockmanagerLoadService) EXPECT() *MockmanagerLoadServiceMockRecorder {
 	return m.recorder
 }
 
-// CanManagerTakeProblem mocks base method.
-func (m *MockmanagerLoadService) CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error) {
+// GetManagerLoad mocks base method.
+func (m *MockmanagerLoadService) GetManagerLoad(ctx context.Context, managerID types.UserID) (int, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CanManagerTakeProblem", ctx, managerID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetManagerLoad", ctx, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// CanManagerTakeProblem indicates an expected call of CanManagerTakeProblem.
-func (mr *MockmanagerLoadServiceMockRecorder) CanManagerTakeProblem(ctx, managerID interface{}) *gomock.Call {
+// GetManagerLoad indicates an expected call of GetManagerLoad.
+func (mr *MockmanagerLoadServiceMockRecorder) GetManagerLoad(ctx, managerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManagerTakeProblem", reflect.TypeOf((*MockmanagerLoadService)(nil).CanManagerTakeProblem), ctx, managerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerLoad", reflect.TypeOf((*MockmanagerLoadService)(nil).GetManagerLoad), ctx, managerID)
 }
 
 // MockmanagerPool is a mock of managerPool interface.
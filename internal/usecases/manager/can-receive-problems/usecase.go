@@ -10,7 +10,7 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=canreceiveproblemsmocks
 
 type managerLoadService interface {
-	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
+	GetManagerLoad(ctx context.Context, managerID types.UserID) (load, capacity int, err error)
 }
 
 type managerPool interface {
@@ -46,16 +46,15 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		return Response{}, fmt.Errorf("checking manager %d in pool err: %w", req.ManagerID, err)
 	}
 
-	if contains {
-		return Response{Result: false}, nil
-	}
-
-	canTakeProblem, err := u.managerLoadService.CanManagerTakeProblem(ctx, req.ManagerID)
+	load, capacity, err := u.managerLoadService.GetManagerLoad(ctx, req.ManagerID)
 	if err != nil {
-		return Response{}, fmt.Errorf("can manager %d take problem err: %w", req.ManagerID, err)
+		return Response{}, fmt.Errorf("get manager %d load err: %w", req.ManagerID, err)
 	}
 
 	return Response{
-		Result: canTakeProblem,
+		Result:   !contains && capacity > load,
+		InPool:   contains,
+		Load:     load,
+		Capacity: capacity,
 	}, nil
 }
@@ -48,7 +48,7 @@ func (s *UseCaseSuite) TearDownTest() {
 
 func (s *UseCaseSuite) TestUseCaseHandle_RequestValidation() {
 	s.mPoolMock.EXPECT().Contains(s.Ctx, gomock.Any()).Return(false, nil)
-	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, gomock.Any()).Return(true, nil)
+	s.mLoadMock.EXPECT().GetManagerLoad(s.Ctx, gomock.Any()).Return(1, 5, nil)
 
 	tCase := []struct {
 		name    string
@@ -118,6 +118,7 @@ func (s *UseCaseSuite) TestUseCaseHandle_ContainsError() {
 
 func (s *UseCaseSuite) TestUseCaseHandle_ManagerAlreadyInThePool() {
 	s.mPoolMock.EXPECT().Contains(s.Ctx, gomock.Any()).Return(true, nil)
+	s.mLoadMock.EXPECT().GetManagerLoad(s.Ctx, gomock.Any()).Return(2, 5, nil)
 
 	resp, err := s.uCase.Handle(s.Ctx, canreceiveproblems.Request{
 		ID:        types.NewRequestID(),
@@ -126,13 +127,16 @@ func (s *UseCaseSuite) TestUseCaseHandle_ManagerAlreadyInThePool() {
 
 	s.Require().NoError(err)
 	s.False(resp.Result)
+	s.True(resp.InPool)
+	s.Equal(2, resp.Load)
+	s.Equal(5, resp.Capacity)
 }
 
-func (s *UseCaseSuite) TestUseCaseHandle_CanManagerTakeProblemError() {
+func (s *UseCaseSuite) TestUseCaseHandle_GetManagerLoadError() {
 	containsError := errors.New("contains error")
 
 	s.mPoolMock.EXPECT().Contains(s.Ctx, gomock.Any()).Return(false, nil)
-	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, gomock.Any()).Return(false, containsError)
+	s.mLoadMock.EXPECT().GetManagerLoad(s.Ctx, gomock.Any()).Return(0, 0, containsError)
 
 	_, err := s.uCase.Handle(s.Ctx, canreceiveproblems.Request{
 		ID:        types.NewRequestID(),
@@ -149,8 +153,8 @@ func (s *UseCaseSuite) TestUseCaseHandle_SuccessfulResults() {
 	s.mPoolMock.EXPECT().Contains(s.Ctx, firstManager).Return(false, nil)
 	s.mPoolMock.EXPECT().Contains(s.Ctx, secondManager).Return(false, nil)
 
-	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, firstManager).Return(true, nil)
-	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, secondManager).Return(false, nil)
+	s.mLoadMock.EXPECT().GetManagerLoad(s.Ctx, firstManager).Return(3, 5, nil)
+	s.mLoadMock.EXPECT().GetManagerLoad(s.Ctx, secondManager).Return(5, 5, nil)
 
 	resp, err := s.uCase.Handle(s.Ctx, canreceiveproblems.Request{
 		ID:        types.NewRequestID(),
@@ -158,6 +162,9 @@ func (s *UseCaseSuite) TestUseCaseHandle_SuccessfulResults() {
 	})
 	s.Require().NoError(err)
 	s.True(resp.Result)
+	s.False(resp.InPool)
+	s.Equal(3, resp.Load)
+	s.Equal(5, resp.Capacity)
 
 	resp, err = s.uCase.Handle(s.Ctx, canreceiveproblems.Request{
 		ID:        types.NewRequestID(),
@@ -165,4 +172,6 @@ func (s *UseCaseSuite) TestUseCaseHandle_SuccessfulResults() {
 	})
 	s.Require().NoError(err)
 	s.False(resp.Result)
+	s.Equal(5, resp.Load)
+	s.Equal(5, resp.Capacity)
 }
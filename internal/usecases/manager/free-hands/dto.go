@@ -8,6 +8,8 @@ import (
 type Request struct {
 	ID        types.RequestID `validate:"required"`
 	ManagerID types.UserID    `validate:"required"`
+	// Skills are the topics the manager is skilled in according to the token.
+	Skills []types.Topic `validate:"dive,topic"`
 }
 
 func (r Request) Validate() error {
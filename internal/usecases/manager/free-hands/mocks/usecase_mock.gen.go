@@ -86,3 +86,40 @@ func (mr *MockmanagerPoolMockRecorder) Put(ctx, managerID interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockmanagerPool)(nil).Put), ctx, managerID)
 }
+
+// MockskillsRepository is a mock of skillsRepository interface.
+type MockskillsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockskillsRepositoryMockRecorder
+}
+
+// MockskillsRepositoryMockRecorder is the mock recorder for MockskillsRepository.
+type MockskillsRepositoryMockRecorder struct {
+	mock *MockskillsRepository
+}
+
+// NewMockskillsRepository creates a new mock instance.
+func NewMockskillsRepository(ctrl *gomock.Controller) *MockskillsRepository {
+	mock := &MockskillsRepository{ctrl: ctrl}
+	mock.recorder = &MockskillsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockskillsRepository) EXPECT() *MockskillsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SetManagerSkills mocks base method.
+func (m *MockskillsRepository) SetManagerSkills(ctx context.Context, managerID types.UserID, skills []types.Topic) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManagerSkills", ctx, managerID, skills)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetManagerSkills indicates an expected call of SetManagerSkills.
+func (mr *MockskillsRepositoryMockRecorder) SetManagerSkills(ctx, managerID, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagerSkills", reflect.TypeOf((*MockskillsRepository)(nil).SetManagerSkills), ctx, managerID, skills)
+}
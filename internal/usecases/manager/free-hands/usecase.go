@@ -20,10 +20,17 @@ type managerPool interface {
 	Put(ctx context.Context, managerID types.UserID) error
 }
 
+type skillsRepository interface {
+	SetManagerSkills(ctx context.Context, managerID types.UserID, skills []types.Topic) error
+}
+
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
 	managerLoadService managerLoadService `option:"mandatory" validate:"required"`
 	managerPool        managerPool        `option:"mandatory" validate:"required"`
+	// skillsRepo is set if the skills of the managers are taken from the token.
+	// Otherwise the request skills are ignored and the stored ones are used.
+	skillsRepo skillsRepository
 }
 
 type UseCase struct {
@@ -53,5 +60,12 @@ func (u UseCase) Handle(ctx context.Context, req Request) error {
 		return ErrManagerCannotTakeMoreProblems
 	}
 
+	// The skills are stored before the manager gets into the pool, so the scheduler routes the problems right.
+	if u.skillsRepo != nil {
+		if err := u.skillsRepo.SetManagerSkills(ctx, req.ManagerID, req.Skills); err != nil {
+			return fmt.Errorf("set manager skills: %w", err)
+		}
+	}
+
 	return u.managerPool.Put(ctx, req.ManagerID)
 }
@@ -28,6 +28,12 @@ func NewOptions(
 	return o
 }
 
+func WithSkillsRepo(opt skillsRepository) OptOptionsSetter {
+	return func(o *Options) {
+		o.skillsRepo = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("managerLoadService", _validate_Options_managerLoadService(o)))
@@ -120,3 +120,49 @@ func (s *UseCaseSuite) TestUseCaseHandle() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *UseCaseSuite) TestUseCaseHandle_SkillsFromToken() {
+	// Arrange.
+	skillsRepo := freehandsmocks.NewMockskillsRepository(s.ctrl)
+	uCase, err := freehands.New(freehands.NewOptions(s.loadService, s.managerPool, freehands.WithSkillsRepo(skillsRepo)))
+	s.Require().NoError(err)
+
+	managerID := types.NewUserID()
+	skills := []types.Topic{types.TopicCards, types.TopicDeposits}
+
+	s.Run("unknown skill", func() {
+		err := uCase.Handle(s.Ctx, freehands.Request{
+			ID:        types.NewRequestID(),
+			ManagerID: managerID,
+			Skills:    []types.Topic{"mortgage"},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("set skills error", func() {
+		s.loadService.EXPECT().CanManagerTakeProblem(s.Ctx, managerID).Return(true, nil)
+		skillsRepo.EXPECT().SetManagerSkills(s.Ctx, managerID, skills).Return(errors.New("unexpected"))
+
+		err := uCase.Handle(s.Ctx, freehands.Request{
+			ID:        types.NewRequestID(),
+			ManagerID: managerID,
+			Skills:    skills,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("skills are stored before getting into the pool", func() {
+		gomock.InOrder(
+			s.loadService.EXPECT().CanManagerTakeProblem(s.Ctx, managerID).Return(true, nil),
+			skillsRepo.EXPECT().SetManagerSkills(s.Ctx, managerID, skills).Return(nil),
+			s.managerPool.EXPECT().Put(s.Ctx, managerID).Return(nil),
+		)
+
+		err := uCase.Handle(s.Ctx, freehands.Request{
+			ID:        types.NewRequestID(),
+			ManagerID: managerID,
+			Skills:    skills,
+		})
+		s.Require().NoError(err)
+	})
+}
@@ -0,0 +1,111 @@
+package getchathistory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+	getchathistory "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getchathistory.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "cursor specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+			},
+			wantErr: false,
+		},
+		{
+			name: "page size specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  50,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "no chat id",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				PageSize:  50,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no manager id",
+			request: getchathistory.Request{
+				ID:       types.NewRequestID(),
+				ChatID:   types.NewChatID(),
+				PageSize: 50,
+			},
+			wantErr: true,
+		},
+		{
+			name: "neither cursor nor page size specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "both cursor and page size specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  50,
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too small page size",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  9,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big page size",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  101,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package getchathistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/keepcalmist/chat-service/internal/cursor"
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getchathistorymocks
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	GetProblemMessages(
+		ctx context.Context,
+		problemID types.ProblemID,
+		pageSize int,
+		cursor *messagesrepo.Cursor,
+	) ([]messagesrepo.Message, *messagesrepo.Cursor, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, err
+	}
+
+	return UseCase{
+		Options: opts,
+	}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		if errors.Is(err, ErrInvalidRequest) {
+			return Response{}, err
+		}
+		return Response{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	var cur *messagesrepo.Cursor
+	if req.Cursor != "" {
+		cur = new(messagesrepo.Cursor)
+		if err := cursor.Decode(req.Cursor, cur); err != nil {
+			return Response{}, ErrInvalidCursor
+		}
+	}
+
+	problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+			return Response{}, ErrProblemNotFound
+		}
+		return Response{}, fmt.Errorf("get assigned problem: %w", err)
+	}
+
+	msgs, next, err := u.msgRepo.GetProblemMessages(ctx, problemID, req.PageSize, cur)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrInvalidCursor) {
+			return Response{}, ErrInvalidCursor
+		}
+		return Response{}, fmt.Errorf("get problem messages: %w", err)
+	}
+
+	nextCursor := ""
+	if next != nil {
+		nextCursor, err = cursor.Encode(next)
+		if err != nil {
+			return Response{}, fmt.Errorf("encode cursor: %w", err)
+		}
+	}
+
+	result := make([]Message, 0, len(msgs))
+	for _, m := range msgs {
+		result = append(result, Message{
+			ID:        m.ID,
+			AuthorID:  m.AuthorID,
+			Body:      m.Body,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return Response{
+		Messages:   result,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,273 @@
+package getchathistory_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/keepcalmist/chat-service/internal/cursor"
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	getchathistory "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history"
+	getchathistorymocks "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chat-history/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *getchathistorymocks.MockmessagesRepository
+	problemsRepo *getchathistorymocks.MockproblemsRepository
+	uCase        getchathistory.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = getchathistorymocks.NewMockmessagesRepository(s.ctrl)
+	s.problemsRepo = getchathistorymocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getchathistory.New(getchathistory.NewOptions(s.msgRepo, s.problemsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getchathistory.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrInvalidRequest)
+	s.Empty(resp.Messages)
+	s.Empty(resp.NextCursor)
+}
+
+func (s *UseCaseSuite) TestCursorDecodingError() {
+	// Arrange.
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		Cursor:    "eyJwYWdlX3NpemUiOjEwMA==", // {"page_size":100
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrInvalidCursor)
+	s.Empty(resp.Messages)
+	s.Empty(resp.NextCursor)
+}
+
+func (s *UseCaseSuite) TestManagerIsNotAssignedToChat() {
+	// Arrange.
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		PageSize:  10,
+	}
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrProblemNotFound)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemError() {
+	// Arrange.
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		PageSize:  10,
+	}
+	errExpected := errors.New("any error")
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, errExpected)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_InvalidCursor() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+
+	c := messagesrepo.Cursor{PageSize: -1, LastCreatedAt: time.Now()}
+	cursorWithNegativePageSize, err := cursor.Encode(c)
+	s.Require().NoError(err)
+
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, managerID, chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 0, messagesrepo.NewCursorMatcher(c)).
+		Return(nil, nil, messagesrepo.ErrInvalidCursor)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Cursor:    cursorWithNegativePageSize,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrInvalidCursor)
+	s.Empty(resp.Messages)
+	s.Empty(resp.NextCursor)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_SomeError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	errExpected := errors.New("any error")
+
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, managerID, chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 20, (*messagesrepo.Cursor)(nil)).
+		Return(nil, nil, errExpected)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  20,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Messages)
+	s.Empty(resp.NextCursor)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_FirstPage() {
+	// Arrange.
+	const messagesCount = 10
+	const pageSize = messagesCount
+
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	expectedMsgs := s.createMessages(messagesCount, types.NewUserID(), chatID)
+	lastMsg := expectedMsgs[len(expectedMsgs)-1]
+
+	nextCursor := &messagesrepo.Cursor{PageSize: pageSize, LastCreatedAt: lastMsg.CreatedAt}
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, managerID, chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, pageSize, (*messagesrepo.Cursor)(nil)).
+		Return(expectedMsgs, nextCursor, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  pageSize,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.NotEmpty(resp.NextCursor)
+
+	var actualCursor messagesrepo.Cursor
+	s.Require().NoError(cursor.Decode(resp.NextCursor, &actualCursor))
+	s.True(messagesrepo.NewCursorMatcher(*nextCursor).Matches(&actualCursor))
+
+	s.Require().Len(resp.Messages, messagesCount)
+	for i := 0; i < messagesCount; i++ {
+		s.Equal(expectedMsgs[i].ID, resp.Messages[i].ID)
+		s.Equal(expectedMsgs[i].AuthorID, resp.Messages[i].AuthorID)
+		s.Equal(expectedMsgs[i].Body, resp.Messages[i].Body)
+		s.Equal(expectedMsgs[i].CreatedAt.Unix(), resp.Messages[i].CreatedAt.Unix())
+	}
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_LastPage() {
+	// Arrange.
+	const messagesCount = 10
+	const pageSize = messagesCount + 1
+
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	expectedMsgs := s.createMessages(messagesCount, types.NewUserID(), chatID)
+
+	c := messagesrepo.Cursor{PageSize: pageSize, LastCreatedAt: time.Now()}
+	s.problemsRepo.EXPECT().GetAssignedProblemID(s.Ctx, managerID, chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 0, messagesrepo.NewCursorMatcher(c)).
+		Return(expectedMsgs, nil, nil)
+
+	cursorStr, err := cursor.Encode(c)
+	s.Require().NoError(err)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Cursor:    cursorStr,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Empty(resp.NextCursor)
+	s.Require().Len(resp.Messages, messagesCount)
+}
+
+func (s *UseCaseSuite) createMessages(count int, authorID types.UserID, chatID types.ChatID) []messagesrepo.Message {
+	s.T().Helper()
+
+	result := make([]messagesrepo.Message, 0, count)
+	for i := 0; i < count; i++ {
+		result = append(result, messagesrepo.Message{
+			ID:                  types.NewMessageID(),
+			ChatID:              chatID,
+			AuthorID:            authorID,
+			Body:                fmt.Sprintf("message %d", i),
+			CreatedAt:           time.Now(),
+			IsVisibleForClient:  true,
+			IsVisibleForManager: true,
+		})
+	}
+	return result
+}
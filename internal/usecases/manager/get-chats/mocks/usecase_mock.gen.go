@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getchatsmocks is a generated GoMock package.
+package getchatsmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagerOpenChats mocks base method.
+func (m *MockproblemsRepository) GetManagerOpenChats(ctx context.Context, managerID types.UserID) ([]problemsrepo.ManagerChat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerOpenChats", ctx, managerID)
+	ret0, _ := ret[0].([]problemsrepo.ManagerChat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerOpenChats indicates an expected call of GetManagerOpenChats.
+func (mr *MockproblemsRepositoryMockRecorder) GetManagerOpenChats(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerOpenChats", reflect.TypeOf((*MockproblemsRepository)(nil).GetManagerOpenChats), ctx, managerID)
+}
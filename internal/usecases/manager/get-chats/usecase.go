@@ -0,0 +1,55 @@
+package getchats
+
+import (
+	"context"
+	"fmt"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getchatsmocks
+
+type problemsRepository interface {
+	GetManagerOpenChats(ctx context.Context, managerID types.UserID) ([]problemsrepo.ManagerChat, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, err
+	}
+	return UseCase{
+		Options: opts,
+	}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validation request err: %w", err)
+	}
+
+	chats, err := u.problemsRepo.GetManagerOpenChats(ctx, req.ManagerID)
+	if err != nil {
+		return Response{}, fmt.Errorf("get manager %s open chats err: %w", req.ManagerID, err)
+	}
+
+	result := make([]Chat, 0, len(chats))
+	for _, c := range chats {
+		result = append(result, Chat{
+			ID:               c.ChatID,
+			ClientID:         c.ClientID,
+			ProblemCreatedAt: c.ProblemCreatedAt,
+		})
+	}
+
+	return Response{Chats: result}, nil
+}
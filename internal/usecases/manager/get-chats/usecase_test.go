@@ -0,0 +1,120 @@
+package getchats_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	getchats "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats"
+	getchatsmocks "github.com/keepcalmist/chat-service/internal/usecases/manager/get-chats/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	problemsRepo *getchatsmocks.MockproblemsRepository
+	uCase        getchats.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemsRepo = getchatsmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getchats.New(getchats.NewOptions(s.problemsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.UserIDNil,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Chats)
+}
+
+func (s *UseCaseSuite) TestGetChatsError() {
+	// Arrange.
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+	}
+	errExpected := errors.New("unexpected")
+	s.problemsRepo.EXPECT().GetManagerOpenChats(s.Ctx, req.ManagerID).Return(nil, errExpected)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Chats)
+}
+
+func (s *UseCaseSuite) TestNoChats() {
+	// Arrange.
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+	}
+	s.problemsRepo.EXPECT().GetManagerOpenChats(s.Ctx, req.ManagerID).Return(nil, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.NotNil(resp.Chats)
+	s.Empty(resp.Chats)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+	}
+	chats := []problemsrepo.ManagerChat{
+		{ChatID: types.NewChatID(), ClientID: types.NewUserID(), ProblemCreatedAt: time.Now().Add(-time.Minute)},
+		{ChatID: types.NewChatID(), ClientID: types.NewUserID(), ProblemCreatedAt: time.Now()},
+	}
+	s.problemsRepo.EXPECT().GetManagerOpenChats(s.Ctx, req.ManagerID).Return(chats, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Chats, len(chats))
+	for i := range chats {
+		s.Equal(chats[i].ChatID, resp.Chats[i].ID)
+		s.Equal(chats[i].ClientID, resp.Chats[i].ClientID)
+		s.Equal(chats[i].ProblemCreatedAt, resp.Chats[i].ProblemCreatedAt)
+	}
+}
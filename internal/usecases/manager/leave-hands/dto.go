@@ -0,0 +1,15 @@
+package leavehands
+
+import (
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
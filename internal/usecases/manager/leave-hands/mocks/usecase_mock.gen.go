@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package leavehandsmocks is a generated GoMock package.
+package leavehandsmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockmanagerPool is a mock of managerPool interface.
+type MockmanagerPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPoolMockRecorder
+}
+
+// MockmanagerPoolMockRecorder is the mock recorder for MockmanagerPool.
+type MockmanagerPoolMockRecorder struct {
+	mock *MockmanagerPool
+}
+
+// NewMockmanagerPool creates a new mock instance.
+func NewMockmanagerPool(ctrl *gomock.Controller) *MockmanagerPool {
+	mock := &MockmanagerPool{ctrl: ctrl}
+	mock.recorder = &MockmanagerPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPool) EXPECT() *MockmanagerPoolMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockmanagerPool) Remove(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockmanagerPoolMockRecorder) Remove(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockmanagerPool)(nil).Remove), ctx, managerID)
+}
@@ -0,0 +1,45 @@
+package leavehands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=leavehandsmocks
+
+type managerPool interface {
+	Remove(ctx context.Context, managerID types.UserID) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	managerPool managerPool `option:"mandatory" validate:"required"`
+}
+
+// UseCase takes the manager out of the pool, so no new problems are assigned to them.
+// The problems already assigned are kept.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, err
+	}
+	return UseCase{
+		Options: opts,
+	}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) error {
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("validation request err: %w", err)
+	}
+
+	if err := u.managerPool.Remove(ctx, req.ManagerID); err != nil {
+		return fmt.Errorf("remove manager %s from pool err: %w", req.ManagerID, err)
+	}
+	return nil
+}
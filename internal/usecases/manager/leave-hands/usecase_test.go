@@ -0,0 +1,86 @@
+package leavehands_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	leavehands "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands"
+	leavehandsmocks "github.com/keepcalmist/chat-service/internal/usecases/manager/leave-hands/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	managerPool *leavehandsmocks.MockmanagerPool
+	uCase       leavehands.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.managerPool = leavehandsmocks.NewMockmanagerPool(s.ctrl)
+
+	var err error
+	s.uCase, err = leavehands.New(leavehands.NewOptions(s.managerPool))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := leavehands.Request{ManagerID: types.NewUserID()}
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestRemoveError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	errExpected := errors.New("any error")
+	s.managerPool.EXPECT().Remove(s.Ctx, managerID).Return(errExpected)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, leavehands.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+	})
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.managerPool.EXPECT().Remove(s.Ctx, managerID).Return(nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, leavehands.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package resolveproblemmocks is a generated GoMock package.
+package resolveproblemmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceMessageForClient mocks base method.
+func (m *MockmessagesRepository) CreateServiceMessageForClient(ctx context.Context, problemID types.ProblemID, chatID types.ChatID, msgBody string) (types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceMessageForClient", ctx, problemID, chatID, msgBody)
+	ret0, _ := ret[0].(types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceMessageForClient indicates an expected call of CreateServiceMessageForClient.
+func (mr *MockmessagesRepositoryMockRecorder) CreateServiceMessageForClient(ctx, problemID, chatID, msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceMessageForClient", reflect.TypeOf((*MockmessagesRepository)(nil).CreateServiceMessageForClient), ctx, problemID, chatID, msgBody)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAssignedProblemID mocks base method.
+func (m *MockproblemsRepository) GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedProblemID", ctx, managerID, chatID)
+	ret0, _ := ret[0].(types.ProblemID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedProblemID indicates an expected call of GetAssignedProblemID.
+func (mr *MockproblemsRepositoryMockRecorder) GetAssignedProblemID(ctx, managerID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedProblemID", reflect.TypeOf((*MockproblemsRepository)(nil).GetAssignedProblemID), ctx, managerID, chatID)
+}
+
+// GetManagerLastProblem mocks base method.
+func (m *MockproblemsRepository) GetManagerLastProblem(ctx context.Context, managerID types.UserID, chatID types.ChatID) (problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerLastProblem", ctx, managerID, chatID)
+	ret0, _ := ret[0].(problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerLastProblem indicates an expected call of GetManagerLastProblem.
+func (mr *MockproblemsRepositoryMockRecorder) GetManagerLastProblem(ctx, managerID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerLastProblem", reflect.TypeOf((*MockproblemsRepository)(nil).GetManagerLastProblem), ctx, managerID, chatID)
+}
+
+// ResolveProblem mocks base method.
+func (m *MockproblemsRepository) ResolveProblem(ctx context.Context, problemID types.ProblemID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveProblem", ctx, problemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveProblem indicates an expected call of ResolveProblem.
+func (mr *MockproblemsRepositoryMockRecorder) ResolveProblem(ctx, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveProblem", reflect.TypeOf((*MockproblemsRepository)(nil).ResolveProblem), ctx, problemID)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
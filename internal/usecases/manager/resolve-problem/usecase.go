@@ -0,0 +1,122 @@
+package resolveproblem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	closechatjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=resolveproblemmocks
+
+const problemResolvedMsgBody = "Your question has been marked as resolved.\nHow would you rate our support?"
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	CreateServiceMessageForClient(
+		ctx context.Context,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		msgBody string,
+	) (types.MessageID, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+	GetManagerLastProblem(ctx context.Context, managerID types.UserID, chatID types.ChatID) (problemsrepo.Problem, error)
+	ResolveProblem(ctx context.Context, problemID types.ProblemID) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outbox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	tx           transactor         `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, err
+	}
+	return UseCase{
+		Options: opts,
+	}, nil
+}
+
+// Handle resolves the manager's open problem in the chat and asks the client to rate the conversation.
+// The repeated call for the already resolved problem does nothing.
+func (u UseCase) Handle(ctx context.Context, req Request) error {
+	if err := req.Validate(); err != nil {
+		return ErrInvalidRequest
+	}
+
+	return u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+		if err != nil {
+			if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+				return u.checkAlreadyResolved(ctx, req)
+			}
+			return fmt.Errorf("get assigned problem: %w", err)
+		}
+
+		if err := u.problemsRepo.ResolveProblem(ctx, problemID); err != nil {
+			if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+				// Resolved concurrently.
+				return nil
+			}
+			return fmt.Errorf("resolve problem: %w", err)
+		}
+
+		msgID, err := u.msgRepo.CreateServiceMessageForClient(ctx, problemID, req.ChatID, problemResolvedMsgBody)
+		if err != nil {
+			return fmt.Errorf("create service message: %w", err)
+		}
+
+		payload, err := closechatjob.MarshalPayload(req.ID, msgID, req.ManagerID)
+		if err != nil {
+			return fmt.Errorf("marshal job payload: %w", err)
+		}
+
+		if _, err := u.outbox.Put(ctx, closechatjob.Name, payload, time.Now()); err != nil {
+			return fmt.Errorf("put job to outbox: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (u UseCase) checkAlreadyResolved(ctx context.Context, req Request) error {
+	p, err := u.problemsRepo.GetManagerLastProblem(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+			return ErrProblemNotFound
+		}
+		return fmt.Errorf("get manager last problem: %w", err)
+	}
+
+	if p.ResolvedAt.IsZero() {
+		return ErrProblemNotFound
+	}
+	return nil
+}
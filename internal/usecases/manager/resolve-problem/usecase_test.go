@@ -0,0 +1,215 @@
+package resolveproblem_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	closechatjob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/close-chat"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	resolveproblem "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem"
+	resolveproblemmocks "github.com/keepcalmist/chat-service/internal/usecases/manager/resolve-problem/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *resolveproblemmocks.MockmessagesRepository
+	outbox       *resolveproblemmocks.MockoutboxService
+	problemsRepo *resolveproblemmocks.MockproblemsRepository
+	txtor        *resolveproblemmocks.Mocktransactor
+	uCase        resolveproblem.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = resolveproblemmocks.NewMockmessagesRepository(s.ctrl)
+	s.outbox = resolveproblemmocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = resolveproblemmocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = resolveproblemmocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = resolveproblem.New(resolveproblem.NewOptions(s.msgRepo, s.outbox, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := resolveproblem.Request{}
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolveproblem.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemError() {
+	// Arrange.
+	req := s.newRequest()
+	errExpected := errors.New("unexpected")
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, errExpected)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestManagerHasNoProblemInChat() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+	s.problemsRepo.EXPECT().GetManagerLastProblem(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(problemsrepo.Problem{}, problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolveproblem.ErrProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestProblemAlreadyResolved() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+	s.problemsRepo.EXPECT().GetManagerLastProblem(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(problemsrepo.Problem{
+			ID:         types.NewProblemID(),
+			ChatID:     req.ChatID,
+			ManagerID:  req.ManagerID,
+			CreatedAt:  time.Now().Add(-time.Hour),
+			ResolvedAt: time.Now(),
+		}, nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestProblemResolvedConcurrently() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestCreateServiceMessageError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	errExpected := errors.New("unexpected")
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), problemID, req.ChatID, gomock.Any()).
+		Return(types.MessageIDNil, errExpected)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	errExpected := errors.New("unexpected")
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), problemID, req.ChatID, gomock.Any()).
+		Return(types.NewMessageID(), nil)
+	s.outbox.EXPECT().Put(gomock.Any(), closechatjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.JobIDNil, errExpected)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), problemID, req.ChatID, gomock.Any()).
+		Return(msgID, nil)
+
+	payload, err := closechatjob.MarshalPayload(req.ID, msgID, req.ManagerID)
+	s.Require().NoError(err)
+	s.outbox.EXPECT().Put(gomock.Any(), closechatjob.Name, payload, gomock.Any()).Return(types.NewJobID(), nil)
+
+	// Action.
+	err = s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() resolveproblem.Request {
+	return resolveproblem.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
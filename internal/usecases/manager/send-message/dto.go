@@ -0,0 +1,25 @@
+package sendmessage
+
+import (
+	"time"
+
+	"github.com/keepcalmist/chat-service/internal/types"
+	"github.com/keepcalmist/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID `validate:"required"`
+	ManagerID   types.UserID    `validate:"required"`
+	ChatID      types.ChatID    `validate:"required"`
+	MessageBody string          `validate:"required,gte=1,lte=3000"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	MessageID types.MessageID
+	AuthorID  types.UserID
+	CreatedAt time.Time
+}
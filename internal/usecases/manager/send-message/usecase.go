@@ -0,0 +1,118 @@
+package sendmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	sendmanagermessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-manager-message"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendmessagemocks
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	GetMessageByRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Message, error)
+	CreateFullVisible(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+type outboxService interface {
+	PutOrdered(ctx context.Context, name, payload, orderingKey string, availableAt time.Time) (types.JobID, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outbox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	tx           transactor         `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if req.Validate() != nil {
+		return Response{}, ErrInvalidRequest
+	}
+	resp := Response{}
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		msg, err := u.msgRepo.GetMessageByRequestID(ctx, req.ID)
+		if err != nil && !errors.Is(err, messagesrepo.ErrMsgNotFound) {
+			return fmt.Errorf("get message by request id: %w", err)
+		}
+
+		if msg != nil {
+			resp = convertResponse(msg)
+			return nil
+		}
+
+		problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+		if err != nil {
+			if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+				return ErrProblemNotFound
+			}
+			return fmt.Errorf("get assigned problem: %w", err)
+		}
+
+		msg, err = u.msgRepo.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		if err != nil {
+			return fmt.Errorf("create message: %w", err)
+		}
+
+		payload, err := sendmanagermessagejob.MarshalPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("marshal job payload: %w", err)
+		}
+
+		if _, err := u.outbox.PutOrdered(ctx, sendmanagermessagejob.Name, payload, req.ChatID.String(), time.Now()); err != nil {
+			return fmt.Errorf("failed to put job to outbox: %w", err)
+		}
+
+		resp = convertResponse(msg)
+
+		return nil
+	})
+	if err != nil {
+		return Response{}, err
+	}
+
+	return resp, nil
+}
+
+func convertResponse(msg *messagesrepo.Message) Response {
+	return Response{
+		MessageID: msg.ID,
+		AuthorID:  msg.AuthorID,
+		CreatedAt: msg.CreatedAt,
+	}
+}
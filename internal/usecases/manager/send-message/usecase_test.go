@@ -0,0 +1,230 @@
+package sendmessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/keepcalmist/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/keepcalmist/chat-service/internal/repositories/problems"
+	sendmanagermessagejob "github.com/keepcalmist/chat-service/internal/services/outbox/jobs/send-manager-message"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	sendmessage "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message"
+	sendmessagemocks "github.com/keepcalmist/chat-service/internal/usecases/manager/send-message/mocks"
+)
+
+const msgBody = "Hello, how can I help you?"
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *sendmessagemocks.MockmessagesRepository
+	outBoxSvc    *sendmessagemocks.MockoutboxService
+	problemsRepo *sendmessagemocks.MockproblemsRepository
+	txtor        *sendmessagemocks.Mocktransactor
+	uCase        sendmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = sendmessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = sendmessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = sendmessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = sendmessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := sendmessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestGetMessageByRequestID_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestGetMessageByRequestID_MsgFound() {
+	// Arrange.
+	req := s.newRequest()
+	createdAt := time.Now()
+	messageID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).
+		Return(&messagesrepo.Message{
+			ID:                  messageID,
+			ChatID:              req.ChatID,
+			AuthorID:            req.ManagerID,
+			Body:                msgBody,
+			CreatedAt:           createdAt,
+			IsVisibleForClient:  true,
+			IsVisibleForManager: true,
+		}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.ManagerID, resp.AuthorID)
+	s.Equal(messageID, resp.MessageID)
+	s.True(createdAt.Equal(resp.CreatedAt))
+}
+
+func (s *UseCaseSuite) TestManagerHasNoProblemInChat() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestCreateMessageError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	errExpected := errors.New("any error")
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, msgBody).
+		Return(nil, errExpected)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	msgID := types.NewMessageID()
+	errExpected := errors.New("any error")
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, msgBody).
+		Return(&messagesrepo.Message{ID: msgID}, nil)
+	s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendmanagermessagejob.Name, msgID.String(), req.ChatID.String(), gomock.Any()).
+		Return(types.JobIDNil, errExpected)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestTransactionError() {
+	// Arrange.
+	req := s.newRequest()
+	errExpected := errors.New("commit error")
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Return(errExpected)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	msgID := types.NewMessageID()
+	createdAt := time.Now()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, msgBody).
+		Return(&messagesrepo.Message{
+			ID:                  msgID,
+			ChatID:              req.ChatID,
+			AuthorID:            req.ManagerID,
+			Body:                msgBody,
+			CreatedAt:           createdAt,
+			IsVisibleForClient:  true,
+			IsVisibleForManager: true,
+		}, nil)
+	s.outBoxSvc.EXPECT().PutOrdered(gomock.Any(), sendmanagermessagejob.Name, msgID.String(), req.ChatID.String(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(msgID, resp.MessageID)
+	s.Equal(req.ManagerID, resp.AuthorID)
+	s.True(createdAt.Equal(resp.CreatedAt))
+}
+
+func (s *UseCaseSuite) newRequest() sendmessage.Request {
+	return sendmessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		ChatID:      types.NewChatID(),
+		MessageBody: msgBody,
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
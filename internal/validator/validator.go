@@ -3,10 +3,20 @@ package validator
 import (
 	"github.com/go-playground/validator/v10"
 	optsGenValidator "github.com/kazhuravlev/options-gen/pkg/validator"
+
+	"github.com/keepcalmist/chat-service/internal/types"
 )
 
 var Validator = validator.New()
 
 func init() {
+	if err := Validator.RegisterValidation("topic", isTopic); err != nil {
+		panic(err)
+	}
 	optsGenValidator.Set(Validator)
 }
+
+// isTopic is the "topic" tag accepting one of types.Topics.
+func isTopic(fl validator.FieldLevel) bool {
+	return types.Topic(fl.Field().String()).Valid()
+}
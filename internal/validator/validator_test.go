@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/keepcalmist/chat-service/internal/types"
 	"github.com/keepcalmist/chat-service/internal/validator"
 )
 
@@ -60,6 +61,22 @@ func TestValidate_TrickyNils(t *testing.T) {
 	}
 }
 
+func TestValidate_Topic(t *testing.T) {
+	type request struct {
+		Topic  types.Topic   `validate:"omitempty,topic"`
+		Skills []types.Topic `validate:"dive,topic"`
+	}
+
+	for _, topic := range types.Topics {
+		assert.NoError(t, validator.Validator.Struct(request{Topic: topic, Skills: []types.Topic{topic}}), topic)
+	}
+	assert.NoError(t, validator.Validator.Struct(request{}))
+
+	assert.Error(t, validator.Validator.Struct(request{Topic: "mortgages"}))
+	assert.Error(t, validator.Validator.Struct(request{Skills: []types.Topic{types.TopicCards, "mortgages"}}))
+	assert.Error(t, validator.Validator.Struct(request{Skills: []types.Topic{""}}))
+}
+
 var _ http.Handler = (*handlerMock)(nil)
 
 type handlerMock struct{}
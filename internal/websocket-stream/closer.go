@@ -0,0 +1,41 @@
+package websocketstream
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+type wsCloser struct {
+	logger *zap.Logger
+	ws     Websocket
+	once   sync.Once
+}
+
+func newWsCloser(logger *zap.Logger, ws Websocket) *wsCloser {
+	return &wsCloser{
+		logger: logger,
+		ws:     ws,
+	}
+}
+
+// Close sends the close frame with the code and closes the connection.
+// Only the first call takes effect.
+func (c *wsCloser) Close(code int) {
+	c.once.Do(func() {
+		err := c.ws.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(code, ""),
+			time.Now().Add(writeTimeout),
+		)
+		if err != nil && !isClosedConnErr(err) {
+			c.logger.Debug("write close message", zap.Error(err))
+		}
+
+		if err := c.ws.Close(); err != nil && !isClosedConnErr(err) {
+			c.logger.Debug("close connection", zap.Error(err))
+		}
+	})
+}
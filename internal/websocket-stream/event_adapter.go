@@ -0,0 +1,8 @@
+package websocketstream
+
+import eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+
+// EventAdapter converts the event from the service layer to the event of the specific API.
+type EventAdapter interface {
+	Adapt(event eventstream.Event) (any, error)
+}
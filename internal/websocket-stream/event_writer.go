@@ -0,0 +1,16 @@
+package websocketstream
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type EventWriter interface {
+	Write(event any, out io.Writer) error
+}
+
+type JSONEventWriter struct{}
+
+func (JSONEventWriter) Write(event any, out io.Writer) error {
+	return json.NewEncoder(out).Encode(event)
+}
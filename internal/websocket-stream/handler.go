@@ -0,0 +1,208 @@
+package websocketstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/handler_mock.gen.go -package=websocketstreammocks
+
+const writeTimeout = time.Second
+
+type eventStream interface {
+	Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error)
+}
+
+//go:generate options-gen -out-filename=handler_options.gen.go -from-struct=Options
+type Options struct {
+	pingPeriod time.Duration `default:"3s" validate:"omitempty,min=100ms,max=30s"`
+
+	logger       *zap.Logger     `option:"mandatory" validate:"required"`
+	eventStream  eventStream     `option:"mandatory" validate:"required"`
+	eventAdapter EventAdapter    `option:"mandatory" validate:"required"`
+	eventWriter  EventWriter     `option:"mandatory" validate:"required"`
+	upgrader     Upgrader        `option:"mandatory" validate:"required"`
+	shutdownCh   <-chan struct{} `option:"mandatory" validate:"required"`
+}
+
+// HTTPHandler upgrades the request to the WebSocket connection
+// and streams the events of the authorized user into it.
+type HTTPHandler struct {
+	Options
+	pongWait time.Duration
+}
+
+func NewHTTPHandler(opts Options) (*HTTPHandler, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	return &HTTPHandler{
+		Options:  opts,
+		pongWait: opts.pingPeriod * 3 / 2,
+	}, nil
+}
+
+func (h *HTTPHandler) Serve(eCtx echo.Context) error {
+	// The upgrader has already replied to the client with the HTTP error.
+	ws, err := h.upgrader.Upgrade(eCtx.Response(), eCtx.Request(), nil)
+	if err != nil {
+		h.logger.Warn("upgrade connection", zap.Error(err))
+		return nil
+	}
+
+	userID := middlewares.MustUserID(eCtx)
+	closer := newWsCloser(h.logger, ws)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := h.eventStream.Subscribe(ctx, userID)
+	if err != nil {
+		h.logger.Error("subscribe to events", zap.Error(err), zap.Stringer("user_id", userID))
+		closer.Close(websocket.CloseInternalServerErr)
+		return nil
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		defer cancel()
+		return h.readLoop(ctx, ws)
+	})
+
+	eg.Go(func() error {
+		defer cancel()
+		return h.writeLoop(ctx, ws, events)
+	})
+
+	eg.Go(func() error {
+		select {
+		case <-ctx.Done():
+			closer.Close(websocket.CloseNormalClosure)
+		case <-h.shutdownCh:
+			closer.Close(websocket.CloseGoingAway)
+		}
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		h.logger.Warn("websocket connection closed with error", zap.Error(err), zap.Stringer("user_id", userID))
+	}
+
+	return nil
+}
+
+// readLoop discards the client messages and keeps the read deadline up to date on pong.
+func (h *HTTPHandler) readLoop(_ context.Context, ws Websocket) error {
+	if err := ws.SetReadDeadline(time.Now().Add(h.pongWait)); err != nil {
+		return fmt.Errorf("set read deadline: %v", err)
+	}
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(h.pongWait))
+	})
+
+	for {
+		if _, _, err := ws.NextReader(); err != nil {
+			if isClosedConnErr(err) {
+				return nil
+			}
+			return fmt.Errorf("read message: %w", err)
+		}
+	}
+}
+
+func (h *HTTPHandler) writeLoop(ctx context.Context, ws Websocket, events <-chan eventstream.Event) error {
+	pingTicker := time.NewTicker(h.pingPeriod)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if err := h.writeEvent(ws, event); err != nil {
+				if isClosedConnErr(err) {
+					return nil
+				}
+				return err
+			}
+
+		case <-pingTicker.C:
+			if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				if isClosedConnErr(err) {
+					return nil
+				}
+				return fmt.Errorf("write ping: %w", err)
+			}
+		}
+	}
+}
+
+func (h *HTTPHandler) writeEvent(ws Websocket, event eventstream.Event) error {
+	adapted, err := h.eventAdapter.Adapt(event)
+	if err != nil {
+		h.logger.Error("adapt event", zap.Error(err))
+		return nil
+	}
+
+	if err := ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return fmt.Errorf("set write deadline: %w", err)
+	}
+
+	w, err := ws.NextWriter(websocket.TextMessage)
+	if err != nil {
+		return fmt.Errorf("get next writer: %w", err)
+	}
+
+	if err := h.eventWriter.Write(adapted, w); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("flush event: %w", err)
+	}
+	return nil
+}
+
+func isClosedConnErr(err error) bool {
+	return errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, websocket.ErrCloseSent) ||
+		websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived)
+}
+
+// Websocket is the part of *websocket.Conn used by the handler.
+type Websocket interface {
+	SetWriteDeadline(t time.Time) error
+	NextWriter(messageType int) (io.WriteCloser, error)
+	WriteControl(messageType int, data []byte, deadline time.Time) error
+
+	SetReadDeadline(t time.Time) error
+	SetPongHandler(h func(appData string) error)
+	NextReader() (messageType int, r io.Reader, err error)
+
+	Close() error
+}
+
+type Upgrader interface {
+	Upgrade(w http.ResponseWriter, r *http.Request, responseHeader http.Header) (*websocket.Conn, error)
+}
@@ -0,0 +1,238 @@
+package websocketstream_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/keepcalmist/chat-service/internal/middlewares"
+	clientevents "github.com/keepcalmist/chat-service/internal/server/server-client/events"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	"github.com/keepcalmist/chat-service/internal/testingh"
+	"github.com/keepcalmist/chat-service/internal/types"
+	websocketstream "github.com/keepcalmist/chat-service/internal/websocket-stream"
+	websocketstreammocks "github.com/keepcalmist/chat-service/internal/websocket-stream/mocks"
+)
+
+const (
+	allowedOrigin = "http://localhost:3000"
+	pingPeriod    = 100 * time.Millisecond
+	readTimeout   = time.Second
+)
+
+type HandlerSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	eventStream *websocketstreammocks.MockeventStream
+	events      chan eventstream.Event
+	shutdownCh  chan struct{}
+	userID      types.UserID
+	srv         *httptest.Server
+}
+
+func TestHandlerSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HandlerSuite))
+}
+
+func (s *HandlerSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.eventStream = websocketstreammocks.NewMockeventStream(s.ctrl)
+	s.events = make(chan eventstream.Event, 1)
+	s.shutdownCh = make(chan struct{})
+	s.userID = types.NewUserID()
+
+	handler, err := websocketstream.NewHTTPHandler(websocketstream.NewOptions(
+		zap.NewNop(),
+		s.eventStream,
+		clientevents.Adapter{},
+		websocketstream.JSONEventWriter{},
+		websocketstream.NewUpgrader([]string{allowedOrigin}, websocketstream.SecWsProtocol),
+		s.shutdownCh,
+		websocketstream.WithPingPeriod(pingPeriod),
+	))
+	s.Require().NoError(err)
+
+	e := echo.New()
+	e.GET("/ws", handler.Serve, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			middlewares.SetToken(c, s.userID)
+			return next(c)
+		}
+	})
+	s.srv = httptest.NewServer(e)
+}
+
+func (s *HandlerSuite) TearDownTest() {
+	s.srv.Close()
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *HandlerSuite) TestEventDelivered() {
+	// Arrange.
+	s.eventStream.EXPECT().Subscribe(gomock.Any(), s.userID).Return(s.events, nil)
+	conn := s.dial(allowedOrigin)
+
+	eventID := types.NewEventID()
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+
+	// Action.
+	s.events <- eventstream.NewMessageSentEvent(eventID, reqID, msgID)
+
+	// Assert.
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(readTimeout)))
+	msgType, data, err := conn.ReadMessage()
+	s.Require().NoError(err)
+	s.Equal(websocket.TextMessage, msgType)
+	s.JSONEq(`{
+		"eventId": "`+eventID.String()+`",
+		"eventType": "MessageSentEvent",
+		"messageId": "`+msgID.String()+`",
+		"requestId": "`+reqID.String()+`"
+	}`, string(data))
+}
+
+func (s *HandlerSuite) TestEveryConnectionReceivesEvent() {
+	// Arrange.
+	const tabs = 3
+
+	subscriptions := make([]chan eventstream.Event, 0, tabs)
+	conns := make([]*websocket.Conn, 0, tabs)
+	for i := 0; i < tabs; i++ {
+		events := make(chan eventstream.Event, 1)
+		subscriptions = append(subscriptions, events)
+
+		s.eventStream.EXPECT().Subscribe(gomock.Any(), s.userID).Return(events, nil)
+		conns = append(conns, s.dial(allowedOrigin))
+	}
+
+	event := eventstream.NewMessageSentEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID())
+
+	// Action.
+	for _, events := range subscriptions {
+		events <- event
+	}
+
+	// Assert.
+	for _, conn := range conns {
+		s.Require().NoError(conn.SetReadDeadline(time.Now().Add(readTimeout)))
+		_, data, err := conn.ReadMessage()
+		s.Require().NoError(err)
+		s.Contains(string(data), event.MessageID.String())
+	}
+}
+
+func (s *HandlerSuite) TestPingSent() {
+	// Arrange.
+	s.eventStream.EXPECT().Subscribe(gomock.Any(), s.userID).Return(s.events, nil)
+	conn := s.dial(allowedOrigin)
+
+	pinged := make(chan struct{}, 1)
+	conn.SetPingHandler(func(string) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+
+	// Action.
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Assert.
+	select {
+	case <-pinged:
+	case <-time.After(readTimeout):
+		s.Fail("no ping received")
+	}
+}
+
+func (s *HandlerSuite) TestConnectionClosedOnShutdown() {
+	// Arrange.
+	s.eventStream.EXPECT().Subscribe(gomock.Any(), s.userID).Return(s.events, nil)
+	conn := s.dial(allowedOrigin)
+
+	// Action.
+	close(s.shutdownCh)
+
+	// Assert.
+	s.assertClosedWithCode(conn, websocket.CloseGoingAway)
+}
+
+func (s *HandlerSuite) TestConnectionClosedWhenEventsClosed() {
+	// Arrange.
+	s.eventStream.EXPECT().Subscribe(gomock.Any(), s.userID).Return(s.events, nil)
+	conn := s.dial(allowedOrigin)
+
+	// Action.
+	close(s.events)
+
+	// Assert.
+	s.assertClosedWithCode(conn, websocket.CloseNormalClosure)
+}
+
+func (s *HandlerSuite) TestForbiddenOrigin() {
+	// Arrange.
+	dialer := websocket.Dialer{Subprotocols: []string{websocketstream.SecWsProtocol}}
+
+	// Action.
+	_, resp, err := dialer.DialContext(s.Ctx, s.wsURL(), http.Header{"Origin": {"http://evil.com"}})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Require().NotNil(resp)
+	defer resp.Body.Close()
+	s.Equal(http.StatusForbidden, resp.StatusCode)
+}
+
+func (s *HandlerSuite) dial(origin string) *websocket.Conn {
+	s.T().Helper()
+
+	dialer := websocket.Dialer{Subprotocols: []string{websocketstream.SecWsProtocol, "token"}}
+
+	conn, resp, err := dialer.DialContext(s.Ctx, s.wsURL(), http.Header{"Origin": {origin}})
+	s.Require().NoError(err)
+	s.Require().NoError(resp.Body.Close())
+	s.Equal(websocketstream.SecWsProtocol, conn.Subprotocol())
+
+	s.T().Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func (s *HandlerSuite) wsURL() string {
+	return "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/ws"
+}
+
+func (s *HandlerSuite) assertClosedWithCode(conn *websocket.Conn, code int) {
+	s.T().Helper()
+
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(readTimeout)))
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+		s.True(websocket.IsCloseError(err, code), "unexpected error: %v", err)
+		return
+	}
+}
@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+
+// Package websocketstreammocks is a generated GoMock package.
+package websocketstreammocks
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	websocket "github.com/gorilla/websocket"
+	eventstream "github.com/keepcalmist/chat-service/internal/services/event-stream"
+	types "github.com/keepcalmist/chat-service/internal/types"
+)
+
+// MockeventStream is a mock of eventStream interface.
+type MockeventStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventStreamMockRecorder
+}
+
+// MockeventStreamMockRecorder is the mock recorder for MockeventStream.
+type MockeventStreamMockRecorder struct {
+	mock *MockeventStream
+}
+
+// NewMockeventStream creates a new mock instance.
+func NewMockeventStream(ctrl *gomock.Controller) *MockeventStream {
+	mock := &MockeventStream{ctrl: ctrl}
+	mock.recorder = &MockeventStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventStream) EXPECT() *MockeventStreamMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockeventStream) Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, userID)
+	ret0, _ := ret[0].(<-chan eventstream.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockeventStreamMockRecorder) Subscribe(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockeventStream)(nil).Subscribe), ctx, userID)
+}
+
+// MockWebsocket is a mock of Websocket interface.
+type MockWebsocket struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebsocketMockRecorder
+}
+
+// MockWebsocketMockRecorder is the mock recorder for MockWebsocket.
+type MockWebsocketMockRecorder struct {
+	mock *MockWebsocket
+}
+
+// NewMockWebsocket creates a new mock instance.
+func NewMockWebsocket(ctrl *gomock.Controller) *MockWebsocket {
+	mock := &MockWebsocket{ctrl: ctrl}
+	mock.recorder = &MockWebsocketMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebsocket) EXPECT() *MockWebsocketMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockWebsocket) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWebsocketMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWebsocket)(nil).Close))
+}
+
+// NextReader mocks base method.
+func (m *MockWebsocket) NextReader() (int, io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextReader")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(io.Reader)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// NextReader indicates an expected call of NextReader.
+func (mr *MockWebsocketMockRecorder) NextReader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextReader", reflect.TypeOf((*MockWebsocket)(nil).NextReader))
+}
+
+// NextWriter mocks base method.
+func (m *MockWebsocket) NextWriter(messageType int) (io.WriteCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextWriter", messageType)
+	ret0, _ := ret[0].(io.WriteCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextWriter indicates an expected call of NextWriter.
+func (mr *MockWebsocketMockRecorder) NextWriter(messageType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextWriter", reflect.TypeOf((*MockWebsocket)(nil).NextWriter), messageType)
+}
+
+// SetPongHandler mocks base method.
+func (m *MockWebsocket) SetPongHandler(h func(string) error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPongHandler", h)
+}
+
+// SetPongHandler indicates an expected call of SetPongHandler.
+func (mr *MockWebsocketMockRecorder) SetPongHandler(h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPongHandler", reflect.TypeOf((*MockWebsocket)(nil).SetPongHandler), h)
+}
+
+// SetReadDeadline mocks base method.
+func (m *MockWebsocket) SetReadDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReadDeadline indicates an expected call of SetReadDeadline.
+func (mr *MockWebsocketMockRecorder) SetReadDeadline(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadDeadline", reflect.TypeOf((*MockWebsocket)(nil).SetReadDeadline), t)
+}
+
+// SetWriteDeadline mocks base method.
+func (m *MockWebsocket) SetWriteDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWriteDeadline indicates an expected call of SetWriteDeadline.
+func (mr *MockWebsocketMockRecorder) SetWriteDeadline(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDeadline", reflect.TypeOf((*MockWebsocket)(nil).SetWriteDeadline), t)
+}
+
+// WriteControl mocks base method.
+func (m *MockWebsocket) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteControl", messageType, data, deadline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteControl indicates an expected call of WriteControl.
+func (mr *MockWebsocketMockRecorder) WriteControl(messageType, data, deadline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteControl", reflect.TypeOf((*MockWebsocket)(nil).WriteControl), messageType, data, deadline)
+}
+
+// MockUpgrader is a mock of Upgrader interface.
+type MockUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgraderMockRecorder
+}
+
+// MockUpgraderMockRecorder is the mock recorder for MockUpgrader.
+type MockUpgraderMockRecorder struct {
+	mock *MockUpgrader
+}
+
+// NewMockUpgrader creates a new mock instance.
+func NewMockUpgrader(ctrl *gomock.Controller) *MockUpgrader {
+	mock := &MockUpgrader{ctrl: ctrl}
+	mock.recorder = &MockUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgrader) EXPECT() *MockUpgraderMockRecorder {
+	return m.recorder
+}
+
+// Upgrade mocks base method.
+func (m *MockUpgrader) Upgrade(w http.ResponseWriter, r *http.Request, responseHeader http.Header) (*websocket.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", w, r, responseHeader)
+	ret0, _ := ret[0].(*websocket.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockUpgraderMockRecorder) Upgrade(w, r, responseHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockUpgrader)(nil).Upgrade), w, r, responseHeader)
+}
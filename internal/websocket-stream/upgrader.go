@@ -0,0 +1,28 @@
+package websocketstream
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+// SecWsProtocol is the subprotocol the clients must request.
+// The access token is passed next to it in the Sec-WebSocket-Protocol header.
+const SecWsProtocol = "chat-service-protocol"
+
+func NewUpgrader(allowOrigins []string, secWsProtocol string) *websocket.Upgrader {
+	upgrader := &websocket.Upgrader{
+		HandshakeTimeout: writeTimeout,
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			for _, o := range allowOrigins {
+				if o == "*" || o == origin {
+					return true
+				}
+			}
+			return false
+		},
+		Subprotocols: []string{secWsProtocol},
+	}
+	return upgrader
+}
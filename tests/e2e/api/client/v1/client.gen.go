@@ -28,6 +28,13 @@ const (
 	ErrorCodeCreateProblemError ErrorCode = 1001
 )
 
+// Defines values for ProblemTopic.
+const (
+	ProblemTopicCards    ProblemTopic = "cards"
+	ProblemTopicDeposits ProblemTopic = "deposits"
+	ProblemTopicLoans    ProblemTopic = "loans"
+)
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
@@ -75,9 +82,15 @@ type MessagesPage struct {
 	Next     string    `json:"next"`
 }
 
+// ProblemTopic The subject of the problem chosen by the client when starting a chat. It is inferred from the message if omitted.
+type ProblemTopic string
+
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
 	MessageBody string `json:"messageBody"`
+
+	// Topic The subject of the problem chosen by the client when starting a chat. It is inferred from the message if omitted.
+	Topic *ProblemTopic `json:"topic,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.